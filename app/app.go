@@ -1,12 +1,15 @@
 package app
 
 import (
+	"fmt"
 	"os"
+	"time"
 
 	"github.com/containers/podman-tui/config"
 	"github.com/containers/podman-tui/pdcs/registry"
 	health "github.com/containers/podman-tui/system"
 	"github.com/containers/podman-tui/ui/containers"
+	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/containers/podman-tui/ui/help"
 	"github.com/containers/podman-tui/ui/images"
 	"github.com/containers/podman-tui/ui/infobar"
@@ -24,6 +27,7 @@ import (
 // App represents main application struct.
 type App struct {
 	*tview.Application
+	screen          tcell.Screen
 	infoBar         *infobar.InfoBar
 	pages           *tview.Pages
 	pods            *pods.Pods
@@ -36,14 +40,21 @@ type App struct {
 	menu            *tview.TextView
 	health          *health.Engine
 	help            *help.Help
+	toast           *dialogs.ToastDialog
+	notifyRules     []utils.NotificationRule
+	notifyBell      bool
+	notifyTerminal  string
+	healthStatus    map[string]string
 	currentPage     string
 	needInitUI      bool
+	connectedHosts  []string
+	refreshInterval time.Duration
 	fastRefreshChan chan bool
 	config          *config.Config
 }
 
 // NewApp returns new app.
-func NewApp(name string, version string) *App {
+func NewApp(name string, version string, theme string, refreshInterval time.Duration) *App {
 	log.Debug().Msg("app: new application")
 
 	// create application UI
@@ -51,17 +62,46 @@ func NewApp(name string, version string) *App {
 		Application:     tview.NewApplication(),
 		pages:           tview.NewPages(),
 		needInitUI:      false,
+		healthStatus:    make(map[string]string),
 		fastRefreshChan: make(chan bool, 10), //nolint:gomnd
 	}
 
 	var err error
 
+	// the screen is kept to write the terminal escape sequences it doesn't support
+	app.screen, err = tcell.NewScreen()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		log.Fatal().Msgf("%v", err)
+	}
+
+	app.SetScreen(app.screen)
+
 	app.config, err = config.NewConfig()
 	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		log.Fatal().Msgf("%v", err)
 	}
 
-	app.health = health.NewEngine(utils.RefreshInterval)
+	// the theme shall be set before creating the user interface primitives
+	if err := app.config.SetTheme(theme); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		log.Fatal().Msgf("%v", err)
+	}
+
+	// the key bindings shall be set before creating the user interface primitives
+	if err := app.config.SetKeyBindings(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		log.Fatal().Msgf("%v", err)
+	}
+
+	app.refreshInterval, err = app.config.GetRefreshInterval(refreshInterval)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		log.Fatal().Msgf("%v", err)
+	}
+
+	app.health = health.NewEngine(app.refreshInterval)
 
 	app.infoBar = infobar.NewInfoBar()
 
@@ -81,16 +121,57 @@ func NewApp(name string, version string) *App {
 		return err
 	})
 
-	app.system.SetConnectionConnectFunc(app.health.Connect)
+	app.system.SetConnectionConnectFunc(app.connect)
 	app.system.SetConnectionDisconnectFunc(app.health.Disconnect)
+	app.system.SetConnectionHistoryFunc(app.health.ConnectionHistory)
 	app.system.SetConnectionAddFunc(app.config.Add)
 	app.system.SetConnectionRemoveFunc(app.config.Remove)
 
+	revealTimeout, err := app.config.GetSecretRevealTimeout()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		log.Fatal().Msgf("%v", err)
+	}
+
+	auditLog, err := app.config.GetSecretAuditLog()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		log.Fatal().Msgf("%v", err)
+	}
+
+	app.secrets.SetRevealOptions(revealTimeout, auditLog)
+	app.secrets.SetClipboardFunc(app.copyToClipboard)
+	app.secrets.SetAppFuncs(
+		func(f func()) { app.QueueUpdateDraw(f) },
+		func(p tview.Primitive) { app.SetFocus(p) },
+	)
+
+	app.notifyRules, err = app.config.GetNotificationRules()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		log.Fatal().Msgf("%v", err)
+	}
+
+	app.notifyBell, app.notifyTerminal, err = app.config.GetNotificationTerminal()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		log.Fatal().Msgf("%v", err)
+	}
+
+	notifyTimeout, err := app.config.GetNotificationTimeout()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		log.Fatal().Msgf("%v", err)
+	}
+
+	app.toast = dialogs.NewToastDialog(notifyTimeout)
+
 	app.help = help.NewHelp(name, version)
 
 	// set refresh channel for container page
 	// its required for container exec dialog.
 	app.containers.SetFastRefreshChannel(app.fastRefreshChan)
+	app.containers.SetQueueUpdateDrawFunc(func(f func()) { app.QueueUpdateDraw(f) })
 
 	// set refresh channel for image page
 	// its required for image build dialog.
@@ -231,6 +312,9 @@ func (app *App) Run() error { //nolint:cyclop
 	app.currentPage = app.system.GetTitle()
 	app.pages.SwitchToPage(app.system.GetTitle())
 
+	// the notification toast is drawn over any screen
+	app.SetAfterDrawFunc(app.drawToast)
+
 	// start refresh loop
 	go app.refresh()
 
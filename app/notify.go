@@ -0,0 +1,140 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/containers/podman-tui/pdcs/containers"
+	health "github.com/containers/podman-tui/system"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rs/zerolog/log"
+)
+
+// notifyEvents raises the toast notification (and the terminal notification if configured)
+// of the events which match a notification rule.
+func (app *App) notifyEvents(events []health.Event) {
+	var notifications [][2]string
+
+	for _, evt := range events {
+		if !app.healthStatusChanged(evt) {
+			continue
+		}
+
+		attributes := app.notificationAttributes(evt)
+
+		for _, rule := range app.notifyRules {
+			if !rule.Match(evt.Type, evt.Action, evt.HealthStatus, attributes) {
+				continue
+			}
+
+			message := notificationMessage(evt)
+
+			app.toast.Notify(rule.Name, message)
+
+			notifications = append(notifications, [2]string{rule.Name, message})
+
+			break
+		}
+	}
+
+	if len(notifications) == 0 || (!app.notifyBell && app.notifyTerminal == "") {
+		return
+	}
+
+	// the bell and the terminal notifications are written to the screen terminal
+	// from the user interface goroutine between the screen updates.
+	app.QueueUpdate(func() {
+		if app.notifyBell {
+			if err := app.screen.Beep(); err != nil {
+				log.Error().Msgf("app: terminal bell: %v", err)
+			}
+		}
+
+		for _, notification := range notifications {
+			err := utils.TerminalNotify(utils.TerminalWriter(app.screen),
+				notification[0], notification[1], app.notifyTerminal)
+			if err != nil {
+				log.Error().Msgf("app: terminal notification: %v", err)
+			}
+		}
+	})
+}
+
+// notificationAttributes returns the event attributes, the OOM killed attribute is added to
+// the container died events if a notification rule matches the OOM killed containers.
+func (app *App) notificationAttributes(evt health.Event) map[string]string {
+	if evt.Type != "container" || evt.Action != "died" {
+		return evt.Attributes
+	}
+
+	if !slices.ContainsFunc(app.notifyRules, func(rule utils.NotificationRule) bool { return rule.OOMKilled }) {
+		return evt.Attributes
+	}
+
+	oomKilled, err := containers.OOMKilledByConnection(evt.Host, evt.ID)
+	if err != nil {
+		log.Debug().Msgf("app: notification %s %s: %v", evt.Host, evt.ID, err)
+
+		return evt.Attributes
+	}
+
+	attributes := make(map[string]string, len(evt.Attributes)+1)
+	for key, value := range evt.Attributes {
+		attributes[key] = value
+	}
+
+	attributes[utils.NotificationOOMKilledAttribute] = strconv.FormatBool(oomKilled)
+
+	return attributes
+}
+
+// healthStatusChanged returns false if the event is a container health status event
+// with the same status as the previous one (the status is sent on each healthcheck run).
+func (app *App) healthStatusChanged(evt health.Event) bool {
+	key := evt.Host + "/" + evt.ID
+
+	if evt.Action == utils.EventActionRemove {
+		delete(app.healthStatus, key)
+	}
+
+	if evt.HealthStatus == "" {
+		return true
+	}
+
+	if app.healthStatus[key] == evt.HealthStatus {
+		return false
+	}
+
+	app.healthStatus[key] = evt.HealthStatus
+
+	return true
+}
+
+// drawToast draws the notification toast over the current screen.
+func (app *App) drawToast(screen tcell.Screen) {
+	width, height := screen.Size()
+
+	app.toast.SetRect(0, 0, width, height)
+	app.toast.Draw(screen)
+}
+
+func notificationMessage(evt health.Event) string {
+	name := evt.Name
+	if name == "" {
+		name = utils.GetIDWithLimit(evt.ID)
+	}
+
+	message := fmt.Sprintf("%s: %s %s", evt.Host, evt.Type, name)
+
+	if exitCode := evt.Attributes["containerExitCode"]; exitCode != "" && exitCode != "0" {
+		message = fmt.Sprintf("%s (exit code %s)", message, exitCode)
+	}
+
+	if evt.HealthStatus != "" {
+		message = fmt.Sprintf("%s (%s)", message, evt.HealthStatus)
+	}
+
+	return message
+}
@@ -1,17 +1,17 @@
 package app
 
 import (
+	"slices"
 	"time"
 
 	"github.com/containers/podman-tui/pdcs/registry"
-	"github.com/containers/podman-tui/ui/utils"
 	"github.com/rs/zerolog/log"
 )
 
 func (app *App) refresh() {
-	log.Debug().Msgf("app: starting refresh loop (interval=%v)", utils.RefreshInterval)
+	log.Debug().Msgf("app: starting refresh loop (interval=%v)", app.refreshInterval)
 
-	tick := time.NewTicker(utils.RefreshInterval)
+	tick := time.NewTicker(app.refreshInterval)
 
 	for {
 		<-tick.C
@@ -61,9 +61,36 @@ func (app *App) refreshConnOK() {
 		app.setPageFocus(app.currentPage)
 	}
 
+	app.updateAggregatedViews()
 	app.flushEvents()
 }
 
+// updateAggregatedViews updates the views which list the resources of all
+// connected services (containers and pods) if the connected services are changed.
+func (app *App) updateAggregatedViews() {
+	hosts := app.health.ConnectedHosts()
+	if slices.Equal(hosts, app.connectedHosts) {
+		return
+	}
+
+	app.connectedHosts = hosts
+
+	app.pods.UpdateData()
+	app.containers.UpdateData()
+}
+
+// connect connects to the service connection and sets it as the active connection,
+// the views data are reloaded from the new active connection.
+func (app *App) connect(connection registry.Connection) {
+	if registry.ConnectionName() != connection.Name {
+		app.clearViewsData()
+
+		app.needInitUI = true
+	}
+
+	app.health.Connect(connection)
+}
+
 func (app *App) refreshNotConnOK() {
 	// only switch to system view one time
 	if !app.needInitUI {
@@ -78,11 +105,10 @@ func (app *App) refreshNotConnOK() {
 
 func (app *App) flushEvents() {
 	// update events
-	eventTypes := app.health.GetEvents()
+	events := app.health.GetEvents()
 
-	for _, evt := range eventTypes {
-		app.updatePageDataFromEvent(evt)
-	}
+	app.notifyEvents(events)
+	app.updatePageDataFromEvents(events)
 
 	if app.health.HasNewEvent() {
 		app.system.SetEventMessage(app.health.GetEventMessages())
@@ -1,7 +1,11 @@
 package app
 
 import (
+	"slices"
+
 	"github.com/containers/podman-tui/pdcs/registry"
+	health "github.com/containers/podman-tui/system"
+	"github.com/containers/podman-tui/ui/utils"
 	"github.com/rs/zerolog/log"
 )
 
@@ -130,6 +134,80 @@ func (app *App) updatePageData(page string) {
 	}
 }
 
+// eventActionPodContainer is the pod item action of its containers events.
+const eventActionPodContainer = "container"
+
+// eventItem is a container or pod updated from the events.
+type eventItem struct {
+	eventType string
+	host      string
+	id        string
+	action    string
+}
+
+// updatePageDataFromEvents updates the views from the events, the containers and
+// pods are updated item by item (once per item with the last event action) and
+// the other views are listed again once per event type.
+func (app *App) updatePageDataFromEvents(events []health.Event) {
+	items, eventTypes := groupEvents(events)
+
+	for _, item := range items {
+		switch item.eventType {
+		case "pod":
+			app.pods.UpdateItem(item.host, item.id, item.action)
+		case "container":
+			app.containers.UpdateItem(item.host, item.id, item.action)
+		}
+	}
+
+	for _, eventType := range eventTypes {
+		app.updatePageDataFromEvent(eventType)
+	}
+}
+
+// groupEvents returns the containers and pods items (in order of their first event
+// and with their last event action) and the other events types.
+// The container events (except the healthchecks) update the container pod too, the
+// pods list shows the pod containers status.
+func groupEvents(events []health.Event) ([]eventItem, []string) {
+	var (
+		items      []eventItem
+		itemIndex  = make(map[eventItem]int)
+		eventTypes []string
+	)
+
+	addItem := func(item eventItem, action string) {
+		if index, ok := itemIndex[item]; ok {
+			items[index].action = action
+
+			return
+		}
+
+		itemIndex[item] = len(items)
+		item.action = action
+		items = append(items, item)
+	}
+
+	for _, evt := range events {
+		if (evt.Type == "container" || evt.Type == "pod") && evt.ID != "" {
+			addItem(eventItem{eventType: evt.Type, host: evt.Host, id: evt.ID}, evt.Action)
+
+			podID := evt.Attributes["podId"]
+			if evt.Type == "container" && podID != "" && evt.Action != utils.EventActionHealthStatus {
+				addItem(eventItem{eventType: "pod", host: evt.Host, id: podID}, eventActionPodContainer)
+			}
+
+			continue
+		}
+
+		if !slices.Contains(eventTypes, evt.Type) {
+			eventTypes = append(eventTypes, evt.Type)
+		}
+	}
+
+	return items, eventTypes
+}
+
 func (app *App) updatePageDataFromEvent(eventType string) {
 	switch eventType {
 	case "pod":
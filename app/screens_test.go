@@ -0,0 +1,60 @@
+package app
+
+import (
+	health "github.com/containers/podman-tui/system"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("screens", func() {
+	It("group events", func() {
+		events := []health.Event{
+			{Host: "localhost", Type: "container", Action: "create", ID: "cnt01"},
+			{Host: "localhost", Type: "image", Action: "pull", ID: "img01"},
+			{Host: "localhost", Type: "container", Action: "start", ID: "cnt01"},
+			{Host: "remote", Type: "container", Action: "start", ID: "cnt01"},
+			{Host: "localhost", Type: "pod", Action: "create", ID: "pod01"},
+			{Host: "localhost", Type: "image", Action: "tag", ID: "img01"},
+			{Host: "localhost", Type: "volume", Action: "create", ID: "vol01"},
+			{Host: "localhost", Type: "container", Action: "remove", ID: "cnt01"},
+			{Host: "localhost", Type: "container", Action: "prune"},
+		}
+
+		items, eventTypes := groupEvents(events)
+		Expect(items).To(Equal([]eventItem{
+			{eventType: "container", host: "localhost", id: "cnt01", action: "remove"},
+			{eventType: "container", host: "remote", id: "cnt01", action: "start"},
+			{eventType: "pod", host: "localhost", id: "pod01", action: "create"},
+		}))
+		Expect(eventTypes).To(Equal([]string{"image", "volume", "container"}))
+	})
+
+	It("group pod container events", func() {
+		podAttributes := map[string]string{"podId": "pod01"}
+		events := []health.Event{
+			{Host: "localhost", Type: "container", Action: "start", ID: "cnt01", Attributes: podAttributes},
+			{Host: "localhost", Type: "container", Action: "health_status", ID: "cnt02", Attributes: podAttributes},
+			{Host: "localhost", Type: "container", Action: "died", ID: "cnt03", Attributes: map[string]string{"podId": ""}},
+			{Host: "localhost", Type: "container", Action: "remove", ID: "cnt01", Attributes: podAttributes},
+			{Host: "localhost", Type: "pod", Action: "remove", ID: "pod01"},
+			{Host: "remote", Type: "container", Action: "stop", ID: "cnt04", Attributes: map[string]string{"podId": "pod02"}},
+		}
+
+		items, eventTypes := groupEvents(events)
+		Expect(items).To(Equal([]eventItem{
+			{eventType: "container", host: "localhost", id: "cnt01", action: "remove"},
+			{eventType: "pod", host: "localhost", id: "pod01", action: "remove"},
+			{eventType: "container", host: "localhost", id: "cnt02", action: "health_status"},
+			{eventType: "container", host: "localhost", id: "cnt03", action: "died"},
+			{eventType: "container", host: "remote", id: "cnt04", action: "stop"},
+			{eventType: "pod", host: "remote", id: "pod02", action: eventActionPodContainer},
+		}))
+		Expect(eventTypes).To(BeEmpty())
+	})
+
+	It("group no events", func() {
+		items, eventTypes := groupEvents(nil)
+		Expect(items).To(BeEmpty())
+		Expect(eventTypes).To(BeEmpty())
+	})
+})
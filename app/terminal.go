@@ -0,0 +1,11 @@
+package app
+
+import (
+	"github.com/containers/podman-tui/ui/utils"
+)
+
+// copyToClipboard copies the text to the terminal clipboard, its called from the
+// user interface goroutine (i.e. input handlers) between the screen updates.
+func (app *App) copyToClipboard(text string) error {
+	return utils.CopyToClipboard(utils.TerminalWriter(app.screen), text)
+}
@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/containers/podman-tui/config"
+	"github.com/containers/podman-tui/pdcs/registry"
+	putils "github.com/containers/podman-tui/pdcs/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	outputFormatTable = "table"
+	outputFormatJSON  = "json"
+)
+
+var errInvalidOutputFormat = errors.New("invalid output format")
+
+// connectionsCmd represents the connections command.
+var connectionsCmd = &cobra.Command{
+	Use:   "connections",
+	Short: "Manage podman-tui service connections",
+	Long:  "Manage the service connections in podman-tui.conf without starting the user interface.",
+}
+
+var connectionsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List service connections",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error { //nolint:revive
+		return runWithConfig(cmd, func(appConfig *config.Config) error {
+			output, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+
+			return printConnections(os.Stdout, appConfig.ServicesConnections(), output)
+		})
+	},
+}
+
+var connectionsAddCmd = &cobra.Command{
+	Use:   "add NAME DESTINATION",
+	Short: "Add a new service connection",
+	Long: "Add a new service connection, the destination is in format of " +
+		"ssh://user@host[:port]/run/podman/podman.sock, unix://path or tcp://host:port.",
+	Args: cobra.ExactArgs(2), //nolint:gomnd
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runWithConfig(cmd, func(appConfig *config.Config) error {
+			identity, err := cmd.Flags().GetString("identity")
+			if err != nil {
+				return err
+			}
+
+			if err := appConfig.Add(args[0], args[1], identity); err != nil {
+				return err
+			}
+
+			setDefault, err := cmd.Flags().GetBool("default")
+			if err != nil {
+				return err
+			}
+
+			if setDefault {
+				return appConfig.SetDefaultService(args[0])
+			}
+
+			return nil
+		})
+	},
+}
+
+var connectionsRemoveCmd = &cobra.Command{
+	Use:   "remove NAME",
+	Short: "Remove a service connection",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runWithConfig(cmd, func(appConfig *config.Config) error {
+			return appConfig.Remove(args[0])
+		})
+	},
+}
+
+var connectionsDefaultCmd = &cobra.Command{
+	Use:   "default NAME",
+	Short: "Set the default service connection",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runWithConfig(cmd, func(appConfig *config.Config) error {
+			return appConfig.SetDefaultService(args[0])
+		})
+	},
+}
+
+// runWithConfig initializes the logger and the application configuration
+// and runs the non-interactive command function.
+func runWithConfig(cmd *cobra.Command, cmdFunc func(appConfig *config.Config) error) error {
+	// errors are printed by Execute
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	closeLog, _, err := initLogger(cmd)
+	if err != nil {
+		return err
+	}
+
+	defer closeLog()
+
+	appConfig, err := config.NewConfig()
+	if err != nil {
+		return err
+	}
+
+	return cmdFunc(appConfig)
+}
+
+func printConnections(out io.Writer, connections []registry.Connection, output string) error {
+	switch output {
+	case outputFormatJSON:
+		type connectionReport struct {
+			Name     string `json:"name"`
+			URI      string `json:"uri"`
+			Identity string `json:"identity,omitempty"`
+			Default  bool   `json:"default"`
+		}
+
+		report := make([]connectionReport, 0, len(connections))
+		for _, conn := range connections {
+			report = append(report, connectionReport{
+				Name:     conn.Name,
+				URI:      conn.URI,
+				Identity: conn.Identity,
+				Default:  conn.Default,
+			})
+		}
+
+		data, err := putils.GetJSONOutput(report)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(out, data)
+
+		return nil
+	case outputFormatTable:
+		writer := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0) //nolint:gomnd
+
+		fmt.Fprintln(writer, "NAME\tDEFAULT\tURI\tIDENTITY")
+
+		for _, conn := range connections {
+			fmt.Fprintf(writer, "%s\t%t\t%s\t%s\n", conn.Name, conn.Default, conn.URI, conn.Identity)
+		}
+
+		return writer.Flush()
+	}
+
+	return fmt.Errorf("%w %q (supported formats: %s, %s)",
+		errInvalidOutputFormat, output, outputFormatTable, outputFormatJSON)
+}
+
+func init() {
+	connectionsListCmd.Flags().StringP("output", "o", outputFormatTable, "Output format (table or json)")
+	connectionsAddCmd.Flags().StringP("identity", "i", "", "Path to SSH identity file (ssh destinations)")
+	connectionsAddCmd.Flags().Bool("default", false, "Set the new connection as default connection")
+
+	connectionsCmd.AddCommand(connectionsListCmd)
+	connectionsCmd.AddCommand(connectionsAddCmd)
+	connectionsCmd.AddCommand(connectionsRemoveCmd)
+	connectionsCmd.AddCommand(connectionsDefaultCmd)
+	rootCmd.AddCommand(connectionsCmd)
+}
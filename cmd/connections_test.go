@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/containers/podman-tui/config"
+	"github.com/containers/podman-tui/pdcs/registry"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("connections", Ordered, func() {
+	connections := []registry.Connection{
+		{Name: "localhost", URI: "unix://run/podman/podman.sock", Default: true},
+		{Name: "remote", URI: "ssh://root@example.com:22/run/podman/podman.sock", Identity: "/root/.ssh/id_ed25519"},
+	}
+
+	It("print connections table", func() {
+		var out strings.Builder
+
+		Expect(printConnections(&out, connections, outputFormatTable)).To(Succeed())
+
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		Expect(lines).To(HaveLen(3))
+		Expect(strings.Fields(lines[0])).To(Equal([]string{"NAME", "DEFAULT", "URI", "IDENTITY"}))
+		Expect(strings.Fields(lines[1])).To(Equal([]string{"localhost", "true", "unix://run/podman/podman.sock"}))
+		Expect(strings.Fields(lines[2])).To(Equal([]string{
+			"remote", "false", "ssh://root@example.com:22/run/podman/podman.sock", "/root/.ssh/id_ed25519",
+		}))
+	})
+
+	It("print connections json", func() {
+		var out strings.Builder
+
+		Expect(printConnections(&out, connections[:1], outputFormatJSON)).To(Succeed())
+		Expect(out.String()).To(MatchJSON(
+			`[{"name": "localhost", "uri": "unix://run/podman/podman.sock", "default": true}]`))
+	})
+
+	It("print connections invalid output format", func() {
+		var out strings.Builder
+
+		Expect(printConnections(&out, connections, "yaml")).To(MatchError(errInvalidOutputFormat))
+		Expect(out.String()).To(BeEmpty())
+	})
+
+	It("add, set default and remove connections", func() {
+		configHome := GinkgoT().TempDir()
+		GinkgoT().Setenv("XDG_CONFIG_HOME", configHome)
+
+		runCmd := func(args ...string) error {
+			rootCmd.SetArgs(args)
+
+			return rootCmd.Execute()
+		}
+
+		Expect(runCmd("connections", "add", "remote01", "tcp://localhost:8080")).To(Succeed())
+		Expect(runCmd("connections", "add", "remote02", "tcp://localhost:8081", "--default")).To(Succeed())
+		Expect(runCmd("connections", "add", "remote02", "tcp://localhost:8082")).
+			To(MatchError(config.ErrDuplicatedServiceName))
+
+		configData, err := os.ReadFile(filepath.Join(configHome, "podman-tui", "podman-tui.conf"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(configData)).To(ContainSubstring("tcp://localhost:8081"))
+
+		appConfig, err := config.NewConfig()
+		Expect(err).NotTo(HaveOccurred())
+
+		defaultConn := ""
+		for _, conn := range appConfig.ServicesConnections() {
+			if conn.Default {
+				defaultConn = conn.Name
+			}
+		}
+		Expect(defaultConn).To(Equal("remote02"))
+
+		Expect(runCmd("connections", "default", "remote01")).To(Succeed())
+		Expect(runCmd("connections", "remove", "remote02")).To(Succeed())
+		Expect(runCmd("connections", "default", "remote02")).To(MatchError(config.ErrServiceNotFound))
+
+		appConfig, err = config.NewConfig()
+		Expect(err).NotTo(HaveOccurred())
+
+		names := []string{}
+		for _, conn := range appConfig.ServicesConnections() {
+			names = append(names, conn.Name)
+			if conn.Name == "remote01" {
+				Expect(conn.Default).To(BeTrue())
+			}
+		}
+		Expect(names).NotTo(ContainElement("remote02"))
+		Expect(names).To(ContainElement("remote01"))
+	})
+})
@@ -29,38 +29,77 @@ func Execute() {
 	cobra.CheckErr(rootCmd.Execute())
 }
 
-func run(cmd *cobra.Command, args []string) error { //nolint:cyclop,revive
+func run(cmd *cobra.Command, args []string) error { //nolint:revive
+	runLog := fmt.Sprintf("starting %s version %s", appName, appVersion)
+
+	closeLog, debugLevel, err := initLogger(cmd)
+	if err != nil {
+		return err
+	}
+
+	defer closeLog()
+
+	if debugLevel {
+		runLog += " in debug mode"
+	}
+
+	log.Info().Msg(runLog)
+
+	unsetPassphrase, err := setSSHIdentityPassphrase()
+	if err != nil {
+		return err
+	}
+
+	// unset CONTAINER_PASSPHRASE environment variable if we have set it
+	// after application exits
+	defer unsetPassphrase()
+
+	theme, err := cmd.Flags().GetString("theme")
+	if err != nil {
+		return err
+	}
+
+	refreshInterval, err := cmd.Flags().GetDuration("refresh-interval")
+	if err != nil {
+		return err
+	}
+
+	app := app.NewApp(appName, appVersion, theme, refreshInterval)
+
+	return app.Run()
+}
+
+// initLogger initializes the application logger (default level is info and
+// the logs are discarded if debug mode is not enabled) and returns the log file
+// close function and whether or not debug mode is enabled.
+func initLogger(cmd *cobra.Command) (func(), bool, error) {
 	var (
 		logOutput = io.Discard
-		runLog    = fmt.Sprintf("starting %s version %s", appName, appVersion)
+		closeLog  = func() {}
 	)
 
 	zerolog.SetGlobalLevel(zerolog.InfoLevel)
 
-	// Default level is info
 	debugLevel, err := cmd.Flags().GetBool("debug")
 	if err != nil {
-		return err
+		return closeLog, false, err
 	}
 
 	if debugLevel {
 		zerolog.SetGlobalLevel(zerolog.DebugLevel)
 
-		runLog += " in debug mode"
-
 		// init logger
 		logfile, err := cmd.Flags().GetString("log-file")
 		if err != nil {
-			return err
+			return closeLog, false, err
 		}
 
 		logFD, err := os.OpenFile(logfile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, os.ModePerm)
 		if err != nil {
-			return err
+			return closeLog, false, err
 		}
 
-		defer logFD.Close()
-
+		closeLog = func() { logFD.Close() }
 		logOutput = logFD
 	}
 
@@ -68,54 +107,38 @@ func run(cmd *cobra.Command, args []string) error { //nolint:cyclop,revive
 
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: logOutput, TimeFormat: time.RFC3339})
 
-	log.Info().Msg(runLog)
-	// check if CONTAINER_PASSPHRASE environment variable is set and not empty
-	// otherwise set with value dummy value
-	// its required since podman/pkg/podman is using terminal package
-	// that is writing directly to os.Stdout and reading from os.Stdin
-	// for Phassphrase
-	setSSHIdentityPassphrase := true
-
-	v, found := os.LookupEnv("CONTAINER_PASSPHRASE")
-	if found {
-		if v != "" {
-			setSSHIdentityPassphrase = false
-		}
-	}
-
-	if setSSHIdentityPassphrase {
-		emptyPassphrase := "__empty__"
-
-		log.Debug().Msgf("env set CONTAINER_PASSPHRASE=%q", emptyPassphrase)
+	return closeLog, debugLevel, nil
+}
 
-		err := os.Setenv("CONTAINER_PASSPHRASE", emptyPassphrase)
-		if err != nil {
-			return err
-		}
+// setSSHIdentityPassphrase checks if CONTAINER_PASSPHRASE environment variable is set
+// and not empty otherwise set with value dummy value and returns the unset function.
+// its required since podman/pkg/podman is using terminal package
+// that is writing directly to os.Stdout and reading from os.Stdin
+// for Phassphrase.
+func setSSHIdentityPassphrase() (func(), error) {
+	if v, found := os.LookupEnv("CONTAINER_PASSPHRASE"); found && v != "" {
+		return func() {}, nil
 	}
 
-	app := app.NewApp(appName, appVersion)
-	if err := app.Run(); err != nil {
-		if setSSHIdentityPassphrase {
-			os.Unsetenv("CONTAINER_PASSPHRASE")
-		}
+	emptyPassphrase := "__empty__"
 
-		return err
-	}
+	log.Debug().Msgf("env set CONTAINER_PASSPHRASE=%q", emptyPassphrase)
 
-	// unset CONTAINER_PASSPHRASE environment variable if we have set it
-	// after application exits
-	if setSSHIdentityPassphrase {
-		os.Unsetenv("CONTAINER_PASSPHRASE")
+	if err := os.Setenv("CONTAINER_PASSPHRASE", emptyPassphrase); err != nil {
+		return func() {}, err
 	}
 
-	return nil
+	return func() { os.Unsetenv("CONTAINER_PASSPHRASE") }, nil
 }
 
 func init() {
 	rootCmd.CompletionOptions.DisableDefaultCmd = true
 	defaultLogFile := appName + ".log"
 
-	rootCmd.Flags().BoolP("debug", "d", false, "Run application in debug mode")
-	rootCmd.Flags().StringP("log-file", "l", defaultLogFile, "Application runtime log file")
+	rootCmd.PersistentFlags().BoolP("debug", "d", false, "Run application in debug mode")
+	rootCmd.PersistentFlags().StringP("log-file", "l", defaultLogFile, "Application runtime log file")
+	rootCmd.Flags().StringP("theme", "t", "",
+		"User interface theme (dark, light, high-contrast, monochrome or a theme from themes.conf)")
+	rootCmd.Flags().Duration("refresh-interval", 0,
+		"User interface and connections health check refresh interval (default 1s)")
 }
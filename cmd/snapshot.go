@@ -0,0 +1,302 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/containers/podman-tui/config"
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/containers/podman-tui/pdcs/images"
+	"github.com/containers/podman-tui/pdcs/networks"
+	"github.com/containers/podman-tui/pdcs/pods"
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/secrets"
+	putils "github.com/containers/podman-tui/pdcs/utils"
+	"github.com/containers/podman-tui/pdcs/volumes"
+	"github.com/docker/go-units"
+	"github.com/spf13/cobra"
+)
+
+var errUnknownSnapshotView = errors.New("unknown view")
+
+// snapshotViews list of the views which snapshot command supports.
+var snapshotViews = []string{"containers", "pods", "images", "volumes", "networks", "secrets"}
+
+// snapshotCmd represents the snapshot command.
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Print a view data and exit",
+	Long: "Print the data of a view (" + strings.Join(snapshotViews, ", ") + ") " +
+		"from the default or selected service connection without starting the user interface.",
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error { //nolint:revive
+		return runWithConfig(cmd, func(appConfig *config.Config) error {
+			view, err := cmd.Flags().GetString("view")
+			if err != nil {
+				return err
+			}
+
+			output, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+
+			connName, err := cmd.Flags().GetString("connection")
+			if err != nil {
+				return err
+			}
+
+			if err := setSnapshotConnection(appConfig, connName); err != nil {
+				return err
+			}
+
+			unsetPassphrase, err := setSSHIdentityPassphrase()
+			if err != nil {
+				return err
+			}
+
+			defer unsetPassphrase()
+
+			return snapshot(os.Stdout, view, output)
+		})
+	},
+}
+
+func setSnapshotConnection(appConfig *config.Config, name string) error {
+	if name == "" {
+		if !registry.ConnectionIsSet() {
+			return registry.ErrConnectionNotSelected
+		}
+
+		return nil
+	}
+
+	for _, conn := range appConfig.ServicesConnections() {
+		if conn.Name == name {
+			registry.SetConnection(conn)
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w %q", config.ErrServiceNotFound, name)
+}
+
+func snapshot(out io.Writer, view string, output string) error {
+	if output != outputFormatTable && output != outputFormatJSON {
+		return fmt.Errorf("%w %q (supported formats: %s, %s)",
+			errInvalidOutputFormat, output, outputFormatTable, outputFormatJSON)
+	}
+
+	var (
+		data   interface{}
+		header []string
+		rows   [][]string
+		err    error
+	)
+
+	switch view {
+	case "containers":
+		data, header, rows, err = containersSnapshot()
+	case "pods":
+		data, header, rows, err = podsSnapshot()
+	case "images":
+		data, header, rows, err = imagesSnapshot()
+	case "volumes":
+		data, header, rows, err = volumesSnapshot()
+	case "networks":
+		data, header, rows, err = networksSnapshot()
+	case "secrets":
+		data, header, rows, err = secretsSnapshot()
+	default:
+		return fmt.Errorf("%w %q (supported views: %s)",
+			errUnknownSnapshotView, view, strings.Join(snapshotViews, ", "))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return printSnapshot(out, output, data, header, rows)
+}
+
+// printSnapshot prints the view data as JSON or the view header and rows as a table.
+func printSnapshot(out io.Writer, output string, data interface{}, header []string, rows [][]string) error {
+	if output == outputFormatJSON {
+		jsonData, err := putils.GetJSONOutput(data)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(out, jsonData)
+
+		return nil
+	}
+
+	writer := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0) //nolint:gomnd
+
+	fmt.Fprintln(writer, strings.ToUpper(strings.Join(header, "\t")))
+
+	for _, row := range rows {
+		fmt.Fprintln(writer, strings.Join(row, "\t"))
+	}
+
+	return writer.Flush()
+}
+
+func containersSnapshot() (interface{}, []string, [][]string, error) {
+	cntList, err := containers.List()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	header := []string{"container id", "image", "pod", "created", "state", "names", "ports"}
+	rows := [][]string{}
+
+	for _, cnt := range cntList {
+		rows = append(rows, []string{
+			shortID(cnt.ID),
+			cnt.Image,
+			cnt.PodName,
+			humanDuration(cnt.Created),
+			cnt.State,
+			strings.Join(cnt.Names, ","),
+			putils.PortsToString(cnt.Ports),
+		})
+	}
+
+	return cntList, header, rows, nil
+}
+
+func podsSnapshot() (interface{}, []string, [][]string, error) {
+	podList, err := pods.List()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	header := []string{"pod id", "name", "status", "created", "infra id", "# of containers"}
+	rows := [][]string{}
+
+	for _, pod := range podList {
+		rows = append(rows, []string{
+			shortID(pod.Id),
+			pod.Name,
+			pod.Status,
+			humanDuration(pod.Created),
+			shortID(pod.InfraId),
+			strconv.Itoa(len(pod.Containers)),
+		})
+	}
+
+	return podList, header, rows, nil
+}
+
+func imagesSnapshot() (interface{}, []string, [][]string, error) {
+	imgList, err := images.List()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	header := []string{"repository", "tag", "image id", "created at", "size"}
+	rows := [][]string{}
+
+	for _, img := range imgList {
+		rows = append(rows, []string{
+			img.Repository,
+			img.Tag,
+			shortID(img.ID),
+			putils.CreatedToStr(img.Created),
+			putils.SizeToStr(img.Size),
+		})
+	}
+
+	return imgList, header, rows, nil
+}
+
+func volumesSnapshot() (interface{}, []string, [][]string, error) {
+	volList, err := volumes.List()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	header := []string{"driver", "volume name", "created at", "mount point"}
+	rows := [][]string{}
+
+	for _, vol := range volList {
+		rows = append(rows, []string{
+			vol.Driver,
+			vol.Name,
+			humanDuration(vol.CreatedAt),
+			vol.Mountpoint,
+		})
+	}
+
+	return volList, header, rows, nil
+}
+
+func networksSnapshot() (interface{}, []string, [][]string, error) {
+	netList, err := networks.List()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	header := []string{"id", "name", "driver"}
+	rows := [][]string{}
+
+	for _, net := range netList {
+		rows = append(rows, []string{shortID(net.ID), net.Name, net.Driver})
+	}
+
+	return netList, header, rows, nil
+}
+
+func secretsSnapshot() (interface{}, []string, [][]string, error) {
+	secList, err := secrets.List()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	header := []string{"id", "name", "driver", "created", "updated"}
+	rows := [][]string{}
+
+	for _, sec := range secList {
+		rows = append(rows, []string{
+			sec.ID,
+			sec.Spec.Name,
+			sec.Spec.Driver.Name,
+			humanDuration(sec.CreatedAt),
+			humanDuration(sec.UpdatedAt),
+		})
+	}
+
+	return secList, header, rows, nil
+}
+
+func shortID(id string) string {
+	idLength := 12
+
+	if len(id) > idLength {
+		return id[:idLength]
+	}
+
+	return id
+}
+
+func humanDuration(t time.Time) string {
+	return units.HumanDuration(time.Since(t)) + " ago"
+}
+
+func init() {
+	snapshotCmd.Flags().StringP("view", "v", "containers",
+		"View to print ("+strings.Join(snapshotViews, ", ")+")")
+	snapshotCmd.Flags().StringP("output", "o", outputFormatTable, "Output format (table or json)")
+	snapshotCmd.Flags().StringP("connection", "c", "", "Service connection name (default service connection if not set)")
+
+	rootCmd.AddCommand(snapshotCmd)
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/containers/podman-tui/config"
+	"github.com/containers/podman-tui/pdcs/registry"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("snapshot", func() {
+	header := []string{"id", "name", "driver"}
+	rows := [][]string{
+		{"2f259bab93aa", "podman", "bridge"},
+		{"8a0c6f33b5f4", "backend", "macvlan"},
+	}
+
+	It("print snapshot table", func() {
+		var out strings.Builder
+
+		Expect(printSnapshot(&out, outputFormatTable, nil, header, rows)).To(Succeed())
+
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		Expect(lines).To(HaveLen(3))
+		Expect(strings.Fields(lines[0])).To(Equal([]string{"ID", "NAME", "DRIVER"}))
+		Expect(strings.Fields(lines[2])).To(Equal(rows[1]))
+		// columns are aligned
+		Expect(strings.Index(lines[1], "podman")).To(Equal(strings.Index(lines[0], "NAME")))
+	})
+
+	It("print snapshot json", func() {
+		var out strings.Builder
+
+		data := []map[string]string{{"Name": "podman", "Driver": "bridge"}}
+
+		Expect(printSnapshot(&out, outputFormatJSON, data, header, rows)).To(Succeed())
+		Expect(out.String()).To(MatchJSON(`[{"Name": "podman", "Driver": "bridge"}]`))
+	})
+
+	It("snapshot invalid output format", func() {
+		var out strings.Builder
+
+		Expect(snapshot(&out, "networks", "yaml")).To(MatchError(errInvalidOutputFormat))
+	})
+
+	It("snapshot unknown view", func() {
+		var out strings.Builder
+
+		Expect(snapshot(&out, "machines", outputFormatTable)).To(MatchError(errUnknownSnapshotView))
+	})
+
+	It("snapshot connection", func() {
+		appConfig := &config.Config{
+			Services: map[string]config.Service{
+				"remote01": {URI: "tcp://localhost:8080"},
+			},
+		}
+
+		Expect(setSnapshotConnection(appConfig, "remote02")).To(MatchError(config.ErrServiceNotFound))
+		Expect(setSnapshotConnection(appConfig, "remote01")).To(Succeed())
+		Expect(registry.ConnectionName()).To(Equal("remote01"))
+	})
+
+	It("shortID", func() {
+		Expect(shortID("2f259bab93aaaf2f259bab93aaaf")).To(Equal("2f259bab93aa"))
+		Expect(shortID("2f259b")).To(Equal("2f259b"))
+	})
+})
@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/ui/utils"
 	"github.com/rs/zerolog/log"
 )
 
@@ -28,6 +29,7 @@ var (
 	ErrEmptyURIDestination     = errors.New("empty URI destination")
 	ErrEmptyServiceName        = errors.New("empty service name")
 	ErrDuplicatedServiceName   = errors.New("duplicated service name")
+	ErrServiceNotFound         = errors.New("service not found")
 )
 
 // Config contains configuration options for container tools.
@@ -35,6 +37,36 @@ type Config struct {
 	mu sync.Mutex
 	// Services specify the service destination connections
 	Services map[string]Service `toml:"services,omitempty"`
+	// Theme specify the user interface theme name, optional
+	Theme string `toml:"theme,omitempty"`
+	// RefreshInterval specify the user interface refresh interval (e.g. "2s"), optional
+	RefreshInterval string `toml:"refresh_interval,omitempty"`
+	// Keys specify the user interface key bindings (binding name = key name)
+	Keys map[string]string `toml:"keys,omitempty"`
+	// SecretRevealTimeout specify how long a revealed secret value is displayed (e.g. "10s"), optional
+	SecretRevealTimeout string `toml:"secret_reveal_timeout,omitempty"`
+	// SecretAuditLog specify the secret reveal audit log file path, optional
+	SecretAuditLog string `toml:"secret_audit_log,omitempty"`
+	// Notifications specify the podman event notification rules and options, optional
+	Notifications Notifications `toml:"notifications,omitempty"`
+}
+
+// Notifications represents the podman event notification rules and options.
+type Notifications struct {
+	// Disabled disables the event notifications, optional
+	Disabled bool `toml:"disabled,omitempty"`
+
+	// Bell rings the terminal bell on notification, optional
+	Bell bool `toml:"bell,omitempty"`
+
+	// Terminal emits the terminal desktop notification (osc9 or osc777), optional
+	Terminal string `toml:"terminal,omitempty"`
+
+	// Timeout specify how long the notification toast is displayed (e.g. "5s"), optional
+	Timeout string `toml:"timeout,omitempty"`
+
+	// Rules specify the notification rules (default rules are used if not set), optional
+	Rules []utils.NotificationRule `toml:"rules,omitempty"`
 }
 
 // Service represents remote service destination.
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"fmt"
+
 	"github.com/containers/podman-tui/pdcs/registry"
 	"github.com/rs/zerolog/log"
 )
@@ -24,6 +26,10 @@ func (c *Config) setDef(name string) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	if _, ok := c.Services[name]; !ok {
+		return fmt.Errorf("%w %q", ErrServiceNotFound, name)
+	}
+
 	for key := range c.Services {
 		dest := c.Services[key]
 		dest.Default = false
@@ -0,0 +1,23 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/rs/zerolog/log"
+)
+
+// SetKeyBindings applies the configuration user interface key bindings
+// (the keys section) over the default key bindings.
+func (c *Config) SetKeyBindings() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	log.Debug().Msgf("config: set %d key bindings", len(c.Keys))
+
+	if err := utils.SetKeyBindings(c.Keys); err != nil {
+		return fmt.Errorf("config: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package config
+
+import (
+	"github.com/containers/podman-tui/ui/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("key bindings", func() {
+	It("default key bindings", func() {
+		cfg := &Config{}
+		Expect(cfg.SetKeyBindings()).To(Succeed())
+	})
+
+	It("unknown key binding", func() {
+		cfg := &Config{Keys: map[string]string{"next_page": "n"}}
+		Expect(cfg.SetKeyBindings()).To(MatchError(utils.ErrUnknownKeyBinding))
+	})
+})
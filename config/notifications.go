@@ -0,0 +1,72 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/rs/zerolog/log"
+)
+
+var ErrInvalidNotificationTimeout = errors.New("invalid notification timeout")
+
+// GetNotificationRules returns the podman event notification rules, the configuration
+// notifications rules or the default rules (empty if the notifications are disabled).
+func (c *Config) GetNotificationRules() ([]utils.NotificationRule, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.Notifications.Disabled {
+		log.Debug().Msgf("config: event notifications disabled")
+
+		return nil, nil
+	}
+
+	if len(c.Notifications.Rules) == 0 {
+		return utils.DefaultNotificationRules, nil
+	}
+
+	for _, rule := range c.Notifications.Rules {
+		if err := rule.Validate(); err != nil {
+			return nil, fmt.Errorf("config: %w", err)
+		}
+	}
+
+	log.Debug().Msgf("config: event notification rules %v", c.Notifications.Rules)
+
+	return c.Notifications.Rules, nil
+}
+
+// GetNotificationTimeout returns how long a notification toast is displayed, the
+// configuration notifications timeout key or the default timeout.
+func (c *Config) GetNotificationTimeout() (time.Duration, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.Notifications.Timeout == "" {
+		return utils.NotificationTimeout, nil
+	}
+
+	timeout, err := time.ParseDuration(c.Notifications.Timeout)
+	if err != nil || timeout <= 0 {
+		return 0, fmt.Errorf("config: %w %q", ErrInvalidNotificationTimeout, c.Notifications.Timeout)
+	}
+
+	log.Debug().Msgf("config: notification timeout %v", timeout)
+
+	return timeout, nil
+}
+
+// GetNotificationTerminal returns whether the terminal bell is rung and the terminal
+// desktop notification (empty, osc9 or osc777) emitted on notification.
+func (c *Config) GetNotificationTerminal() (bool, string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := utils.ValidateNotificationTerminal(c.Notifications.Terminal); err != nil {
+		return false, "", fmt.Errorf("config: %w", err)
+	}
+
+	return c.Notifications.Bell, c.Notifications.Terminal, nil
+}
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/rs/zerolog/log"
+)
+
+// minRefreshInterval is the minimum user interface refresh interval.
+const minRefreshInterval = 100 * time.Millisecond
+
+var ErrInvalidRefreshInterval = errors.New("invalid refresh interval")
+
+// GetRefreshInterval returns the user interface and health check refresh interval.
+// The interval is selected from (in order) the interval argument (i.e. command line flag),
+// the configuration refresh_interval key or the default interval.
+func (c *Config) GetRefreshInterval(interval time.Duration) (time.Duration, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if interval == 0 && c.RefreshInterval != "" {
+		var err error
+
+		interval, err = time.ParseDuration(c.RefreshInterval)
+		if err != nil {
+			return 0, fmt.Errorf("config: %w %q", ErrInvalidRefreshInterval, c.RefreshInterval)
+		}
+	}
+
+	if interval == 0 {
+		interval = utils.RefreshInterval
+	}
+
+	if interval < minRefreshInterval {
+		return 0, fmt.Errorf("config: %w %v (minimum %v)", ErrInvalidRefreshInterval, interval, minRefreshInterval)
+	}
+
+	log.Debug().Msgf("config: refresh interval %v", interval)
+
+	return interval, nil
+}
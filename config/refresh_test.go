@@ -0,0 +1,51 @@
+package config
+
+import (
+	"time"
+
+	"github.com/containers/podman-tui/ui/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("refresh interval", func() {
+	It("default refresh interval", func() {
+		cfg := &Config{}
+		interval, err := cfg.GetRefreshInterval(0)
+		Expect(err).To(BeNil())
+		Expect(interval).To(Equal(utils.RefreshInterval))
+	})
+
+	It("configuration refresh interval", func() {
+		cfg := &Config{RefreshInterval: "2s"}
+		interval, err := cfg.GetRefreshInterval(0)
+		Expect(err).To(BeNil())
+		Expect(interval).To(Equal(2 * time.Second))
+	})
+
+	It("flag takes precedence over the configuration", func() {
+		cfg := &Config{RefreshInterval: "2s"}
+		interval, err := cfg.GetRefreshInterval(500 * time.Millisecond)
+		Expect(err).To(BeNil())
+		Expect(interval).To(Equal(500 * time.Millisecond))
+	})
+
+	It("invalid configuration refresh interval", func() {
+		cfg := &Config{RefreshInterval: "2 seconds"}
+		_, err := cfg.GetRefreshInterval(0)
+		Expect(err).To(MatchError(ErrInvalidRefreshInterval))
+	})
+
+	It("refresh interval below the minimum", func() {
+		cfg := &Config{RefreshInterval: "10ms"}
+		_, err := cfg.GetRefreshInterval(0)
+		Expect(err).To(MatchError(ErrInvalidRefreshInterval))
+
+		_, err = cfg.GetRefreshInterval(50 * time.Millisecond)
+		Expect(err).To(MatchError(ErrInvalidRefreshInterval))
+
+		interval, err := cfg.GetRefreshInterval(minRefreshInterval)
+		Expect(err).To(BeNil())
+		Expect(interval).To(Equal(minRefreshInterval))
+	})
+})
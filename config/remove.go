@@ -1,12 +1,18 @@
 package config
 
-import "github.com/rs/zerolog/log"
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
 
 // Remove removes a service from config.
 func (c *Config) Remove(name string) error {
 	log.Debug().Msgf("config: remove service %q", name)
 
-	c.remove(name)
+	if err := c.remove(name); err != nil {
+		return err
+	}
 
 	if err := c.Write(); err != nil {
 		return err
@@ -15,13 +21,15 @@ func (c *Config) Remove(name string) error {
 	return c.reload()
 }
 
-func (c *Config) remove(name string) {
+func (c *Config) remove(name string) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	for serviceName := range c.Services {
-		if serviceName == name {
-			delete(c.Services, name)
-		}
+	if _, ok := c.Services[name]; !ok {
+		return fmt.Errorf("%w %q", ErrServiceNotFound, name)
 	}
+
+	delete(c.Services, name)
+
+	return nil
 }
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/rs/zerolog/log"
+)
+
+var ErrInvalidSecretRevealTimeout = errors.New("invalid secret reveal timeout")
+
+// GetSecretRevealTimeout returns how long a revealed secret value is displayed, the
+// configuration secret_reveal_timeout key or the default timeout.
+func (c *Config) GetSecretRevealTimeout() (time.Duration, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.SecretRevealTimeout == "" {
+		return utils.SecretRevealTimeout, nil
+	}
+
+	timeout, err := time.ParseDuration(c.SecretRevealTimeout)
+	if err != nil || timeout <= 0 {
+		return 0, fmt.Errorf("config: %w %q", ErrInvalidSecretRevealTimeout, c.SecretRevealTimeout)
+	}
+
+	log.Debug().Msgf("config: secret reveal timeout %v", timeout)
+
+	return timeout, nil
+}
+
+// GetSecretAuditLog returns the secret reveal audit log file path (empty if the audit is disabled).
+func (c *Config) GetSecretAuditLog() (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.SecretAuditLog == "" {
+		return "", nil
+	}
+
+	auditLog, err := utils.ResolveHomeDir(c.SecretAuditLog)
+	if err != nil {
+		return "", fmt.Errorf("config: %w", err)
+	}
+
+	log.Debug().Msgf("config: secret audit log %q", auditLog)
+
+	return auditLog, nil
+}
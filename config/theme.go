@@ -0,0 +1,82 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// _themesFile is the themes file name next to podman-tui.conf.
+	_themesFile = "themes.conf"
+)
+
+// themesConfig contains the user defined themes.
+type themesConfig struct {
+	Themes map[string]style.Theme `toml:"themes,omitempty"`
+}
+
+// SetTheme applies the user interface theme.
+// The theme is selected from (in order) the name argument (i.e. command line flag),
+// NO_COLOR environment variable (monochrome theme), the configuration theme key or
+// the default theme.
+func (c *Config) SetTheme(name string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if name == "" {
+		name = c.Theme
+		if noColor := os.Getenv("NO_COLOR"); noColor != "" {
+			name = style.MonochromeTheme
+		}
+	}
+
+	if name == "" {
+		name = style.DefaultTheme
+	}
+
+	log.Debug().Msgf("config: set %q theme", name)
+
+	themes, err := readThemes()
+	if err != nil {
+		return err
+	}
+
+	if err := style.SetTheme(name, themes); err != nil {
+		return fmt.Errorf("config: %w", err)
+	}
+
+	return nil
+}
+
+// readThemes reads the user defined themes from the themes file
+// next to the configuration file.
+func readThemes() (map[string]style.Theme, error) {
+	path, err := configPath()
+	if err != nil {
+		return nil, err
+	}
+
+	path = filepath.Join(filepath.Dir(path), _themesFile)
+
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, err
+	}
+
+	log.Debug().Msgf("config: reading themes file %q", path)
+
+	themes := themesConfig{}
+	if _, err := toml.DecodeFile(path, &themes); err != nil {
+		return nil, fmt.Errorf("config: %w decode themes %q", err, path)
+	}
+
+	return themes.Themes, nil
+}
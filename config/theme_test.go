@@ -0,0 +1,63 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("theme", func() {
+	var themesFile string
+
+	BeforeEach(func() {
+		configHome := GinkgoT().TempDir()
+		GinkgoT().Setenv("XDG_CONFIG_HOME", configHome)
+		GinkgoT().Setenv("NO_COLOR", "")
+
+		themesFile = filepath.Join(configHome, filepath.Dir(_configPath), _themesFile)
+		Expect(os.MkdirAll(filepath.Dir(themesFile), 0o755)).To(Succeed())
+	})
+
+	It("load user defined theme", func() {
+		themes := "[themes.solarized]\nbase = \"light\"\nbg = \"#002b36\"\n"
+		Expect(os.WriteFile(themesFile, []byte(themes), 0o600)).To(Succeed())
+
+		cfg := &Config{Theme: "solarized"}
+		Expect(cfg.SetTheme("")).To(Succeed())
+		Expect(style.BgColor).To(Equal(tcell.GetColor("#002b36")))
+		Expect(style.FgColor).To(Equal(tcell.ColorBlack))
+	})
+
+	It("theme flag takes precedence over the configuration", func() {
+		cfg := &Config{Theme: "light"}
+		Expect(cfg.SetTheme("high-contrast")).To(Succeed())
+		Expect(style.BorderColor).To(Equal(tcell.ColorYellow))
+	})
+
+	It("NO_COLOR selects the monochrome theme", func() {
+		GinkgoT().Setenv("NO_COLOR", "1")
+
+		cfg := &Config{Theme: "light"}
+		Expect(cfg.SetTheme("")).To(Succeed())
+		Expect(style.RunningStatusFgColor).To(Equal(tcell.ColorWhite))
+	})
+
+	It("invalid themes file", func() {
+		Expect(os.WriteFile(themesFile, []byte("[themes.solarized\n"), 0o600)).To(Succeed())
+
+		cfg := &Config{}
+		Expect(cfg.SetTheme("")).NotTo(Succeed())
+	})
+
+	It("invalid theme color", func() {
+		themes := "[themes.solarized]\nbg = \"not-a-color\"\n"
+		Expect(os.WriteFile(themesFile, []byte(themes), 0o600)).To(Succeed())
+
+		cfg := &Config{}
+		Expect(cfg.SetTheme("solarized")).To(MatchError(style.ErrInvalidThemeColor))
+	})
+})
@@ -0,0 +1,53 @@
+package containers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman/v5/pkg/bindings/containers"
+	"github.com/rs/zerolog/log"
+)
+
+var ErrUnknownBulkCommand = errors.New("unknown bulk command")
+
+// BulkCommandByConnection runs the bulk command (kill, pause, restart, rm, start, stop or unpause)
+// for the container of the named connection.
+func BulkCommandByConnection(name string, cmd string, id string) error {
+	log.Debug().Msgf("pdcs: podman container %s %s (connection=%s)", cmd, id, name)
+
+	conn, err := registry.GetConnectionByName(name)
+	if err != nil {
+		return err
+	}
+
+	switch cmd {
+	case "kill":
+		return containers.Kill(conn, id, new(containers.KillOptions))
+	case "pause":
+		return containers.Pause(conn, id, new(containers.PauseOptions))
+	case "restart":
+		return containers.Restart(conn, id, new(containers.RestartOptions).WithTimeout(DefaultRestartTimeout))
+	case "rm":
+		response, err := containers.Remove(conn, id, new(containers.RemoveOptions))
+		if err != nil {
+			return err
+		}
+
+		for _, rmReport := range response {
+			if rmReport != nil && rmReport.Err != nil {
+				return fmt.Errorf("error removing %s: %w", rmReport.Id, rmReport.Err)
+			}
+		}
+
+		return nil
+	case "start":
+		return containers.Start(conn, id, new(containers.StartOptions))
+	case "stop":
+		return containers.Stop(conn, id, new(containers.StopOptions))
+	case "unpause":
+		return containers.Unpause(conn, id, new(containers.UnpauseOptions))
+	}
+
+	return fmt.Errorf("%w %q", ErrUnknownBulkCommand, cmd)
+}
@@ -0,0 +1,517 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/containers/common/libnetwork/types"
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/utils"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/bindings/containers"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/specgen"
+	"github.com/containers/podman/v5/pkg/specgenutil"
+	"github.com/rs/zerolog/log"
+)
+
+// cloneNameSuffix is the suffix of the clone default name.
+const cloneNameSuffix = "-clone"
+
+// CntCloneOptions container clone options, the empty values are copied from the original container.
+type CntCloneOptions struct {
+	Name    string
+	Image   string
+	CPUs    string
+	Memory  string
+	Pod     string
+	Destroy bool
+	Start   bool
+}
+
+// Clone creates a copy of the container with the same configuration (read from its inspect data)
+// and returns the new container ID. The original container is removed if destroy option is set and
+// the copy is started if start option is set.
+func Clone(id string, opts CntCloneOptions) (string, error) {
+	log.Debug().Msgf("pdcs: podman container clone %s %v", id, opts)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return "", err
+	}
+
+	data, err := containers.Inspect(conn, id, new(containers.InspectOptions))
+	if err != nil {
+		return "", err
+	}
+
+	if opts.Name == "" {
+		opts.Name, err = CloneName(data.Name)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if opts.Image == "" {
+		opts.Image = data.ImageName
+	}
+
+	spec, err := cloneSpecGenerator(data, opts, false)
+	if err != nil {
+		return "", err
+	}
+
+	response, err := containers.CreateWithSpec(conn, spec, &containers.CreateOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	if opts.Destroy {
+		removeOpts := new(containers.RemoveOptions).WithForce(true)
+
+		if _, err := containers.Remove(conn, data.ID, removeOpts); err != nil {
+			return response.ID, err
+		}
+	}
+
+	if opts.Start {
+		if err := containers.Start(conn, response.ID, new(containers.StartOptions)); err != nil {
+			return response.ID, err
+		}
+	}
+
+	return response.ID, nil
+}
+
+// Recreate creates the container again with the same name and configuration (read from its
+// inspect data, e.g. to apply a replaced secret value which podman reads when the container is
+// created), the original container is removed once the new container spec is built and the new
+// container is started if the original container was running. It returns the new container ID.
+func Recreate(id string) (string, error) {
+	log.Debug().Msgf("pdcs: podman container recreate %s", id)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return "", err
+	}
+
+	data, err := containers.Inspect(conn, id, new(containers.InspectOptions))
+	if err != nil {
+		return "", err
+	}
+
+	running := data.State != nil && data.State.Running
+
+	spec, err := cloneSpecGenerator(data, CntCloneOptions{Name: data.Name, Image: data.ImageName}, true)
+	if err != nil {
+		return "", err
+	}
+
+	// the original container is removed first to release its name and static addresses
+	if _, err := containers.Remove(conn, data.ID, new(containers.RemoveOptions).WithForce(true)); err != nil {
+		return "", err
+	}
+
+	response, err := containers.CreateWithSpec(conn, spec, &containers.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("%w: %s removed and not created again", err, data.Name)
+	}
+
+	if running {
+		if err := containers.Start(conn, response.ID, new(containers.StartOptions)); err != nil {
+			return response.ID, err
+		}
+	}
+
+	return response.ID, nil
+}
+
+// CloneDefaults returns the container clone default options: the clone name, the
+// original container image and its CPUs and memory limits.
+func CloneDefaults(id string) (CntCloneOptions, error) {
+	var opts CntCloneOptions
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return opts, err
+	}
+
+	data, err := containers.Inspect(conn, id, new(containers.InspectOptions))
+	if err != nil {
+		return opts, err
+	}
+
+	opts.Name, err = CloneName(data.Name)
+	if err != nil {
+		return opts, err
+	}
+
+	opts.Image = data.ImageName
+
+	if data.HostConfig != nil {
+		resources := hostConfigResources(data.HostConfig)
+		opts.CPUs = resources.CPUs
+		opts.Memory = resources.Memory
+	}
+
+	return opts, nil
+}
+
+// CloneName returns the default clone name of the container (name-clone, name-clone1, ...).
+func CloneName(name string) (string, error) {
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return "", err
+	}
+
+	cloneName := name + cloneNameSuffix
+
+	for index := 1; ; index++ {
+		exist, err := containers.Exists(conn, cloneName, new(containers.ExistsOptions))
+		if err != nil {
+			return "", err
+		}
+
+		if !exist {
+			return cloneName, nil
+		}
+
+		cloneName = fmt.Sprintf("%s%s%d", name, cloneNameSuffix, index)
+	}
+}
+
+// cloneSpecGenerator returns the container create spec from its inspect data, the static
+// network addresses are kept if the container is recreated.
+func cloneSpecGenerator(data *define.InspectContainerData, opts CntCloneOptions, recreate bool) (*specgen.SpecGenerator, error) { //nolint:cyclop,gocognit,lll
+	var createOptions entities.ContainerCreateOptions
+
+	utils.DefineCreateDefaults(&createOptions)
+
+	createOptions.Name = opts.Name
+	createOptions.HealthCmd = "none"
+	createOptions.HealthInterval = define.DefaultHealthCheckInterval
+	createOptions.HealthTimeout = define.DefaultHealthCheckTimeout
+	createOptions.HealthStartPeriod = define.DefaultHealthCheckStartPeriod
+	createOptions.HealthRetries = define.DefaultHealthCheckRetries
+	createOptions.Net = &entities.NetOptions{Networks: make(map[string]types.PerNetworkOptions)}
+
+	if data.Config != nil {
+		cloneConfigOptions(&createOptions, data.Config)
+	}
+
+	if data.HostConfig != nil {
+		if err := cloneHostConfigOptions(&createOptions, data.HostConfig); err != nil {
+			return nil, err
+		}
+	}
+
+	// the pod owns the network of its containers
+	switch {
+	case opts.Pod != "":
+		createOptions.Pod = opts.Pod
+		createOptions.Net = &entities.NetOptions{Networks: make(map[string]types.PerNetworkOptions)}
+		createOptions.Net.Network.NSMode = specgen.FromPod
+	case data.Pod != "":
+		createOptions.Pod = data.Pod
+		createOptions.Net = &entities.NetOptions{Networks: make(map[string]types.PerNetworkOptions)}
+		createOptions.Net.Network.NSMode = specgen.FromPod
+	default:
+		if err := cloneNetworkOptions(createOptions.Net, data, recreate); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.CPUs != "" {
+		cpus, err := strconv.ParseFloat(opts.CPUs, 64)
+		if err != nil || cpus <= 0 {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidUpdateCPUs, opts.CPUs)
+		}
+
+		createOptions.CPUS = cpus
+		createOptions.CPUQuota = 0
+		createOptions.CPUPeriod = 0
+	}
+
+	if opts.Memory != "" {
+		createOptions.Memory = opts.Memory
+	}
+
+	// the command line arguments are the image followed by the command
+	args := []string{opts.Image}
+	if data.Config != nil {
+		args = append(args, data.Config.Cmd...)
+	}
+
+	spec := specgen.NewSpecGenerator(opts.Image, false)
+	if err := specgenutil.FillOutSpecGen(spec, &createOptions, args); err != nil {
+		return nil, err
+	}
+
+	spec.Image = opts.Image
+	spec.RawImageName = opts.Image
+
+	if data.Config != nil && len(data.Config.Entrypoint) > 0 {
+		spec.Entrypoint = data.Config.Entrypoint
+	}
+
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	return spec, nil
+}
+
+func cloneConfigOptions(createOptions *entities.ContainerCreateOptions, config *define.InspectContainerConfig) {
+	for _, env := range config.Env {
+		// the hostname is set by podman for each container
+		if strings.HasPrefix(env, "HOSTNAME=") {
+			continue
+		}
+
+		createOptions.Env = append(createOptions.Env, env)
+	}
+
+	for key, value := range config.Labels {
+		createOptions.Label = append(createOptions.Label, key+"="+value)
+	}
+
+	sort.Strings(createOptions.Label)
+
+	for key, value := range config.Annotations {
+		// the podman internal annotations are set by podman for each container
+		if strings.HasPrefix(key, "io.podman.annotations.") || key == "io.container.manager" {
+			continue
+		}
+
+		createOptions.Annotation = append(createOptions.Annotation, key+"="+value)
+	}
+
+	sort.Strings(createOptions.Annotation)
+
+	// the secrets options of the podman create command keep their type (mount or env) and
+	// target, else the secrets are mounted with their default target (the target and the
+	// environment variable secrets are not part of the inspect data)
+	createOptions.Secrets = utils.CreateCommandOptions(config.CreateCommand, "--secret")
+
+	if len(createOptions.Secrets) == 0 {
+		for _, secret := range config.Secrets {
+			createOptions.Secrets = append(createOptions.Secrets,
+				fmt.Sprintf("%s,uid=%d,gid=%d,mode=%o", secret.Name, secret.UID, secret.GID, secret.Mode))
+		}
+	}
+
+	// the sysctls are not part of the inspect data
+	createOptions.Sysctl = utils.CreateCommandOptions(config.CreateCommand, "--sysctl")
+
+	createOptions.Workdir = config.WorkingDir
+	createOptions.User = config.User
+	createOptions.Umask = config.Umask
+	createOptions.TTY = config.Tty
+	createOptions.Interactive = config.OpenStdin
+	createOptions.StopSignal = config.StopSignal
+	createOptions.StopTimeout = config.StopTimeout
+	createOptions.Timeout = config.Timeout
+	createOptions.HealthOnFailure = config.HealthcheckOnFailureAction
+
+	healthCheck := config.Healthcheck
+	if healthCheck == nil || len(healthCheck.Test) == 0 {
+		return
+	}
+
+	switch healthCheck.Test[0] {
+	case "NONE":
+		return
+	case "CMD-SHELL":
+		createOptions.HealthCmd = strings.Join(healthCheck.Test[1:], " ")
+	case "CMD":
+		healthCmd, err := json.Marshal(healthCheck.Test[1:])
+		if err != nil {
+			return
+		}
+
+		createOptions.HealthCmd = string(healthCmd)
+	default:
+		createOptions.HealthCmd = strings.Join(healthCheck.Test, " ")
+	}
+
+	if healthCheck.Interval > 0 {
+		createOptions.HealthInterval = healthCheck.Interval.String()
+	}
+
+	if healthCheck.Timeout > 0 {
+		createOptions.HealthTimeout = healthCheck.Timeout.String()
+	}
+
+	if healthCheck.StartPeriod > 0 {
+		createOptions.HealthStartPeriod = healthCheck.StartPeriod.String()
+	}
+
+	if healthCheck.Retries > 0 {
+		createOptions.HealthRetries = uint(healthCheck.Retries)
+	}
+}
+
+func cloneHostConfigOptions(createOptions *entities.ContainerCreateOptions, hostConfig *define.InspectContainerHostConfig) error { //nolint:lll
+	createOptions.Volume = hostConfig.Binds
+	createOptions.Privileged = hostConfig.Privileged
+	createOptions.ReadOnly = hostConfig.ReadonlyRootfs
+	createOptions.Rm = hostConfig.AutoRemove
+	createOptions.CapAdd = hostConfig.CapAdd
+	createOptions.CapDrop = hostConfig.CapDrop
+	createOptions.SecurityOpt = hostConfig.SecurityOpt
+
+	for _, device := range hostConfig.Devices {
+		dev := device.PathOnHost + ":" + device.PathInContainer
+		if device.CgroupPermissions != "" {
+			dev += ":" + device.CgroupPermissions
+		}
+
+		createOptions.Devices = append(createOptions.Devices, dev)
+	}
+
+	for _, ulimit := range hostConfig.Ulimits {
+		name := strings.ToLower(strings.TrimPrefix(ulimit.Name, "RLIMIT_"))
+		createOptions.Ulimit = append(createOptions.Ulimit, fmt.Sprintf("%s=%d:%d", name, ulimit.Soft, ulimit.Hard))
+	}
+
+	for path, options := range hostConfig.Tmpfs {
+		tmpfs := path
+		if options != "" {
+			tmpfs += ":" + options
+		}
+
+		createOptions.TmpFS = append(createOptions.TmpFS, tmpfs)
+	}
+
+	if hostConfig.RestartPolicy != nil && hostConfig.RestartPolicy.Name != "" {
+		createOptions.Restart = hostConfig.RestartPolicy.Name
+
+		if hostConfig.RestartPolicy.MaximumRetryCount > 0 {
+			createOptions.Restart += fmt.Sprintf(":%d", hostConfig.RestartPolicy.MaximumRetryCount)
+		}
+	}
+
+	// resources
+	if hostConfig.CpuQuota > 0 && hostConfig.CpuPeriod > 0 {
+		createOptions.CPUQuota = hostConfig.CpuQuota
+		createOptions.CPUPeriod = hostConfig.CpuPeriod
+	}
+
+	createOptions.CPUShares = hostConfig.CpuShares
+
+	if hostConfig.Memory > 0 {
+		createOptions.Memory = strconv.FormatInt(hostConfig.Memory, 10)
+	}
+
+	if hostConfig.MemorySwap != 0 {
+		createOptions.MemorySwap = strconv.FormatInt(hostConfig.MemorySwap, 10)
+	}
+
+	if hostConfig.PidsLimit != 0 {
+		pidsLimit := hostConfig.PidsLimit
+		createOptions.PIDsLimit = &pidsLimit
+	}
+
+	if hostConfig.BlkioWeight > 0 {
+		createOptions.BlkIOWeight = strconv.FormatUint(uint64(hostConfig.BlkioWeight), 10)
+	}
+
+	// DNS and hosts
+	for _, dnsServer := range hostConfig.Dns {
+		addr := net.ParseIP(dnsServer)
+		if addr == nil {
+			return fmt.Errorf("%w: %s", utils.ErrInvalidDNSAddress, dnsServer)
+		}
+
+		createOptions.Net.DNSServers = append(createOptions.Net.DNSServers, addr)
+	}
+
+	createOptions.Net.DNSOptions = hostConfig.DnsOptions
+	createOptions.Net.DNSSearch = hostConfig.DnsSearch
+	createOptions.Net.AddHosts = hostConfig.ExtraHosts
+
+	return nil
+}
+
+func cloneNetworkOptions(netOptions *entities.NetOptions, data *define.InspectContainerData, staticAddresses bool) error { //nolint:cyclop,lll
+	var err error
+
+	if data.HostConfig != nil {
+		var publish []string
+
+		for containerPort, hostPorts := range data.HostConfig.PortBindings {
+			for _, hostPort := range hostPorts {
+				publish = append(publish, publishPort(hostPort, containerPort))
+			}
+		}
+
+		if len(publish) > 0 {
+			sort.Strings(publish)
+
+			netOptions.PublishPorts, err = specgenutil.CreatePortBindings(publish)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	networkMode := ""
+	if data.HostConfig != nil {
+		networkMode = data.HostConfig.NetworkMode
+	}
+
+	// the bridge mode containers are connected to their networks
+	if networkMode == "" || networkMode == string(specgen.Bridge) {
+		netOptions.Network.NSMode = specgen.Bridge
+
+		if data.NetworkSettings != nil {
+			for name, network := range data.NetworkSettings.Networks {
+				netOptions.Networks[name] = types.PerNetworkOptions{}
+
+				if staticAddresses && network != nil {
+					netOptions.Networks[name] = networkAddresses(network)
+				}
+			}
+		}
+
+		return nil
+	}
+
+	netOptions.Network, netOptions.Networks, netOptions.NetworkOptions, err = specgen.ParseNetworkFlag(
+		[]string{networkMode})
+
+	return err
+}
+
+// networkAddresses returns the network options with the container addresses as static addresses.
+func networkAddresses(network *define.InspectAdditionalNetwork) types.PerNetworkOptions {
+	var opts types.PerNetworkOptions
+
+	for _, address := range []string{network.IPAddress, network.GlobalIPv6Address} {
+		if ip := net.ParseIP(address); ip != nil {
+			opts.StaticIPs = append(opts.StaticIPs, ip)
+		}
+	}
+
+	if mac, err := net.ParseMAC(network.MacAddress); err == nil {
+		opts.StaticMAC = types.HardwareAddr(mac)
+	}
+
+	return opts
+}
+
+// publishPort returns the port binding in the publish format [[ip:][hostPort]:]containerPort[/protocol].
+func publishPort(hostPort define.InspectHostPort, containerPort string) string {
+	switch {
+	case hostPort.HostIP != "":
+		return fmt.Sprintf("%s:%s:%s", hostPort.HostIP, hostPort.HostPort, containerPort)
+	case hostPort.HostPort != "":
+		return fmt.Sprintf("%s:%s", hostPort.HostPort, containerPort)
+	default:
+		return containerPort
+	}
+}
@@ -0,0 +1,78 @@
+package containers
+
+import (
+	"net"
+
+	"github.com/containers/podman/v5/libpod/define"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("container clone", func() {
+	data := &define.InspectContainerData{
+		ID:        "cntID",
+		Name:      "db",
+		ImageName: "docker.io/library/postgres:latest",
+		Config: &define.InspectContainerConfig{
+			Cmd: []string{"postgres"},
+			Env: []string{"HOSTNAME=cntID", "PGDATA=/data"},
+			Annotations: map[string]string{
+				"io.podman.annotations.autoremove": "FALSE",
+				"io.container.manager":             "libpod",
+				"org.example.owner":                "dba",
+			},
+			CreateCommand: []string{
+				"podman", "run", "-d", "--name", "db",
+				"--secret", "db-password,type=env,target=DB_PASSWORD",
+				"--sysctl", "net.ipv4.ip_forward=1",
+				"docker.io/library/postgres:latest",
+			},
+		},
+		HostConfig: &define.InspectContainerHostConfig{
+			NetworkMode: "bridge",
+			Devices: []define.InspectDevice{
+				{PathOnHost: "/dev/fuse", PathInContainer: "/dev/fuse", CgroupPermissions: "rwm"},
+			},
+			Ulimits: []define.InspectUlimit{{Name: "RLIMIT_NOFILE", Soft: 1024, Hard: 2048}},
+		},
+		NetworkSettings: &define.InspectNetworkSettings{
+			Networks: map[string]*define.InspectAdditionalNetwork{
+				"podman": {
+					InspectBasicNetworkConfig: define.InspectBasicNetworkConfig{
+						IPAddress:  "10.88.0.5",
+						MacAddress: "4a:1c:3e:9f:00:01",
+					},
+				},
+			},
+		},
+	}
+
+	It("recreate spec", func() {
+		spec, err := cloneSpecGenerator(data, CntCloneOptions{Name: data.Name, Image: data.ImageName}, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(spec.Name).To(Equal("db"))
+		Expect(spec.Env).To(HaveKeyWithValue("PGDATA", "/data"))
+		Expect(spec.Env).NotTo(HaveKey("HOSTNAME"))
+		Expect(spec.Annotations).To(HaveKeyWithValue("org.example.owner", "dba"))
+		Expect(spec.Annotations).NotTo(HaveKey("io.container.manager"))
+		Expect(spec.EnvSecrets).To(HaveKeyWithValue("DB_PASSWORD", "db-password"))
+		Expect(spec.Sysctl).To(HaveKeyWithValue("net.ipv4.ip_forward", "1"))
+		Expect(spec.Devices).To(HaveLen(1))
+		Expect(spec.Devices[0].Path).To(Equal("/dev/fuse:/dev/fuse:rwm"))
+		Expect(spec.Rlimits).To(HaveLen(1))
+		Expect(spec.Rlimits[0].Type).To(Equal("nofile"))
+		Expect(spec.Networks).To(HaveKey("podman"))
+		Expect(spec.Networks["podman"].StaticIPs).To(HaveLen(1))
+		Expect(spec.Networks["podman"].StaticIPs[0].String()).To(Equal("10.88.0.5"))
+		Expect(net.HardwareAddr(spec.Networks["podman"].StaticMAC).String()).To(Equal("4a:1c:3e:9f:00:01"))
+	})
+
+	It("clone spec", func() {
+		spec, err := cloneSpecGenerator(data, CntCloneOptions{Name: "db-clone", Image: data.ImageName}, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(spec.Name).To(Equal("db-clone"))
+		Expect(spec.Networks).To(HaveKey("podman"))
+		Expect(spec.Networks["podman"].StaticIPs).To(BeEmpty())
+		Expect(spec.Networks["podman"].StaticMAC).To(BeEmpty())
+	})
+})
@@ -0,0 +1,430 @@
+package containers
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman/v5/pkg/bindings/containers"
+	"github.com/containers/podman/v5/pkg/copy"
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/rs/zerolog/log"
+)
+
+// copyProgressInterval is the minimum interval between two copy progress reports.
+const copyProgressInterval = 200 * time.Millisecond
+
+var (
+	ErrCntCopyCanceled       = errors.New("container copy canceled")
+	ErrEmptyCopySource       = errors.New("empty copy source path")
+	ErrEmptyCopyDestination  = errors.New("empty copy destination path")
+	ErrCopyDestinationNotDir = errors.New("copy destination is not a directory")
+	ErrInvalidCopyArchive    = errors.New("invalid archive entry path")
+)
+
+// CntCopyOptions container copy options.
+type CntCopyOptions struct {
+	// ToContainer copies the local source path into the container destination path,
+	// otherwise the container source path is copied to the local destination path.
+	ToContainer bool
+	Source      string
+	Destination string
+}
+
+// CntCopyProgress container copy progress report.
+type CntCopyProgress struct {
+	File    string
+	Files   int
+	Current int64
+	Total   int64
+}
+
+// copyProgressTracker counts the copied files and bytes and reports them.
+type copyProgressTracker struct {
+	progress     CntCopyProgress
+	handler      func(CntCopyProgress)
+	lastReported time.Time
+}
+
+func (tracker *copyProgressTracker) file(name string) {
+	tracker.progress.File = name
+	tracker.progress.Files++
+	tracker.report(false)
+}
+
+func (tracker *copyProgressTracker) Write(p []byte) (int, error) {
+	tracker.progress.Current += int64(len(p))
+	tracker.report(false)
+
+	return len(p), nil
+}
+
+func (tracker *copyProgressTracker) report(force bool) {
+	if tracker.handler == nil {
+		return
+	}
+
+	if !force && time.Since(tracker.lastReported) < copyProgressInterval {
+		return
+	}
+
+	tracker.lastReported = time.Now()
+	tracker.handler(tracker.progress)
+}
+
+// Copy copies files or directories between the local machine and the container and reports
+// the copy progress to the progress handler until its finished or canceled (the cancel channel
+// receives or is closed).
+func Copy(id string, opts CntCopyOptions, progress func(CntCopyProgress), cancelChan chan bool) error {
+	log.Debug().Msgf("pdcs: podman container cp %s %v", id, opts)
+
+	if opts.Source == "" {
+		return ErrEmptyCopySource
+	}
+
+	if opts.Destination == "" {
+		return ErrEmptyCopyDestination
+	}
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(conn)
+	defer cancel()
+
+	go func() {
+		select {
+		case <-cancelChan:
+			log.Debug().Msgf("pdcs: podman container cp %s canceled", id)
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	tracker := &copyProgressTracker{handler: progress}
+
+	if opts.ToContainer {
+		err = copyToContainer(ctx, id, opts, tracker)
+	} else {
+		err = copyFromContainer(ctx, id, opts, tracker)
+	}
+
+	if err != nil && errors.Is(ctx.Err(), context.Canceled) {
+		return ErrCntCopyCanceled
+	}
+
+	if err == nil {
+		tracker.report(true)
+	}
+
+	return err
+}
+
+// copyToContainer writes the local source path as tar archive to the container archive endpoint.
+func copyToContainer(ctx context.Context, id string, opts CntCopyOptions, tracker *copyProgressTracker) error {
+	srcInfo, err := os.Lstat(opts.Source)
+	if err != nil {
+		return err
+	}
+
+	if srcInfo.IsDir() {
+		tracker.progress.Total, err = dirSize(opts.Source)
+		if err != nil {
+			return err
+		}
+	} else {
+		tracker.progress.Total = srcInfo.Size()
+	}
+
+	// copy into the destination directory if it exists otherwise to the destination path
+	extractDir := opts.Destination
+	archiveName := filepath.Base(opts.Source)
+
+	destStat, err := containers.Stat(ctx, id, opts.Destination)
+
+	switch {
+	case err == nil && destStat != nil && destStat.IsDir:
+	case err == nil:
+		if srcInfo.IsDir() {
+			return fmt.Errorf("%w: %s", ErrCopyDestinationNotDir, opts.Destination)
+		}
+
+		extractDir = path.Dir(opts.Destination)
+		archiveName = path.Base(opts.Destination)
+	case errors.Is(err, copy.ErrENOENT) && !strings.HasSuffix(opts.Destination, "/"):
+		extractDir = path.Dir(opts.Destination)
+		archiveName = path.Base(opts.Destination)
+	default:
+		return err
+	}
+
+	reader, writer := io.Pipe()
+	defer reader.Close()
+
+	go func() {
+		writer.CloseWithError(writeTarArchive(ctx, writer, opts.Source, archiveName, tracker))
+	}()
+
+	copyFunc, err := containers.CopyFromArchive(ctx, id, extractDir, reader)
+	if err != nil {
+		return err
+	}
+
+	return copyFunc()
+}
+
+// copyFromContainer reads the container source path tar archive and extracts it to the local destination path.
+func copyFromContainer(ctx context.Context, id string, opts CntCopyOptions, tracker *copyProgressTracker) error {
+	srcStat, err := containers.Stat(ctx, id, opts.Source)
+	if err != nil {
+		return err
+	}
+
+	// the directory archive size is unknown
+	if !srcStat.IsDir {
+		tracker.progress.Total = srcStat.Size
+	}
+
+	// extract into the destination directory if it exists otherwise to the destination path
+	extractDir := opts.Destination
+	archiveName := srcStat.Name
+	rename := ""
+
+	destInfo, err := os.Stat(opts.Destination)
+
+	switch {
+	case err == nil && destInfo.IsDir():
+	case err == nil:
+		if srcStat.IsDir {
+			return fmt.Errorf("%w: %s", ErrCopyDestinationNotDir, opts.Destination)
+		}
+
+		extractDir = filepath.Dir(opts.Destination)
+		rename = filepath.Base(opts.Destination)
+	case errors.Is(err, os.ErrNotExist):
+		extractDir = filepath.Dir(opts.Destination)
+		rename = filepath.Base(opts.Destination)
+	default:
+		return err
+	}
+
+	reader, writer := io.Pipe()
+	defer reader.Close()
+
+	copyFunc, err := containers.CopyToArchive(ctx, id, opts.Source, writer)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		writer.CloseWithError(copyFunc())
+	}()
+
+	return extractTarArchive(ctx, reader, extractDir, archiveName, rename, tracker)
+}
+
+// writeTarArchive writes the source path files as tar archive with the archive name as root entry.
+func writeTarArchive(ctx context.Context, writer io.Writer, source string, archiveName string, tracker *copyProgressTracker) error { //nolint:lll
+	tarWriter := tar.NewWriter(writer)
+
+	err := filepath.Walk(source, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		relPath, err := filepath.Rel(source, filePath)
+		if err != nil {
+			return err
+		}
+
+		link := ""
+		if info.Mode()&os.ModeSymlink != 0 {
+			if link, err = os.Readlink(filePath); err != nil {
+				return err
+			}
+		}
+
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+
+		header.Name = path.Join(archiveName, filepath.ToSlash(relPath))
+		if info.IsDir() {
+			header.Name += "/"
+		}
+
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		tracker.file(header.Name)
+
+		return copyFile(io.MultiWriter(tarWriter, tracker), filePath)
+	})
+	if err != nil {
+		return err
+	}
+
+	return tarWriter.Close()
+}
+
+func copyFile(writer io.Writer, filePath string) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	_, err = io.Copy(writer, file)
+
+	return err
+}
+
+// extractTarArchive extracts the tar archive into the extract directory, the archive root entry
+// is renamed if rename is set.
+func extractTarArchive(ctx context.Context, reader io.Reader, extractDir string, archiveName string, rename string, tracker *copyProgressTracker) error { //nolint:lll,cyclop
+	tarReader := tar.NewReader(reader)
+	extractDir = filepath.Clean(extractDir)
+
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		target, err := extractTarget(extractDir, header.Name, archiveName, rename)
+		if err != nil {
+			return err
+		}
+
+		mode := header.FileInfo().Mode()
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, mode.Perm()); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			tracker.file(header.Name)
+
+			if err := extractFile(io.TeeReader(tarReader, tracker), target, mode.Perm()); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err := os.Remove(target); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+
+			if err := os.Symlink(header.Linkname, target); err != nil {
+				return err
+			}
+		default:
+			log.Debug().Msgf("pdcs: podman container cp skipping %s (type %c)", header.Name, header.Typeflag)
+		}
+	}
+}
+
+// extractTarget returns the local path of the archive entry and makes sure its inside the extract directory.
+// The symbolic links of the entry parent directories (i.e. previously extracted symlink entries) are
+// resolved inside the extract directory, the entry itself is never followed.
+func extractTarget(extractDir string, name string, archiveName string, rename string) (string, error) {
+	name = path.Clean("/" + name)[1:]
+
+	if rename != "" && (name == archiveName || strings.HasPrefix(name, archiveName+"/")) {
+		name = rename + strings.TrimPrefix(name, archiveName)
+	}
+
+	if name == "" {
+		return extractDir, nil
+	}
+
+	parent, err := securejoin.SecureJoin(extractDir, filepath.FromSlash(path.Dir(name)))
+	if err != nil {
+		return "", fmt.Errorf("%w: %s: %w", ErrInvalidCopyArchive, name, err)
+	}
+
+	dirPrefix := extractDir
+	if !strings.HasSuffix(dirPrefix, string(os.PathSeparator)) {
+		dirPrefix += string(os.PathSeparator)
+	}
+
+	target := filepath.Join(parent, path.Base(name))
+	if !strings.HasPrefix(target, dirPrefix) {
+		return "", fmt.Errorf("%w: %s", ErrInvalidCopyArchive, name)
+	}
+
+	return target, nil
+}
+
+// extractFile extracts the archive regular file to the target path, an existing symbolic
+// link target is replaced and not followed.
+func extractFile(reader io.Reader, target string, perm os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil { //nolint:gomnd
+		return err
+	}
+
+	if info, err := os.Lstat(target); err == nil && info.Mode()&os.ModeSymlink != 0 {
+		if err := os.Remove(target); err != nil {
+			return err
+		}
+	}
+
+	file, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY|openNoFollow, perm)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(file, reader); err != nil {
+		file.Close()
+
+		return err
+	}
+
+	return file.Close()
+}
+
+func dirSize(dir string) (int64, error) {
+	var size int64
+
+	err := filepath.Walk(dir, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+
+		return nil
+	})
+
+	return size, err
+}
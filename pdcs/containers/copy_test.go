@@ -0,0 +1,121 @@
+package containers
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type tarEntry struct {
+	name     string
+	typeflag byte
+	linkname string
+	content  string
+}
+
+func tarArchive(entries []tarEntry) *bytes.Buffer {
+	var buf bytes.Buffer
+
+	writer := tar.NewWriter(&buf)
+
+	for _, entry := range entries {
+		header := &tar.Header{
+			Name:     entry.name,
+			Typeflag: entry.typeflag,
+			Linkname: entry.linkname,
+			Mode:     0o644,
+			Size:     int64(len(entry.content)),
+		}
+
+		Expect(writer.WriteHeader(header)).To(Succeed())
+
+		if entry.content != "" {
+			_, err := writer.Write([]byte(entry.content))
+			Expect(err).To(BeNil())
+		}
+	}
+
+	Expect(writer.Close()).To(Succeed())
+
+	return &buf
+}
+
+var _ = Describe("container copy", func() {
+	var (
+		extractDir string
+		outsideDir string
+	)
+
+	BeforeEach(func() {
+		extractDir = GinkgoT().TempDir()
+		outsideDir = GinkgoT().TempDir()
+	})
+
+	It("extract archive", func() {
+		archive := tarArchive([]tarEntry{
+			{name: "data/", typeflag: tar.TypeDir},
+			{name: "data/file.txt", typeflag: tar.TypeReg, content: "hello"},
+			{name: "data/link", typeflag: tar.TypeSymlink, linkname: "file.txt"},
+		})
+
+		err := extractTarArchive(context.Background(), archive, extractDir, "data", "copy", &copyProgressTracker{})
+		Expect(err).To(BeNil())
+
+		content, err := os.ReadFile(filepath.Join(extractDir, "copy", "file.txt"))
+		Expect(err).To(BeNil())
+		Expect(string(content)).To(Equal("hello"))
+
+		linkname, err := os.Readlink(filepath.Join(extractDir, "copy", "link"))
+		Expect(err).To(BeNil())
+		Expect(linkname).To(Equal("file.txt"))
+	})
+
+	It("extract archive entry outside of the extract directory", func() {
+		target, err := extractTarget(extractDir, "../../etc/passwd", "data", "")
+		Expect(err).To(BeNil())
+		Expect(target).To(Equal(filepath.Join(extractDir, "etc", "passwd")))
+	})
+
+	It("extract symlink then file archive", func() {
+		archive := tarArchive([]tarEntry{
+			{name: "data", typeflag: tar.TypeSymlink, linkname: outsideDir},
+			{name: "data/file.txt", typeflag: tar.TypeReg, content: "escaped"},
+		})
+
+		err := extractTarArchive(context.Background(), archive, extractDir, "data", "", &copyProgressTracker{})
+		Expect(err).To(BeNil())
+
+		_, err = os.Stat(filepath.Join(outsideDir, "file.txt"))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+
+		content, err := os.ReadFile(filepath.Join(extractDir, outsideDir, "file.txt"))
+		Expect(err).To(BeNil())
+		Expect(string(content)).To(Equal("escaped"))
+	})
+
+	It("extract file over symlink archive", func() {
+		outsideFile := filepath.Join(outsideDir, "file.txt")
+		Expect(os.WriteFile(outsideFile, []byte("outside"), 0o600)).To(Succeed())
+
+		archive := tarArchive([]tarEntry{
+			{name: "file.txt", typeflag: tar.TypeSymlink, linkname: outsideFile},
+			{name: "file.txt", typeflag: tar.TypeReg, content: "escaped"},
+		})
+
+		err := extractTarArchive(context.Background(), archive, extractDir, "file.txt", "", &copyProgressTracker{})
+		Expect(err).To(BeNil())
+
+		content, err := os.ReadFile(outsideFile)
+		Expect(err).To(BeNil())
+		Expect(string(content)).To(Equal("outside"))
+
+		content, err = os.ReadFile(filepath.Join(extractDir, "file.txt"))
+		Expect(err).To(BeNil())
+		Expect(string(content)).To(Equal("escaped"))
+	})
+})
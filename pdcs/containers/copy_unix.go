@@ -0,0 +1,9 @@
+//go:build !windows
+// +build !windows
+
+package containers
+
+import "syscall"
+
+// openNoFollow fails to open the extracted file if its a symbolic link.
+const openNoFollow = syscall.O_NOFOLLOW
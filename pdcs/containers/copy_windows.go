@@ -0,0 +1,7 @@
+//go:build windows
+// +build windows
+
+package containers
+
+// openNoFollow is not supported on windows (symbolic links are replaced before the file is opened).
+const openNoFollow = 0
@@ -0,0 +1,127 @@
+package containers
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman/v5/pkg/bindings/containers"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	ErrCntExportCanceled     = errors.New("container export canceled")
+	ErrEmptyExportOutput     = errors.New("empty export output file")
+	ErrExportOutputExists    = errors.New("export output file already exists")
+	ErrExportOutputDirectory = errors.New("export output is a directory")
+)
+
+// Export exports the container filesystem as tar archive to the local output file and reports
+// the export progress to the progress handler until its finished or canceled (the cancel channel
+// receives or is closed). The output file is removed if the export fails.
+func Export(id string, output string, progress func(CntCopyProgress), cancelChan chan bool) error {
+	log.Debug().Msgf("pdcs: podman container export %s --output %s", id, output)
+
+	if output == "" {
+		return ErrEmptyExportOutput
+	}
+
+	if info, err := os.Stat(output); err == nil {
+		if info.IsDir() {
+			return fmt.Errorf("%w: %s", ErrExportOutputDirectory, output)
+		}
+
+		return fmt.Errorf("%w: %s", ErrExportOutputExists, output)
+	}
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(conn)
+	defer cancel()
+
+	go func() {
+		select {
+		case <-cancelChan:
+			log.Debug().Msgf("pdcs: podman container export %s canceled", id)
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	tracker := &copyProgressTracker{handler: progress}
+
+	// the exported archive size is about the container root filesystem size
+	data, err := containers.Inspect(ctx, id, new(containers.InspectOptions).WithSize(true))
+	if err != nil {
+		return err
+	}
+
+	tracker.progress.Total = data.SizeRootFs
+
+	outputFile, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+
+	err = exportArchive(ctx, id, outputFile, tracker)
+	if closeErr := outputFile.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		if removeErr := os.Remove(output); removeErr != nil {
+			log.Error().Msgf("pdcs: podman container export %s: %v", id, removeErr)
+		}
+
+		if errors.Is(ctx.Err(), context.Canceled) {
+			return ErrCntExportCanceled
+		}
+
+		return err
+	}
+
+	tracker.report(true)
+
+	return nil
+}
+
+// exportArchive writes the container export archive to the writer and reads its
+// entries while writing to report the exported files.
+func exportArchive(ctx context.Context, id string, writer io.Writer, tracker *copyProgressTracker) error {
+	reader, pipeWriter := io.Pipe()
+	defer reader.Close()
+
+	go func() {
+		pipeWriter.CloseWithError(containers.Export(ctx, id, pipeWriter, new(containers.ExportOptions)))
+	}()
+
+	archiveReader := io.TeeReader(reader, io.MultiWriter(writer, tracker))
+	tarReader := tar.NewReader(archiveReader)
+
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if header.Typeflag == tar.TypeReg {
+			tracker.file(header.Name)
+		}
+	}
+
+	// write the archive padding after the end of archive entry
+	_, err := io.Copy(io.Discard, archiveReader)
+
+	return err
+}
@@ -0,0 +1,259 @@
+package containers
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"time"
+	"unicode/utf8"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman/v5/pkg/bindings/containers"
+	"github.com/rs/zerolog/log"
+)
+
+// maxSymlinkFollow is the maximum number of symbolic links followed to resolve a path.
+const maxSymlinkFollow = 40
+
+var (
+	ErrCntFileNotFound   = errors.New("no such file or directory")
+	ErrCntFileNotRegular = errors.New("not a regular file")
+	ErrCntFileNotText    = errors.New("not a text file")
+)
+
+// CntFileInfo container filesystem file information.
+type CntFileInfo struct {
+	Name       string
+	Path       string
+	Mode       os.FileMode
+	Size       int64
+	ModTime    time.Time
+	LinkTarget string
+}
+
+// IsDir returns true if the file is a directory.
+func (info CntFileInfo) IsDir() bool {
+	return info.Mode.IsDir()
+}
+
+// CntFileTree container filesystem tree, the files are indexed by their directory path.
+type CntFileTree struct {
+	dirs map[string][]CntFileInfo
+}
+
+// NewCntFileTree returns an empty container filesystem tree.
+func NewCntFileTree() *CntFileTree {
+	return &CntFileTree{dirs: map[string][]CntFileInfo{"/": {}}}
+}
+
+// List returns the files of the specified directory path sorted by name (directories first).
+func (tree *CntFileTree) List(dir string) ([]CntFileInfo, error) {
+	dir = path.Clean("/" + dir)
+
+	files, ok := tree.dirs[dir]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrCntFileNotFound, dir)
+	}
+
+	report := make([]CntFileInfo, len(files))
+	copy(report, files)
+
+	sort.SliceStable(report, func(i, j int) bool {
+		if report[i].IsDir() != report[j].IsDir() {
+			return report[i].IsDir()
+		}
+
+		return report[i].Name < report[j].Name
+	})
+
+	return report, nil
+}
+
+// Stat returns the file information of the specified path.
+func (tree *CntFileTree) Stat(filePath string) (CntFileInfo, bool) {
+	filePath = path.Clean("/" + filePath)
+	if filePath == "/" {
+		return CntFileInfo{Name: "/", Path: "/", Mode: os.ModeDir | 0o755}, true //nolint:gomnd
+	}
+
+	for _, file := range tree.dirs[path.Dir(filePath)] {
+		if file.Path == filePath {
+			return file, true
+		}
+	}
+
+	return CntFileInfo{}, false
+}
+
+// Resolve returns the file information of the specified path after following its symbolic links.
+func (tree *CntFileTree) Resolve(filePath string) (CntFileInfo, bool) {
+	info, ok := tree.Stat(filePath)
+
+	for i := 0; ok && info.Mode&os.ModeSymlink != 0; i++ {
+		if i == maxSymlinkFollow {
+			return CntFileInfo{}, false
+		}
+
+		target := info.LinkTarget
+		if !path.IsAbs(target) {
+			target = path.Join(path.Dir(info.Path), target)
+		}
+
+		info, ok = tree.Stat(target)
+	}
+
+	return info, ok
+}
+
+// Add adds the file to the tree, its missing parent directories are added as well.
+func (tree *CntFileTree) Add(info CntFileInfo) {
+	info.Path = path.Clean("/" + info.Path)
+	dir := path.Dir(info.Path)
+
+	if info.IsDir() {
+		if _, ok := tree.dirs[info.Path]; !ok {
+			tree.dirs[info.Path] = []CntFileInfo{}
+		}
+	}
+
+	// the archive entries of the parent directories can be missing or come later
+	if _, ok := tree.dirs[dir]; !ok && dir != info.Path {
+		tree.Add(CntFileInfo{
+			Name: path.Base(dir),
+			Path: dir,
+			Mode: os.ModeDir | 0o755, //nolint:gomnd
+		})
+	}
+
+	for i, file := range tree.dirs[dir] {
+		if file.Path == info.Path {
+			tree.dirs[dir][i] = info
+
+			return
+		}
+	}
+
+	if dir != info.Path {
+		tree.dirs[dir] = append(tree.dirs[dir], info)
+	}
+}
+
+// FileTree returns the filesystem tree of the specified container ID.
+// The tree is read from the container export archive so it works on stopped containers as well.
+func FileTree(id string) (*CntFileTree, error) {
+	log.Debug().Msgf("pdcs: podman container export %s (file tree)", id)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	tree := NewCntFileTree()
+
+	reader, writer := io.Pipe()
+	defer reader.Close()
+
+	go func() {
+		writer.CloseWithError(containers.Export(conn, id, writer, new(containers.ExportOptions)))
+	}()
+
+	tarReader := tar.NewReader(reader)
+
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		filePath := path.Clean("/" + header.Name)
+		if filePath == "/" {
+			continue
+		}
+
+		tree.Add(CntFileInfo{
+			Name:       path.Base(filePath),
+			Path:       filePath,
+			Mode:       header.FileInfo().Mode(),
+			Size:       header.Size,
+			ModTime:    header.ModTime,
+			LinkTarget: header.Linkname,
+		})
+	}
+
+	return tree, nil
+}
+
+// ReadTextFile returns the content of the specified container text file, the content is
+// truncated to max size bytes (truncated is true).
+func ReadTextFile(id string, filePath string, maxSize int64) (string, bool, error) {
+	log.Debug().Msgf("pdcs: podman container read file %s %s", id, filePath)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return "", false, err
+	}
+
+	reader, writer := io.Pipe()
+	defer reader.Close()
+
+	copyFunc, err := containers.CopyToArchive(conn, id, filePath, writer)
+	if err != nil {
+		return "", false, err
+	}
+
+	go func() {
+		writer.CloseWithError(copyFunc())
+	}()
+
+	tarReader := tar.NewReader(reader)
+
+	header, err := tarReader.Next()
+	if err != nil {
+		return "", false, err
+	}
+
+	if header.Typeflag != tar.TypeReg {
+		return "", false, fmt.Errorf("%w: %s", ErrCntFileNotRegular, filePath)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(tarReader, maxSize+1))
+	if err != nil {
+		return "", false, err
+	}
+
+	truncated := int64(len(content)) > maxSize
+	if truncated {
+		content = content[:maxSize]
+	}
+
+	if !isText(content, truncated) {
+		return "", false, fmt.Errorf("%w: %s", ErrCntFileNotText, filePath)
+	}
+
+	return string(content), truncated, nil
+}
+
+// isText returns true if the content is valid UTF-8 without NUL characters,
+// the last rune of a truncated content can be incomplete.
+func isText(content []byte, truncated bool) bool {
+	if bytes.IndexByte(content, 0) >= 0 {
+		return false
+	}
+
+	if truncated {
+		for i := 0; i < utf8.UTFMax && len(content) > 0 && !utf8.Valid(content); i++ {
+			content = content[:len(content)-1]
+		}
+	}
+
+	return utf8.Valid(content)
+}
@@ -1,8 +1,11 @@
 package containers
 
 import (
+	"context"
+
 	"github.com/containers/podman-tui/pdcs/registry"
 	"github.com/containers/podman-tui/pdcs/utils"
+	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/bindings/containers"
 	"github.com/rs/zerolog/log"
 )
@@ -30,3 +33,43 @@ func HealthCheck(id string) (string, error) {
 
 	return report, nil
 }
+
+// Health returns the container health status, failing streak and healthcheck log history
+// (the result is nil if the container does not have a healthcheck).
+func Health(id string) (*define.HealthCheckResults, error) {
+	log.Debug().Msgf("pdcs: podman container inspect %s --format {{.State.Health}}", id)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	return health(conn, id)
+}
+
+// HealthByConnection returns the container health results of the named connection.
+func HealthByConnection(name string, id string) (*define.HealthCheckResults, error) {
+	log.Debug().Msgf("pdcs: podman container inspect %s --format {{.State.Health}} (connection=%s)", id, name)
+
+	conn, err := registry.GetConnectionByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return health(conn, id)
+}
+
+func health(conn context.Context, id string) (*define.HealthCheckResults, error) {
+	data, err := containers.Inspect(conn, id, new(containers.InspectOptions))
+	if err != nil {
+		return nil, err
+	}
+
+	var results *define.HealthCheckResults
+
+	if data.State != nil {
+		results = data.State.Health
+	}
+
+	return results, nil
+}
@@ -0,0 +1,19 @@
+package containers
+
+import (
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman/v5/pkg/bindings/containers"
+	"github.com/rs/zerolog/log"
+)
+
+// Init initializes a created container (all the preparations to run the container except starting it).
+func Init(id string) error {
+	log.Debug().Msgf("pdcs: podman container init %s", id)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return err
+	}
+
+	return containers.ContainerInit(conn, id, new(containers.InitOptions))
+}
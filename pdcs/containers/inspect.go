@@ -32,3 +32,21 @@ func Inspect(id string) (string, error) {
 
 	return report, nil
 }
+
+// OOMKilledByConnection returns true if the container of the named connection was killed by
+// the kernel OOM killer (podman sends a died event without OOM attribute).
+func OOMKilledByConnection(name string, id string) (bool, error) {
+	log.Debug().Msgf("pdcs: podman container inspect %s --format {{.State.OOMKilled}} (connection=%s)", id, name)
+
+	conn, err := registry.GetConnectionByName(name)
+	if err != nil {
+		return false, err
+	}
+
+	data, err := containers.Inspect(conn, id, new(containers.InspectOptions))
+	if err != nil {
+		return false, err
+	}
+
+	return data.State != nil && data.State.OOMKilled, nil
+}
@@ -1,6 +1,7 @@
 package containers
 
 import (
+	"context"
 	"sort"
 
 	"github.com/containers/podman-tui/pdcs/registry"
@@ -18,7 +19,36 @@ func List() ([]entities.ListContainer, error) {
 		return nil, err
 	}
 
-	response, err := containers.List(conn, new(containers.ListOptions).WithAll(true))
+	return list(conn, nil)
+}
+
+// ListByConnection returns list of containers information of the named connection.
+func ListByConnection(name string) ([]entities.ListContainer, error) {
+	log.Debug().Msgf("pdcs: podman container ls (connection=%s)", name)
+
+	conn, err := registry.GetConnectionByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return list(conn, nil)
+}
+
+// ListByID returns list of containers information of the named connection filtered by the container ID
+// (the list is empty if the container does not exist).
+func ListByID(name string, id string) ([]entities.ListContainer, error) {
+	log.Debug().Msgf("pdcs: podman container ls --filter id=%s (connection=%s)", id, name)
+
+	conn, err := registry.GetConnectionByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return list(conn, map[string][]string{"id": {id}})
+}
+
+func list(conn context.Context, filters map[string][]string) ([]entities.ListContainer, error) {
+	response, err := containers.List(conn, new(containers.ListOptions).WithAll(true).WithFilters(filters))
 	if err != nil {
 		return nil, err
 	}
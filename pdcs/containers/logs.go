@@ -1,52 +1,114 @@
 package containers
 
 import (
+	"context"
+
 	"github.com/containers/podman-tui/pdcs/registry"
 	"github.com/containers/podman/v5/pkg/bindings/containers"
 	"github.com/rs/zerolog/log"
 )
 
-// Logs returns container's log.
-func Logs(id string) ([]string, error) {
-	var logs []string
+// CntLogsOptions container logs options.
+type CntLogsOptions struct {
+	Follow     bool
+	Since      string
+	Until      string
+	Tail       string
+	Timestamps bool
+}
 
+// CntLogLine is a single container log line and the stream it was read from.
+type CntLogLine struct {
+	Stderr bool
+	Text   string
+}
+
+// Logs streams container's log lines to logChan until the log stream ends or
+// cancelChan receives a value. logChan is closed before returning.
+func Logs(id string, opts CntLogsOptions, logChan chan CntLogLine, cancelChan chan bool) error {
 	logsBuffer := 20
 
-	log.Debug().Msgf("pdcs: podman container logs %s", id)
+	log.Debug().Msgf("pdcs: podman container logs %s (%v)", id, opts)
+
+	defer close(logChan)
 
 	conn, err := registry.GetConnection()
 	if err != nil {
-		return logs, err
+		return err
 	}
 
+	ctx, cancel := context.WithCancel(conn)
+	defer cancel()
+
 	done := make(chan bool)
+	readerDone := make(chan bool)
 	logout := make(chan string, logsBuffer)
 	logerr := make(chan string, logsBuffer)
 
-	logAppender := func() {
+	forward := func(line CntLogLine) {
+		select {
+		case logChan <- line:
+		case <-ctx.Done():
+		}
+	}
+
+	// the bindings block on send, the reader shall drain both channels
+	// until containers.Logs returns even if the stream has been canceled.
+	logReader := func() {
+		defer close(readerDone)
+
 		for {
 			select {
 			case msg := <-logout:
-				logs = append(logs, msg)
+				forward(CntLogLine{Text: msg})
 			case msg := <-logerr:
-				logs = append(logs, msg)
+				forward(CntLogLine{Stderr: true, Text: msg})
+			case <-cancelChan:
+				cancel()
+
+				cancelChan = nil
 			case <-done:
-				return
+				for {
+					select {
+					case msg := <-logout:
+						forward(CntLogLine{Text: msg})
+					case msg := <-logerr:
+						forward(CntLogLine{Stderr: true, Text: msg})
+					default:
+						return
+					}
+				}
 			}
 		}
 	}
 
-	go logAppender()
+	go logReader()
 
-	options := new(containers.LogOptions).WithFollow(false)
+	options := new(containers.LogOptions).WithFollow(opts.Follow)
+	options.WithStdout(true)
+	options.WithStderr(true)
+	options.WithTimestamps(opts.Timestamps)
 
-	err = containers.Logs(conn, id, options, logout, logerr)
-	if err != nil {
-		return logs, err
+	if opts.Since != "" {
+		options.WithSince(opts.Since)
+	}
+
+	if opts.Until != "" {
+		options.WithUntil(opts.Until)
+	}
+
+	if opts.Tail != "" {
+		options.WithTail(opts.Tail)
+	}
+
+	err = containers.Logs(ctx, id, options, logout, logerr)
+
+	close(done)
+	<-readerDone
+
+	if err != nil && ctx.Err() == nil {
+		return err
 	}
-	done <- true
-	// logs = append(logs, <-logout)
-	// logs = append(logs, <-logerr)
 
-	return logs, nil
+	return nil
 }
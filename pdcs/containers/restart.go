@@ -0,0 +1,22 @@
+package containers
+
+import (
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman/v5/pkg/bindings/containers"
+	"github.com/rs/zerolog/log"
+)
+
+// DefaultRestartTimeout is the default number of seconds to wait for the container to stop before killing it.
+const DefaultRestartTimeout = 10
+
+// Restart restarts a container, the container is killed if it does not stop within the timeout (seconds).
+func Restart(id string, timeout int) error {
+	log.Debug().Msgf("pdcs: podman container restart %s (timeout %d)", id, timeout)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return err
+	}
+
+	return containers.Restart(conn, id, new(containers.RestartOptions).WithTimeout(timeout))
+}
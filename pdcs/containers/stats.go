@@ -1,6 +1,8 @@
 package containers
 
 import (
+	"context"
+
 	"github.com/containers/podman-tui/pdcs/registry"
 	"github.com/containers/podman/v5/pkg/bindings/containers"
 	"github.com/containers/podman/v5/pkg/domain/entities"
@@ -23,3 +25,31 @@ func Stats(id string, opts *containers.StatsOptions) (chan entities.ContainerSta
 
 	return statReportChan, nil
 }
+
+// StatsStream returns live stream of container stats result, the stream and its
+// channel are closed when the cancel channel receives or is closed.
+func StatsStream(id string, cancelChan chan bool) (chan entities.ContainerStatsReport, error) {
+	log.Debug().Msgf("pdcs: podman container stats stream %s", id)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(conn)
+
+	statReportChan, err := containers.Stats(ctx, []string{id}, new(containers.StatsOptions).WithStream(true))
+	if err != nil {
+		cancel()
+
+		return nil, err
+	}
+
+	go func() {
+		<-cancelChan
+		log.Debug().Msgf("pdcs: podman container stats stream %s canceled", id)
+		cancel()
+	}()
+
+	return statReportChan, nil
+}
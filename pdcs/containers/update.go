@@ -0,0 +1,237 @@
+package containers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/utils"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/bindings/containers"
+	"github.com/containers/podman/v5/pkg/domain/entities/types"
+	"github.com/containers/podman/v5/pkg/specgen"
+	"github.com/docker/go-units"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/rs/zerolog/log"
+)
+
+// cpuPeriod is the CPU CFS period (microseconds) used to convert the number of CPUs to quota.
+const cpuPeriod = 100000
+
+const (
+	blkioWeightMin = 10
+	blkioWeightMax = 1000
+)
+
+var (
+	ErrInvalidUpdateCPUs           = errors.New("invalid container update cpus value")
+	ErrInvalidUpdateCPUShares      = errors.New("invalid container update cpu shares value")
+	ErrInvalidUpdateMemory         = errors.New("invalid container update memory value")
+	ErrInvalidUpdateMemorySwap     = errors.New("invalid container update memory swap value")
+	ErrInvalidUpdatePidsLimit      = errors.New("invalid container update pids limit value")
+	ErrInvalidUpdateBlkioWeight    = errors.New("invalid container update blkio weight value")
+	ErrInvalidUpdateRestartRetries = errors.New("invalid container update restart retries value")
+)
+
+// CntUpdateOptions container update options, empty values are not changed.
+type CntUpdateOptions struct {
+	CPUs           string
+	CPUShares      string
+	Memory         string
+	MemorySwap     string
+	PidsLimit      string
+	BlkioWeight    string
+	RestartPolicy  string
+	RestartRetries string
+}
+
+// Resources returns the current resource limits and restart policy of a container
+// as update options, the unset (unlimited) values are empty.
+func Resources(id string) (CntUpdateOptions, error) {
+	log.Debug().Msgf("pdcs: podman container resources %s", id)
+
+	var opts CntUpdateOptions
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return opts, err
+	}
+
+	data, err := containers.Inspect(conn, id, new(containers.InspectOptions))
+	if err != nil {
+		return opts, err
+	}
+
+	if data.HostConfig == nil {
+		return opts, nil
+	}
+
+	return hostConfigResources(data.HostConfig), nil
+}
+
+// Update updates the resource limits and restart policy of a container.
+func Update(id string, opts CntUpdateOptions) error {
+	log.Debug().Msgf("pdcs: podman container update %s %v", id, opts)
+
+	spec, err := opts.specGenerator()
+	if err != nil {
+		return err
+	}
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return err
+	}
+
+	_, err = containers.Update(conn, &types.ContainerUpdateOptions{
+		NameOrID: id,
+		Specgen:  spec,
+	})
+
+	return err
+}
+
+func (opts CntUpdateOptions) specGenerator() (*specgen.SpecGenerator, error) { //nolint:cyclop
+	spec := &specgen.SpecGenerator{}
+	resources := &specs.LinuxResources{}
+
+	if opts.CPUs != "" {
+		cpus, err := strconv.ParseFloat(opts.CPUs, 64)
+		if err != nil || cpus <= 0 {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidUpdateCPUs, opts.CPUs)
+		}
+
+		period := uint64(cpuPeriod)
+		quota := int64(cpus * cpuPeriod)
+
+		resources.CPU = &specs.LinuxCPU{Period: &period, Quota: &quota}
+	}
+
+	if opts.CPUShares != "" {
+		shares, err := strconv.ParseUint(opts.CPUShares, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidUpdateCPUShares, opts.CPUShares)
+		}
+
+		if resources.CPU == nil {
+			resources.CPU = &specs.LinuxCPU{}
+		}
+
+		resources.CPU.Shares = &shares
+	}
+
+	if opts.Memory != "" {
+		memory, err := units.RAMInBytes(opts.Memory)
+		if err != nil || memory <= 0 {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidUpdateMemory, opts.Memory)
+		}
+
+		resources.Memory = &specs.LinuxMemory{Limit: &memory}
+	}
+
+	if opts.MemorySwap != "" {
+		swap, err := parseMemorySwap(opts.MemorySwap)
+		if err != nil {
+			return nil, err
+		}
+
+		if resources.Memory == nil {
+			resources.Memory = &specs.LinuxMemory{}
+		}
+
+		resources.Memory.Swap = &swap
+	}
+
+	if opts.PidsLimit != "" {
+		pidsLimit, err := strconv.ParseInt(opts.PidsLimit, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidUpdatePidsLimit, opts.PidsLimit)
+		}
+
+		resources.Pids = &specs.LinuxPids{Limit: pidsLimit}
+	}
+
+	if opts.BlkioWeight != "" {
+		weight, err := strconv.ParseUint(opts.BlkioWeight, 10, 16)
+		if err != nil || weight < blkioWeightMin || weight > blkioWeightMax {
+			return nil, fmt.Errorf("%w: %q (%d-%d)",
+				ErrInvalidUpdateBlkioWeight, opts.BlkioWeight, blkioWeightMin, blkioWeightMax)
+		}
+
+		blkioWeight := uint16(weight)
+		resources.BlockIO = &specs.LinuxBlockIO{Weight: &blkioWeight}
+	}
+
+	spec.ResourceLimits = resources
+
+	if opts.RestartPolicy != "" {
+		spec.RestartPolicy = opts.RestartPolicy
+
+		if opts.RestartRetries != "" {
+			retries, err := strconv.ParseUint(opts.RestartRetries, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %q", ErrInvalidUpdateRestartRetries, opts.RestartRetries)
+			}
+
+			restartRetries := uint(retries)
+			spec.RestartRetries = &restartRetries
+		}
+	}
+
+	return spec, nil
+}
+
+// hostConfigResources returns the resource limits and restart policy of the container host config.
+func hostConfigResources(hostConfig *define.InspectContainerHostConfig) CntUpdateOptions {
+	var opts CntUpdateOptions
+
+	if hostConfig.CpuQuota > 0 && hostConfig.CpuPeriod > 0 {
+		cpus := float64(hostConfig.CpuQuota) / float64(hostConfig.CpuPeriod)
+		opts.CPUs = strconv.FormatFloat(cpus, 'f', -1, 64)
+	}
+
+	if hostConfig.CpuShares > 0 {
+		opts.CPUShares = strconv.FormatUint(hostConfig.CpuShares, 10)
+	}
+
+	if hostConfig.Memory > 0 {
+		opts.Memory = utils.MemoryToStr(hostConfig.Memory)
+	}
+
+	if hostConfig.MemorySwap != 0 {
+		opts.MemorySwap = utils.MemoryToStr(hostConfig.MemorySwap)
+	}
+
+	if hostConfig.PidsLimit != 0 {
+		opts.PidsLimit = strconv.FormatInt(hostConfig.PidsLimit, 10)
+	}
+
+	if hostConfig.BlkioWeight > 0 {
+		opts.BlkioWeight = strconv.FormatUint(uint64(hostConfig.BlkioWeight), 10)
+	}
+
+	if hostConfig.RestartPolicy != nil {
+		opts.RestartPolicy = hostConfig.RestartPolicy.Name
+
+		if hostConfig.RestartPolicy.MaximumRetryCount > 0 {
+			opts.RestartRetries = strconv.FormatUint(uint64(hostConfig.RestartPolicy.MaximumRetryCount), 10)
+		}
+	}
+
+	return opts
+}
+
+// parseMemorySwap returns the memory swap limit in bytes, -1 is unlimited swap.
+func parseMemorySwap(value string) (int64, error) {
+	if value == "-1" {
+		return -1, nil
+	}
+
+	swap, err := units.RAMInBytes(value)
+	if err != nil || swap <= 0 {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidUpdateMemorySwap, value)
+	}
+
+	return swap, nil
+}
@@ -0,0 +1,57 @@
+package containers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman/v5/pkg/bindings/containers"
+	"github.com/rs/zerolog/log"
+)
+
+var ErrCntWaitCanceled = errors.New("container wait canceled")
+
+// WaitConditions is the list of container wait conditions (the first one is the default).
+var WaitConditions = []string{
+	"stopped",
+	"exited",
+	"running",
+	"paused",
+	"created",
+	"stopping",
+	"removing",
+	"healthy",
+	"unhealthy",
+}
+
+// Wait blocks until the container reaches the condition and returns its exit code.
+// The wait is canceled if the cancel channel receives or is closed.
+func Wait(id string, condition string, cancelChan chan bool) (int32, error) {
+	log.Debug().Msgf("pdcs: podman container wait %s (condition %s)", id, condition)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return -1, err
+	}
+
+	ctx, cancel := context.WithCancel(conn)
+	defer cancel()
+
+	go func() {
+		select {
+		case <-cancelChan:
+			log.Debug().Msgf("pdcs: podman container wait %s canceled", id)
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	opts := new(containers.WaitOptions).WithConditions([]string{condition})
+
+	exitCode, err := containers.Wait(ctx, id, opts)
+	if err != nil && errors.Is(ctx.Err(), context.Canceled) {
+		return -1, ErrCntWaitCanceled
+	}
+
+	return exitCode, err
+}
@@ -0,0 +1,237 @@
+package images
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/utils"
+	"github.com/containers/podman/v5/pkg/bindings/images"
+	"github.com/containers/podman/v5/pkg/bindings/manifests"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	ErrEmptyManifestName  = errors.New("empty manifest list name")
+	ErrEmptyManifestImage = errors.New("empty manifest image reference")
+)
+
+// ManifestListReporter manifest list report.
+type ManifestListReporter struct {
+	ID      string
+	Name    string
+	Created string
+}
+
+// ManifestCreateOptions manifest list create options.
+type ManifestCreateOptions struct {
+	Name   string
+	Images []string
+	All    bool
+}
+
+// ManifestAddOptions manifest list add options.
+type ManifestAddOptions struct {
+	Images        []string
+	All           bool
+	OS            string
+	Arch          string
+	Variant       string
+	Annotations   map[string]string
+	SkipTLSVerify bool
+	AuthFile      string
+	Username      string
+	Password      string
+}
+
+// ManifestPlatformReporter manifest list per-platform entry report.
+type ManifestPlatformReporter struct {
+	Digest      string
+	OS          string
+	Arch        string
+	Variant     string
+	Size        string
+	MediaType   string
+	Annotations string
+}
+
+// ManifestList returns list of manifest lists information.
+func ManifestList() ([]ManifestListReporter, error) {
+	log.Debug().Msg("pdcs: podman manifest ls")
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	filters := map[string][]string{"manifest": {"true"}}
+
+	response, err := images.List(conn, new(images.ListOptions).WithFilters(filters))
+	if err != nil {
+		return nil, err
+	}
+
+	report := make([]ManifestListReporter, 0, len(response))
+
+	for _, item := range response {
+		name := noneTag
+		if len(item.RepoTags) > 0 {
+			name = item.RepoTags[0]
+		}
+
+		report = append(report, ManifestListReporter{
+			ID:      item.ID,
+			Name:    name,
+			Created: utils.CreatedToStr(item.Created),
+		})
+	}
+
+	sort.Slice(report, func(i, j int) bool {
+		return report[i].Name < report[j].Name
+	})
+
+	log.Debug().Msgf("pdcs: %v", report)
+
+	return report, nil
+}
+
+// ManifestCreate creates a manifest list and returns its ID.
+func ManifestCreate(opts ManifestCreateOptions) (string, error) {
+	log.Debug().Msgf("pdcs: podman manifest create %s %v", opts.Name, opts.Images)
+
+	if opts.Name == "" {
+		return "", ErrEmptyManifestName
+	}
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return "", err
+	}
+
+	createOptions := new(manifests.CreateOptions).WithAll(opts.All)
+
+	return manifests.Create(conn, opts.Name, opts.Images, createOptions)
+}
+
+// ManifestAdd adds images to the manifest list.
+func ManifestAdd(name string, opts ManifestAddOptions) (string, error) {
+	log.Debug().Msgf("pdcs: podman manifest add %s %v", name, opts.Images)
+
+	if len(opts.Images) == 0 {
+		return "", ErrEmptyManifestImage
+	}
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return "", err
+	}
+
+	addOptions := new(manifests.AddOptions)
+	addOptions.WithImages(opts.Images)
+	addOptions.WithAll(opts.All)
+	addOptions.WithOS(opts.OS)
+	addOptions.WithArch(opts.Arch)
+	addOptions.WithVariant(opts.Variant)
+	addOptions.WithSkipTLSVerify(opts.SkipTLSVerify)
+	addOptions.WithAuthfile(opts.AuthFile)
+	addOptions.WithUsername(opts.Username)
+	addOptions.WithPassword(opts.Password)
+
+	if len(opts.Annotations) > 0 {
+		addOptions.WithAnnotation(opts.Annotations)
+	}
+
+	return manifests.Add(conn, name, addOptions)
+}
+
+// ManifestInspect returns the manifest list per-platform entries.
+func ManifestInspect(name string) ([]ManifestPlatformReporter, error) {
+	log.Debug().Msgf("pdcs: podman manifest inspect %s", name)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := manifests.InspectListData(conn, name, new(manifests.InspectOptions))
+	if err != nil {
+		return nil, err
+	}
+
+	report := make([]ManifestPlatformReporter, 0, len(data.Manifests))
+
+	for _, item := range data.Manifests {
+		annotations := make([]string, 0, len(item.Annotations))
+		for key, value := range item.Annotations {
+			annotations = append(annotations, key+"="+value)
+		}
+
+		sort.Strings(annotations)
+
+		report = append(report, ManifestPlatformReporter{
+			Digest:      item.Digest.String(),
+			OS:          item.Platform.OS,
+			Arch:        item.Platform.Architecture,
+			Variant:     item.Platform.Variant,
+			Size:        utils.SizeToStr(item.Size),
+			MediaType:   item.MediaType,
+			Annotations: strings.Join(annotations, ","),
+		})
+	}
+
+	return report, nil
+}
+
+// ManifestRemove removes the digest entry from the manifest list.
+func ManifestRemove(name string, digest string) (string, error) {
+	log.Debug().Msgf("pdcs: podman manifest remove %s %s", name, digest)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return "", err
+	}
+
+	return manifests.Remove(conn, name, digest, new(manifests.RemoveOptions))
+}
+
+// ManifestDelete removes the manifest list from local storage.
+func ManifestDelete(name string) ([]string, error) {
+	log.Debug().Msgf("pdcs: podman manifest rm %s", name)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := manifests.Delete(conn, name)
+	if err != nil {
+		return nil, err
+	}
+
+	report := append([]string{}, response.Untagged...)
+	report = append(report, response.Deleted...)
+
+	return report, nil
+}
+
+// ManifestPush pushes the manifest list and its images to a specified destination.
+func ManifestPush(name string, opts ImagePushOptions) (string, error) {
+	log.Debug().Msgf("pdcs: podman manifest push %s", name)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return "", err
+	}
+
+	pushOptions := new(images.PushOptions)
+	pushOptions.WithAll(true)
+	pushOptions.WithCompress(opts.Compress)
+	pushOptions.WithFormat(opts.Format)
+	pushOptions.WithSkipTLSVerify(opts.SkipTLSVerify)
+	pushOptions.WithAuthfile(opts.AuthFile)
+	pushOptions.WithUsername(opts.Username)
+	pushOptions.WithPassword(opts.Password)
+
+	return manifests.Push(conn, name, opts.Destination, pushOptions)
+}
@@ -1,13 +1,81 @@
 package images
 
 import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/containers/image/v5/types"
 	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman/v5/pkg/auth"
+	"github.com/containers/podman/v5/pkg/bindings"
 	"github.com/containers/podman/v5/pkg/bindings/images"
 	"github.com/rs/zerolog/log"
 )
 
-// Pull pulls image from registry.
-func Pull(name string) error {
+// compatPullURL is the docker compatible image create (pull) endpoint,
+// its response stream reports the layers download progress in bytes.
+const compatPullURL = "http://d/v1.41/images/create"
+
+var (
+	ErrImagePullCanceled = errors.New("image pull canceled")
+	ErrInvalidPlatform   = errors.New("invalid platform (os/arch[/variant])")
+)
+
+// ImagePullOptions image pull options.
+type ImagePullOptions struct {
+	Platform      string
+	SkipTLSVerify bool
+	AuthFile      string
+	Username      string
+	Password      string
+}
+
+// ImagePullProgress image pull progress report.
+type ImagePullProgress struct {
+	Status     string
+	Digest     string
+	Layers     int
+	LayersDone int
+	Current    int64
+	Total      int64
+}
+
+// pullMessage implements the docker compatible pull response stream message.
+type pullMessage struct {
+	Status         string `json:"status"`
+	ID             string `json:"id"`
+	Error          string `json:"error"`
+	ProgressDetail struct {
+		Current int64 `json:"current"`
+		Total   int64 `json:"total"`
+	} `json:"progressDetail"`
+}
+
+// layerProgress implements a layer download progress.
+type layerProgress struct {
+	current int64
+	total   int64
+	done    bool
+}
+
+// pullProgressTracker aggregates the layers download progress.
+type pullProgressTracker struct {
+	layers   map[string]*layerProgress
+	order    []string
+	progress ImagePullProgress
+}
+
+// Pull pulls image from registry and reports the pull progress to the progress handler
+// until its finished or canceled (the cancel channel receives or is closed).
+// The layers download progress in bytes is not reported if TLS verification is skipped.
+func Pull(name string, opts ImagePullOptions, progress func(ImagePullProgress), cancelChan chan bool) error {
 	log.Debug().Msgf("pdcs: podman image pull %s", name)
 
 	conn, err := registry.GetConnection()
@@ -15,10 +83,254 @@ func Pull(name string) error {
 		return err
 	}
 
-	_, err = images.Pull(conn, name, new(images.PullOptions).WithQuiet(true))
+	ctx, cancel := context.WithCancel(conn)
+	defer cancel()
+
+	go func() {
+		select {
+		case <-cancelChan:
+			log.Debug().Msgf("pdcs: podman image pull %s canceled", name)
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	// the docker compatible endpoint does not support TLS verification option
+	if opts.SkipTLSVerify {
+		err = pullWithLibpod(ctx, name, opts, progress)
+	} else {
+		err = pullWithCompat(ctx, name, opts, progress)
+	}
+
+	if err != nil && errors.Is(ctx.Err(), context.Canceled) {
+		return ErrImagePullCanceled
+	}
+
+	return err
+}
+
+func pullWithCompat(ctx context.Context, name string, opts ImagePullOptions, progress func(ImagePullProgress)) error {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	params := url.Values{}
+	params.Set("fromImage", name)
+
+	if opts.Platform != "" {
+		if _, _, _, err := splitPlatform(opts.Platform); err != nil {
+			return err
+		}
+
+		params.Set("platform", opts.Platform)
+	}
+
+	header, err := auth.MakeXRegistryAuthHeader(&types.SystemContext{AuthFilePath: opts.AuthFile}, opts.Username, opts.Password)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, compatPullURL+"?"+params.Encode(), nil)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	for key, values := range header {
+		for _, value := range values {
+			request.Header.Add(key, value)
+		}
+	}
+
+	response, err := conn.Client.Do(request)
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return responseError(response)
+	}
+
+	tracker := newPullProgressTracker()
+	dec := json.NewDecoder(response.Body)
+
+	for {
+		var msg pullMessage
+
+		if err := dec.Decode(&msg); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		}
+
+		if msg.Error != "" {
+			return errors.New(msg.Error) //nolint:goerr113
+		}
+
+		progress(tracker.update(msg))
+	}
+}
+
+func pullWithLibpod(ctx context.Context, name string, opts ImagePullOptions, progress func(ImagePullProgress)) error {
+	pullOptions := new(images.PullOptions)
+	pullOptions.WithSkipTLSVerify(opts.SkipTLSVerify)
+	pullOptions.WithAuthfile(opts.AuthFile)
+	pullOptions.WithUsername(opts.Username)
+	pullOptions.WithPassword(opts.Password)
+
+	if opts.Platform != "" {
+		osName, arch, variant, err := splitPlatform(opts.Platform)
+		if err != nil {
+			return err
+		}
+
+		pullOptions.WithOS(osName)
+		pullOptions.WithArch(arch)
+
+		if variant != "" {
+			pullOptions.WithVariant(variant)
+		}
+	}
+
+	reader, writer := io.Pipe()
+	pullOptions.WithProgressWriter(writer)
+
+	readerDone := make(chan bool)
+
+	go func() {
+		tracker := newPullProgressTracker()
+		scanner := bufio.NewScanner(reader)
+
+		for scanner.Scan() {
+			progress(tracker.updateFromLine(scanner.Text()))
+		}
+
+		close(readerDone)
+	}()
+
+	_, err := images.Pull(ctx, name, pullOptions)
+
+	writer.Close()
+	<-readerDone
+
+	return err
+}
+
+func newPullProgressTracker() *pullProgressTracker {
+	return &pullProgressTracker{
+		layers: make(map[string]*layerProgress),
+	}
+}
+
+// update updates the pull progress from the docker compatible stream message.
+func (tracker *pullProgressTracker) update(msg pullMessage) ImagePullProgress {
+	tracker.progress.Status = strings.TrimSpace(msg.Status)
+
+	if msg.ID == "" {
+		return tracker.progress
+	}
+
+	layer := tracker.layer(msg.ID)
+
+	switch msg.Status {
+	case "Downloading":
+		layer.current = msg.ProgressDetail.Current
+		layer.total = msg.ProgressDetail.Total
+	case "Download complete", "Already exists", "Pull complete":
+		layer.done = true
+		layer.current = layer.total
+	}
+
+	tracker.progress.Digest = msg.ID
+	tracker.sum()
+
+	return tracker.progress
+}
+
+// updateFromLine updates the pull progress from the podman pull output line,
+// the layers are done when the image configuration is copied.
+func (tracker *pullProgressTracker) updateFromLine(line string) ImagePullProgress {
+	line = strings.TrimSpace(line)
+	tracker.progress.Status = line
+
+	switch {
+	case strings.HasPrefix(line, "Copying blob "):
+		digest := strings.Fields(line)[2]
+
+		tracker.layer(digest)
+		tracker.progress.Digest = digest
+	case strings.HasPrefix(line, "Copying config "):
+		for _, layer := range tracker.layers {
+			layer.done = true
+		}
+	}
+
+	tracker.sum()
+
+	return tracker.progress
+}
+
+func (tracker *pullProgressTracker) layer(id string) *layerProgress {
+	layer, ok := tracker.layers[id]
+	if !ok {
+		layer = &layerProgress{}
+		tracker.layers[id] = layer
+		tracker.order = append(tracker.order, id)
+	}
+
+	return layer
+}
+
+func (tracker *pullProgressTracker) sum() {
+	tracker.progress.Layers = len(tracker.order)
+	tracker.progress.LayersDone = 0
+	tracker.progress.Current = 0
+	tracker.progress.Total = 0
+
+	for _, id := range tracker.order {
+		layer := tracker.layers[id]
+		if layer.done {
+			tracker.progress.LayersDone++
+		}
+
+		tracker.progress.Current += layer.current
+		tracker.progress.Total += layer.total
+	}
+}
+
+// splitPlatform returns the os, arch and variant of the platform (os/arch[/variant]).
+func splitPlatform(platform string) (string, string, string, error) {
+	fields := strings.Split(platform, "/")
+	if len(fields) < 2 || len(fields) > 3 || fields[0] == "" || fields[1] == "" { //nolint:gomnd
+		return "", "", "", fmt.Errorf("%w: %q", ErrInvalidPlatform, platform)
+	}
+
+	variant := ""
+	if len(fields) == 3 { //nolint:gomnd
+		variant = fields[2]
+	}
+
+	return fields[0], fields[1], variant, nil
+}
+
+// responseError returns the docker compatible endpoint error response message.
+func responseError(response *http.Response) error {
+	var errResponse struct {
+		Message string `json:"message"`
+	}
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(body, &errResponse); err != nil || errResponse.Message == "" {
+		return fmt.Errorf("%s: %s", response.Status, strings.TrimSpace(string(body))) //nolint:goerr113
+	}
+
+	return errors.New(errResponse.Message) //nolint:goerr113
 }
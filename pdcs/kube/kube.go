@@ -0,0 +1,183 @@
+package kube
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman/v5/pkg/bindings/generate"
+	"github.com/containers/podman/v5/pkg/bindings/kube"
+	"github.com/containers/podman/v5/pkg/domain/entities/types"
+	"github.com/rs/zerolog/log"
+	"gopkg.in/yaml.v3"
+)
+
+var ErrEmptyKubeFile = errors.New("no kubernetes resource found in the file")
+
+// Resource implements a kubernetes YAML file resource summary.
+type Resource struct {
+	Kind       string
+	Name       string
+	Containers []string
+}
+
+// kubeDocument implements the kubernetes YAML document fields used for the resource summary.
+type kubeDocument struct {
+	Kind     string `yaml:"kind"`
+	Metadata struct {
+		Name string `yaml:"name"`
+	} `yaml:"metadata"`
+	Spec struct {
+		Containers []kubeContainer `yaml:"containers"`
+		Template   struct {
+			Spec struct {
+				Containers []kubeContainer `yaml:"containers"`
+			} `yaml:"spec"`
+		} `yaml:"template"`
+	} `yaml:"spec"`
+}
+
+type kubeContainer struct {
+	Name  string `yaml:"name"`
+	Image string `yaml:"image"`
+}
+
+// Generate returns the kubernetes YAML of the containers, pods or volumes.
+func Generate(nameOrIDs []string) (string, error) {
+	log.Debug().Msgf("pdcs: podman generate kube %v", nameOrIDs)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return "", err
+	}
+
+	report, err := generate.Kube(conn, nameOrIDs, new(generate.KubeOptions))
+	if err != nil {
+		return "", err
+	}
+
+	data, err := io.ReadAll(report.Reader)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// Play creates the pods, containers and volumes from the kubernetes YAML file.
+func Play(path string, replace bool) (*types.KubePlayReport, error) {
+	log.Debug().Msgf("pdcs: podman kube play %s", path)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	return kube.Play(conn, path, new(kube.PlayOptions).WithReplace(replace))
+}
+
+// Down stops and removes the pods and containers created from the kubernetes YAML file,
+// the volumes are removed if force is set.
+func Down(path string, force bool) (*types.KubePlayReport, error) {
+	log.Debug().Msgf("pdcs: podman kube down %s", path)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	return kube.Down(conn, path, kube.DownOptions{Force: &force})
+}
+
+// Resources returns the kubernetes YAML file resources (kind, name and containers).
+func Resources(path string) ([]Resource, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	resources := []Resource{}
+	dec := yaml.NewDecoder(file)
+
+	for {
+		var doc kubeDocument
+
+		if err := dec.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		if doc.Kind == "" {
+			continue
+		}
+
+		containers := doc.Spec.Containers
+		if len(containers) == 0 {
+			containers = doc.Spec.Template.Spec.Containers
+		}
+
+		resource := Resource{
+			Kind: doc.Kind,
+			Name: doc.Metadata.Name,
+		}
+
+		for _, cnt := range containers {
+			resource.Containers = append(resource.Containers, fmt.Sprintf("%s (%s)", cnt.Name, cnt.Image))
+		}
+
+		resources = append(resources, resource)
+	}
+
+	if len(resources) == 0 {
+		return nil, fmt.Errorf("%w %q", ErrEmptyKubeFile, path)
+	}
+
+	return resources, nil
+}
+
+// PlayReportString returns the kube play or down report as human readable string.
+func PlayReportString(report *types.KubePlayReport) string {
+	var lines []string
+
+	for _, pod := range report.Pods {
+		lines = append(lines, "Pod: "+pod.ID)
+
+		for _, cnt := range pod.Containers {
+			lines = append(lines, "  Container: "+cnt)
+		}
+
+		for _, cntErr := range pod.ContainerErrors {
+			lines = append(lines, "  Error: "+cntErr)
+		}
+	}
+
+	for _, volume := range report.Volumes {
+		lines = append(lines, "Volume: "+volume.Name)
+	}
+
+	for _, stopped := range report.StopReport {
+		lines = append(lines, "Pod stopped: "+stopped.Id)
+	}
+
+	for _, removed := range report.RmReport {
+		lines = append(lines, "Pod removed: "+removed.Id)
+	}
+
+	for _, removed := range report.VolumeRmReport {
+		lines = append(lines, "Volume removed: "+removed.Id)
+	}
+
+	for _, removed := range report.SecretRmReport {
+		lines = append(lines, "Secret removed: "+removed.ID)
+	}
+
+	return strings.Join(lines, "\n")
+}
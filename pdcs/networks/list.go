@@ -9,35 +9,25 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
-// List returns list of podman networks.
-func List() ([][]string, error) {
+// List returns list of podman networks sorted by name.
+func List() ([]types.Network, error) {
 	log.Debug().Msg("pdcs: podman network ls")
 
-	report := make([][]string, 0)
-
 	conn, err := registry.GetConnection()
 	if err != nil {
-		return report, err
+		return nil, err
 	}
 
 	response, err := network.List(conn, new(network.ListOptions))
 	if err != nil {
-		return report, err
+		return nil, err
 	}
 
 	sort.Sort(netListSortedName{response})
 
-	for _, item := range response {
-		report = append(report, []string{
-			item.ID,
-			item.Name,
-			item.Driver,
-		})
-	}
-
-	log.Debug().Msgf("pdcs: %v", report)
+	log.Debug().Msgf("pdcs: %v", response)
 
-	return report, nil
+	return response, nil
 }
 
 type lprSort []types.Network
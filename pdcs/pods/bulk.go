@@ -0,0 +1,88 @@
+package pods
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman/v5/pkg/bindings/pods"
+	"github.com/containers/podman/v5/pkg/errorhandling"
+	"github.com/rs/zerolog/log"
+)
+
+var ErrUnknownBulkCommand = errors.New("unknown bulk command")
+
+// BulkCommandByConnection runs the bulk command (kill, pause, restart, rm, start, stop or unpause)
+// for the pod of the named connection.
+func BulkCommandByConnection(name string, cmd string, id string) error { //nolint:cyclop
+	log.Debug().Msgf("pdcs: podman pod %s %s (connection=%s)", cmd, id, name)
+
+	conn, err := registry.GetConnectionByName(name)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	switch cmd {
+	case "kill":
+		response, err := pods.Kill(conn, id, new(pods.KillOptions))
+		if err != nil {
+			return err
+		}
+
+		errs = response.Errs
+	case "pause":
+		response, err := pods.Pause(conn, id, new(pods.PauseOptions))
+		if err != nil {
+			return err
+		}
+
+		errs = response.Errs
+	case "restart":
+		response, err := pods.Restart(conn, id, new(pods.RestartOptions))
+		if err != nil {
+			return err
+		}
+
+		errs = response.Errs
+	case "rm":
+		response, err := pods.Remove(conn, id, new(pods.RemoveOptions))
+		if err != nil {
+			return err
+		}
+
+		if response.Err != nil {
+			return fmt.Errorf("error removing %s: %w", response.Id, response.Err)
+		}
+	case "start":
+		response, err := pods.Start(conn, id, new(pods.StartOptions))
+		if err != nil {
+			return err
+		}
+
+		errs = response.Errs
+	case "stop":
+		response, err := pods.Stop(conn, id, new(pods.StopOptions))
+		if err != nil {
+			return err
+		}
+
+		errs = response.Errs
+	case "unpause":
+		response, err := pods.Unpause(conn, id, new(pods.UnpauseOptions))
+		if err != nil {
+			return err
+		}
+
+		errs = response.Errs
+	default:
+		return fmt.Errorf("%w %q", ErrUnknownBulkCommand, cmd)
+	}
+
+	if len(errs) > 0 {
+		return errorhandling.JoinErrors(errs)
+	}
+
+	return nil
+}
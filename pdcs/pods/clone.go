@@ -0,0 +1,208 @@
+package pods
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/utils"
+	bcontainers "github.com/containers/podman/v5/pkg/bindings/containers"
+	"github.com/containers/podman/v5/pkg/bindings/pods"
+	"github.com/containers/podman/v5/pkg/domain/entities/types"
+	"github.com/rs/zerolog/log"
+)
+
+// cloneNameSuffix is the suffix of the clone default name.
+const cloneNameSuffix = "-clone"
+
+// PodCloneOptions pod clone options, the empty values are copied from the original pod.
+type PodCloneOptions struct {
+	Name    string
+	CPUs    string
+	Memory  string
+	Destroy bool
+	Start   bool
+}
+
+// Clone creates a copy of the pod and its containers with the same configuration (read from
+// their inspect data) and returns the new pod ID. The original pod is removed if destroy option
+// is set and the copy is started if start option is set. The copy is removed if one of the
+// pod containers can't be cloned.
+func Clone(id string, opts PodCloneOptions) (string, error) { //nolint:cyclop
+	log.Debug().Msgf("pdcs: podman pod clone %s %v", id, opts)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return "", err
+	}
+
+	data, err := pods.Inspect(conn, id, new(pods.InspectOptions))
+	if err != nil {
+		return "", err
+	}
+
+	defaultOpts, err := cloneDefaults(data)
+	if err != nil {
+		return "", err
+	}
+
+	createOpts := CreateOptions{
+		Name:         opts.Name,
+		Labels:       data.Labels,
+		Infra:        data.CreateInfra,
+		SecurityOpts: data.SecurityOpts,
+		CPUs:         opts.CPUs,
+		Memory:       opts.Memory,
+	}
+
+	if createOpts.Name == "" {
+		createOpts.Name = defaultOpts.Name
+	}
+
+	if createOpts.CPUs == "" {
+		createOpts.CPUs = defaultOpts.CPUs
+	}
+
+	if createOpts.Memory == "" {
+		createOpts.Memory = defaultOpts.Memory
+	}
+
+	if data.InfraContainerID != "" {
+		infraData, err := bcontainers.Inspect(conn, data.InfraContainerID, new(bcontainers.InspectOptions))
+		if err != nil {
+			return "", err
+		}
+
+		createOpts.InfraImage = infraData.ImageName
+	}
+
+	if infraConfig := data.InfraConfig; infraConfig != nil {
+		createOpts.DNSServer = infraConfig.DNSServer
+		createOpts.DNSOptions = infraConfig.DNSOption
+		createOpts.DNSSearchDomain = infraConfig.DNSSearch
+		createOpts.AddHost = infraConfig.HostAdd
+		createOpts.NoHost = infraConfig.NoManageHosts
+
+		if infraConfig.HostNetwork {
+			createOpts.Network = "host"
+		} else if len(infraConfig.Networks) > 0 {
+			createOpts.Network = infraConfig.Networks[0]
+		}
+
+		for containerPort, hostPorts := range infraConfig.PortBindings {
+			for _, hostPort := range hostPorts {
+				publish := containerPort
+
+				if hostPort.HostPort != "" {
+					publish = hostPort.HostPort + ":" + publish
+				}
+
+				if hostPort.HostIP != "" {
+					publish = hostPort.HostIP + ":" + publish
+				}
+
+				createOpts.Publish = append(createOpts.Publish, publish)
+			}
+		}
+
+		sort.Strings(createOpts.Publish)
+	}
+
+	podID, err := createPod(createOpts)
+	if err != nil {
+		return "", err
+	}
+
+	for _, cnt := range data.Containers {
+		if cnt.ID == data.InfraContainerID {
+			continue
+		}
+
+		if _, err := containers.Clone(cnt.ID, containers.CntCloneOptions{Pod: podID}); err != nil {
+			// the partially cloned pod is removed
+			if _, rmErr := pods.Remove(conn, podID, new(pods.RemoveOptions).WithForce(true)); rmErr != nil {
+				log.Error().Msgf("pdcs: podman pod clone remove %s: %v", podID, rmErr)
+			}
+
+			return "", fmt.Errorf("container %s: %w", cnt.Name, err)
+		}
+	}
+
+	if opts.Destroy {
+		if _, err := pods.Remove(conn, data.ID, new(pods.RemoveOptions).WithForce(true)); err != nil {
+			return podID, err
+		}
+	}
+
+	if opts.Start {
+		if err := Start(podID); err != nil {
+			return podID, err
+		}
+	}
+
+	return podID, nil
+}
+
+// CloneDefaults returns the pod clone default options: the clone name and
+// the original pod CPUs and memory limits.
+func CloneDefaults(id string) (PodCloneOptions, error) {
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return PodCloneOptions{}, err
+	}
+
+	data, err := pods.Inspect(conn, id, new(pods.InspectOptions))
+	if err != nil {
+		return PodCloneOptions{}, err
+	}
+
+	return cloneDefaults(data)
+}
+
+func cloneDefaults(data *types.PodInspectReport) (PodCloneOptions, error) {
+	var (
+		opts PodCloneOptions
+		err  error
+	)
+
+	opts.Name, err = CloneName(data.Name)
+	if err != nil {
+		return opts, err
+	}
+
+	if data.CPUQuota > 0 && data.CPUPeriod > 0 {
+		cpus := float64(data.CPUQuota) / float64(data.CPUPeriod)
+		opts.CPUs = strconv.FormatFloat(cpus, 'f', -1, 64)
+	}
+
+	if data.MemoryLimit > 0 {
+		opts.Memory = utils.MemoryToStr(int64(data.MemoryLimit))
+	}
+
+	return opts, nil
+}
+
+// CloneName returns the default clone name of the pod (name-clone, name-clone1, ...).
+func CloneName(name string) (string, error) {
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return "", err
+	}
+
+	cloneName := name + cloneNameSuffix
+
+	for index := 1; ; index++ {
+		exist, err := pods.Exists(conn, cloneName, new(pods.ExistsOptions))
+		if err != nil {
+			return "", err
+		}
+
+		if !exist {
+			return cloneName, nil
+		}
+
+		cloneName = fmt.Sprintf("%s%s%d", name, cloneNameSuffix, index)
+	}
+}
@@ -2,7 +2,9 @@ package pods
 
 import (
 	"encoding/json"
+	"fmt"
 	"net"
+	"strconv"
 
 	"github.com/containers/common/libnetwork/types"
 	"github.com/containers/podman-tui/pdcs/registry"
@@ -17,6 +19,9 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
+// ErrInvalidCreateCPUs is returned if the pod create cpus value isn't a positive number.
+var ErrInvalidCreateCPUs = errors.New("invalid pod create cpus value")
+
 // CreateOptions implements pods create spec options.
 type CreateOptions struct {
 	Name            string
@@ -35,12 +40,20 @@ type CreateOptions struct {
 	Network         string
 	Publish         []string
 	SecurityOpts    []string
+	CPUs            string
+	Memory          string
 }
 
 // Create creates a new pod.
-func Create(opts CreateOptions) error { //nolint:cyclop
+func Create(opts CreateOptions) error {
 	log.Debug().Msgf("pdcs: podman pod create %v", opts)
 
+	_, err := createPod(opts)
+
+	return err
+}
+
+func createPod(opts CreateOptions) (string, error) { //nolint:cyclop,gocognit
 	var createOptions entities.PodCreateOptions
 
 	var (
@@ -52,16 +65,26 @@ func Create(opts CreateOptions) error { //nolint:cyclop
 
 	conn, err := registry.GetConnection()
 	if err != nil {
-		return err
+		return "", err
+	}
+
+	// resources options
+	if opts.CPUs != "" {
+		createOptions.Cpus, err = strconv.ParseFloat(opts.CPUs, 64)
+		if err != nil || createOptions.Cpus <= 0 {
+			return "", fmt.Errorf("%w: %q", ErrInvalidCreateCPUs, opts.CPUs)
+		}
 	}
 
+	infraOptions.Memory = opts.Memory
+
 	createOptions.Name = opts.Name
 	createOptions.Labels = opts.Labels
 
 	// network options
 	podNetworkOptions, err := podNetworkOptions(opts)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	createOptions.Infra = opts.Infra
@@ -78,7 +101,7 @@ func Create(opts CreateOptions) error { //nolint:cyclop
 
 		err = containerToPodOptions(&infraOptions, &createOptions)
 		if err != nil {
-			return err
+			return "", err
 		}
 	} else {
 		createOptions.Share = nil
@@ -92,7 +115,7 @@ func Create(opts CreateOptions) error { //nolint:cyclop
 
 	podSpec, err = entities.ToPodSpecGen(*podSpec, &createOptions)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	if createOptions.Infra {
@@ -102,7 +125,7 @@ func Create(opts CreateOptions) error { //nolint:cyclop
 
 		err = specgenutil.FillOutSpecGen(podSpec.InfraContainerSpec, &infraOptions, []string{})
 		if err != nil {
-			return err
+			return "", err
 		}
 
 		podSpec.Volumes = podSpec.InfraContainerSpec.Volumes
@@ -112,12 +135,12 @@ func Create(opts CreateOptions) error { //nolint:cyclop
 
 		wrapped, err := json.Marshal(podSpec.InfraContainerSpec)
 		if err != nil {
-			return err
+			return "", err
 		}
 
 		err = json.Unmarshal(wrapped, podSpec)
 		if err != nil {
-			return err
+			return "", err
 		}
 	}
 
@@ -127,17 +150,17 @@ func Create(opts CreateOptions) error { //nolint:cyclop
 	}
 
 	if len(errList) > 0 {
-		return errorhandling.JoinErrors(errList)
+		return "", errorhandling.JoinErrors(errList)
 	}
 
 	newPodSpec := entities.PodSpec{PodSpecGen: *podSpec}
 
-	_, err = pods.CreatePodFromSpec(conn, &newPodSpec)
+	response, err := pods.CreatePodFromSpec(conn, &newPodSpec)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	return nil
+	return response.Id, nil
 }
 
 func defaultPodInfraImage() string {
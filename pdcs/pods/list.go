@@ -1,6 +1,7 @@
 package pods
 
 import (
+	"context"
 	"sort"
 
 	"github.com/containers/podman-tui/pdcs/registry"
@@ -18,7 +19,36 @@ func List() ([]*entities.ListPodsReport, error) {
 		return nil, err
 	}
 
-	response, err := pods.List(conn, new(pods.ListOptions))
+	return list(conn, nil)
+}
+
+// ListByConnection returns list of pods of the named connection.
+func ListByConnection(name string) ([]*entities.ListPodsReport, error) {
+	log.Debug().Msgf("pdcs: podman pod ls (connection=%s)", name)
+
+	conn, err := registry.GetConnectionByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return list(conn, nil)
+}
+
+// ListByID returns list of pods of the named connection filtered by the pod ID
+// (the list is empty if the pod does not exist).
+func ListByID(name string, id string) ([]*entities.ListPodsReport, error) {
+	log.Debug().Msgf("pdcs: podman pod ls --filter id=%s (connection=%s)", id, name)
+
+	conn, err := registry.GetConnectionByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return list(conn, map[string][]string{"id": {id}})
+}
+
+func list(conn context.Context, filters map[string][]string) ([]*entities.ListPodsReport, error) {
+	response, err := pods.List(conn, new(pods.ListOptions).WithFilters(filters))
 	if err != nil {
 		return nil, err
 	}
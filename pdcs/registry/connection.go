@@ -3,56 +3,95 @@ package registry
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/url"
 	"os"
 
 	"github.com/containers/podman/v5/pkg/bindings"
 )
 
-// ErrConnectionNotSelected implements connection is not selected error.
-var ErrConnectionNotSelected = errors.New("system connection not selected")
+var (
+	// ErrConnectionNotSelected implements connection is not selected error.
+	ErrConnectionNotSelected = errors.New("system connection not selected")
+	// ErrConnectionNotFound implements connection is not in the registry error.
+	ErrConnectionNotFound = errors.New("system connection not found")
+)
 
-// GetConnection returns connection to podman socket.
+// GetConnection returns active connection to podman socket.
 func GetConnection() (context.Context, error) {
 	if !ConnectionIsSet() {
 		return nil, ErrConnectionNotSelected
 	}
 
-	if pdcsRegistry.connContext == nil {
-		var (
-			conn       context.Context
-			err        error
-			passPhrase string
-		)
+	return GetConnectionByName(ConnectionName())
+}
 
-		dest := ConnectionURI()
+// GetConnectionByName returns named connection to podman socket.
+func GetConnectionByName(name string) (context.Context, error) {
+	pdcsRegistry.mu.Lock()
 
-		connURI, err := url.Parse(dest)
-		if err != nil {
-			return nil, err
-		}
+	regConn, ok := pdcsRegistry.connections[name]
+	if !ok {
+		pdcsRegistry.mu.Unlock()
 
-		if v, found := os.LookupEnv("CONTAINER_PASSPHRASE"); found {
-			passPhrase = v
-		}
+		return nil, fmt.Errorf("%w %q", ErrConnectionNotFound, name)
+	}
 
-		connURI.User = url.UserPassword(connURI.User.String(), passPhrase)
+	if regConn.connContext != nil {
+		conn := *regConn.connContext
+		pdcsRegistry.mu.Unlock()
 
-		ctx := context.Background()
-		ctx, cancel := context.WithCancel(ctx)
+		return conn, nil
+	}
 
-		conn, err = bindings.NewConnectionWithIdentity(ctx, connURI.String(), ConnectionIdentity(), false)
-		if err != nil {
-			cancel()
+	dest := regConn.connection.URI
+	identity := regConn.connection.Identity
 
-			return nil, err
-		}
+	pdcsRegistry.mu.Unlock()
 
-		pdcsRegistry.connContext = &conn
-		pdcsRegistry.connContextCancel = cancel
+	var passPhrase string
 
-		return conn, nil
+	connURI, err := url.Parse(dest)
+	if err != nil {
+		return nil, err
 	}
 
-	return *pdcsRegistry.connContext, nil
+	if v, found := os.LookupEnv("CONTAINER_PASSPHRASE"); found {
+		passPhrase = v
+	}
+
+	connURI.User = url.UserPassword(connURI.User.String(), passPhrase)
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+
+	conn, err := bindings.NewConnectionWithIdentity(ctx, connURI.String(), identity, false)
+	if err != nil {
+		cancel()
+
+		return nil, err
+	}
+
+	pdcsRegistry.mu.Lock()
+	defer pdcsRegistry.mu.Unlock()
+
+	// the connection could have been removed or connected by another caller
+	// while the new connection was established.
+	regConn, ok = pdcsRegistry.connections[name]
+	if !ok {
+		cancel()
+
+		return nil, fmt.Errorf("%w %q", ErrConnectionNotFound, name)
+	}
+
+	if regConn.connContext != nil {
+		cancel()
+
+		return *regConn.connContext, nil
+	}
+
+	regConn.connContext = &conn
+	regConn.connContextCancel = cancel
+
+	return conn, nil
 }
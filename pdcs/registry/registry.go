@@ -2,7 +2,10 @@ package registry
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/rs/zerolog/log"
 )
@@ -14,15 +17,24 @@ const (
 	ConnectionStatusConnectionError
 )
 
-var pdcsRegistry registry
+var pdcsRegistry = registry{
+	connections: make(map[string]*serviceConnection),
+}
 
-// service implements podman connection service.
+// registry implements podman connections registry.
+// Several connections can be connected at the same time and the active
+// connection is used by the functions which do not specify the connection name.
 type registry struct {
-	mu                sync.Mutex
+	mu          sync.Mutex
+	connections map[string]*serviceConnection
+	active      string
+}
+
+// serviceConnection implements a registered connection and its context.
+type serviceConnection struct {
 	connection        Connection
 	connContext       *context.Context
 	connContextCancel func()
-	connectionIsSet   bool
 }
 
 // Connection implements a system connection.
@@ -37,59 +49,140 @@ type Connection struct {
 // ConnStatus implements Connection status.
 type ConnStatus int
 
-func init() {
-	pdcsRegistry.connectionIsSet = false
+// ConnectionTransition implements a connection status transition record.
+type ConnectionTransition struct {
+	Time    time.Time
+	Status  ConnStatus
+	Message string
+	Latency time.Duration
 }
 
-// SetConnectionStatus sets registry Connection status.
+// SetConnectionStatus sets registry active Connection status.
 func SetConnectionStatus(status ConnStatus) {
-	if !ConnectionIsSet() {
-		return
-	}
+	SetConnectionStatusByName(ConnectionName(), status)
+}
 
+// SetConnectionStatusByName sets registry named Connection status.
+func SetConnectionStatusByName(name string, status ConnStatus) {
 	pdcsRegistry.mu.Lock()
 	defer pdcsRegistry.mu.Unlock()
-	pdcsRegistry.connection.Status = status
+
+	if conn, ok := pdcsRegistry.connections[name]; ok {
+		conn.connection.Status = status
+	}
 }
 
-// SetConnection sets registry connection.
+// SetConnection adds the connection to the registry and sets it as active connection.
 func SetConnection(connection Connection) {
 	log.Debug().Msgf("pdcs: registry set connection %v", connection)
+
 	pdcsRegistry.mu.Lock()
 	defer pdcsRegistry.mu.Unlock()
-	pdcsRegistry.connection = connection
-	pdcsRegistry.connectionIsSet = true
+
+	pdcsRegistry.add(connection)
+	pdcsRegistry.active = connection.Name
+}
+
+// AddConnection adds the connection to the registry without changing the active connection.
+func AddConnection(connection Connection) {
+	log.Debug().Msgf("pdcs: registry add connection %v", connection)
+
+	pdcsRegistry.mu.Lock()
+	defer pdcsRegistry.mu.Unlock()
+
+	pdcsRegistry.add(connection)
 }
 
-// UnsetConnection unsets the registry loaded connection.
+// SetActiveConnection sets the registered named connection as active connection.
+func SetActiveConnection(name string) error {
+	log.Debug().Msgf("pdcs: registry set active connection %q", name)
+
+	pdcsRegistry.mu.Lock()
+	defer pdcsRegistry.mu.Unlock()
+
+	if _, ok := pdcsRegistry.connections[name]; !ok {
+		return fmt.Errorf("%w %q", ErrConnectionNotFound, name)
+	}
+
+	pdcsRegistry.active = name
+
+	return nil
+}
+
+// UnsetConnection removes the active connection from the registry.
 func UnsetConnection() {
 	log.Debug().Msgf("pdcs: registry unset connection")
+	RemoveConnection(ConnectionName())
+}
+
+// RemoveConnection removes the named connection from the registry and cancels its context.
+func RemoveConnection(name string) {
+	log.Debug().Msgf("pdcs: registry remove connection %q", name)
+
 	pdcsRegistry.mu.Lock()
-	pdcsRegistry.connectionIsSet = false
-	pdcsRegistry.connection = Connection{}
-	pdcsRegistry.mu.Unlock()
-	CancelContext()
+	defer pdcsRegistry.mu.Unlock()
+
+	conn, ok := pdcsRegistry.connections[name]
+	if !ok {
+		return
+	}
+
+	conn.cancelContext()
+	delete(pdcsRegistry.connections, name)
+
+	if pdcsRegistry.active == name {
+		pdcsRegistry.active = ""
+	}
 }
 
-// CancelContext run the cancel function for context.
+// CancelContext run the cancel function for active connection context.
 func CancelContext() {
+	CancelContextByName(ConnectionName())
+}
+
+// CancelContextByName run the cancel function for named connection context.
+func CancelContextByName(name string) {
 	pdcsRegistry.mu.Lock()
 	defer pdcsRegistry.mu.Unlock()
 
-	if pdcsRegistry.connContextCancel != nil {
-		log.Debug().Msgf("pdcs: registry context cancel")
-		pdcsRegistry.connContextCancel()
+	if conn, ok := pdcsRegistry.connections[name]; ok {
+		conn.cancelContext()
 	}
-
-	pdcsRegistry.connContext = nil
 }
 
-// ConnectionIsSet returns true if connection is set.
+// ConnectionIsSet returns true if active connection is set.
 func ConnectionIsSet() bool {
 	pdcsRegistry.mu.Lock()
 	defer pdcsRegistry.mu.Unlock()
 
-	return pdcsRegistry.connectionIsSet
+	return pdcsRegistry.active != ""
+}
+
+// HasConnection returns true if the named connection is in the registry.
+func HasConnection(name string) bool {
+	pdcsRegistry.mu.Lock()
+	defer pdcsRegistry.mu.Unlock()
+
+	_, ok := pdcsRegistry.connections[name]
+
+	return ok
+}
+
+// Connections returns list of registered connections sorted by name.
+func Connections() []Connection {
+	pdcsRegistry.mu.Lock()
+	defer pdcsRegistry.mu.Unlock()
+
+	connections := make([]Connection, 0, len(pdcsRegistry.connections))
+	for _, conn := range pdcsRegistry.connections {
+		connections = append(connections, conn.connection)
+	}
+
+	sort.Slice(connections, func(i, j int) bool {
+		return connections[i].Name < connections[j].Name
+	})
+
+	return connections
 }
 
 // ConnectionName returns selected connection name.
@@ -97,7 +190,7 @@ func ConnectionName() string {
 	pdcsRegistry.mu.Lock()
 	defer pdcsRegistry.mu.Unlock()
 
-	return pdcsRegistry.connection.Name
+	return pdcsRegistry.active
 }
 
 // ConnectionStatus returns selected connection status.
@@ -105,7 +198,7 @@ func ConnectionStatus() ConnStatus {
 	pdcsRegistry.mu.Lock()
 	defer pdcsRegistry.mu.Unlock()
 
-	return pdcsRegistry.connection.Status
+	return pdcsRegistry.activeConnection().Status
 }
 
 // ConnectionURI returns selected connection url.
@@ -113,7 +206,7 @@ func ConnectionURI() string {
 	pdcsRegistry.mu.Lock()
 	defer pdcsRegistry.mu.Unlock()
 
-	return pdcsRegistry.connection.URI
+	return pdcsRegistry.activeConnection().URI
 }
 
 // ConnectionIdentity returns selected connection identity.
@@ -121,7 +214,37 @@ func ConnectionIdentity() string {
 	pdcsRegistry.mu.Lock()
 	defer pdcsRegistry.mu.Unlock()
 
-	return pdcsRegistry.connection.Identity
+	return pdcsRegistry.activeConnection().Identity
+}
+
+func (reg *registry) add(connection Connection) {
+	if conn, ok := reg.connections[connection.Name]; ok {
+		if conn.connection.URI == connection.URI && conn.connection.Identity == connection.Identity {
+			return
+		}
+
+		conn.cancelContext()
+	}
+
+	reg.connections[connection.Name] = &serviceConnection{connection: connection}
+}
+
+func (reg *registry) activeConnection() Connection {
+	if conn, ok := reg.connections[reg.active]; ok {
+		return conn.connection
+	}
+
+	return Connection{}
+}
+
+func (conn *serviceConnection) cancelContext() {
+	if conn.connContextCancel != nil {
+		log.Debug().Msgf("pdcs: registry %q context cancel", conn.connection.Name)
+		conn.connContextCancel()
+	}
+
+	conn.connContext = nil
+	conn.connContextCancel = nil
 }
 
 func (connStatus ConnStatus) String() string {
@@ -7,7 +7,7 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
-// Inspect inspects the specified secret.
+// Inspect inspects the specified secret, the secret data is not included (see Reveal).
 func Inspect(id string) (string, error) {
 	log.Debug().Msgf("pdcs: podman secret inspect %s", id)
 
@@ -18,7 +18,7 @@ func Inspect(id string) (string, error) {
 		return report, err
 	}
 
-	response, err := secrets.Inspect(conn, id, new(secrets.InspectOptions).WithShowSecret(true))
+	response, err := secrets.Inspect(conn, id, new(secrets.InspectOptions))
 	if err != nil {
 		return report, err
 	}
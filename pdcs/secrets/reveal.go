@@ -0,0 +1,86 @@
+package secrets
+
+import (
+	"encoding/json"
+	"os"
+	"os/user"
+	"path/filepath"
+	"time"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman/v5/pkg/bindings/secrets"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// RevealActionDisplay is the audit action of a secret value displayed.
+	RevealActionDisplay = "display"
+	// RevealActionCopy is the audit action of a secret value copied to the clipboard.
+	RevealActionCopy = "copy"
+)
+
+// RevealAuditRecord is a secret reveal audit log record.
+type RevealAuditRecord struct {
+	Time       time.Time `json:"time"`
+	User       string    `json:"user"`
+	Connection string    `json:"connection"`
+	SecretID   string    `json:"secret_id"`
+	SecretName string    `json:"secret_name"`
+	Action     string    `json:"action"`
+}
+
+// Reveal returns the specified secret data.
+func Reveal(id string) (string, error) {
+	log.Debug().Msgf("pdcs: podman secret inspect %s --showsecret", id)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return "", err
+	}
+
+	response, err := secrets.Inspect(conn, id, new(secrets.InspectOptions).WithShowSecret(true))
+	if err != nil {
+		return "", err
+	}
+
+	return response.SecretData, nil
+}
+
+// AuditReveal appends the secret reveal record to the audit log file (one JSON record per line).
+func AuditReveal(auditLog string, id string, name string, action string) error {
+	log.Debug().Msgf("pdcs: secret %s reveal audit (%s)", id, action)
+
+	record := RevealAuditRecord{
+		Time:       time.Now(),
+		Connection: registry.ConnectionName(),
+		SecretID:   id,
+		SecretName: name,
+		Action:     action,
+	}
+
+	if currentUser, err := user.Current(); err == nil {
+		record.User = currentUser.Username
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(auditLog), 0o700); err != nil { //nolint:gomnd
+		return err
+	}
+
+	auditFile, err := os.OpenFile(auditLog, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600) //nolint:gomnd
+	if err != nil {
+		return err
+	}
+
+	if _, err := auditFile.Write(append(data, '\n')); err != nil {
+		auditFile.Close()
+
+		return err
+	}
+
+	return auditFile.Close()
+}
@@ -0,0 +1,136 @@
+package secrets
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/utils"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/bindings/containers"
+	"github.com/containers/podman/v5/pkg/bindings/secrets"
+	"github.com/rs/zerolog/log"
+)
+
+var ErrSecretRotateEmptyValue = errors.New("secret rotate value not provided")
+
+// SecretRotateOptions secret rotate options, the new secret value is read from the file or the text.
+type SecretRotateOptions struct {
+	File string
+	Text string
+}
+
+// SecretConsumer is a container which uses a secret.
+type SecretConsumer struct {
+	ID      string
+	Name    string
+	State   string
+	Running bool
+}
+
+// Rotate replaces the secret value and keeps its name, driver and labels.
+func Rotate(id string, opts SecretRotateOptions) error {
+	log.Debug().Msgf("pdcs: podman secret rotate %s", id)
+
+	if opts.File == "" && opts.Text == "" {
+		return ErrSecretRotateEmptyValue
+	}
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return err
+	}
+
+	report, err := secrets.Inspect(conn, id, new(secrets.InspectOptions))
+	if err != nil {
+		return err
+	}
+
+	createOpts := &SecretCreateOptions{
+		Name:    report.Spec.Name,
+		Replace: true,
+		File:    opts.File,
+		Text:    opts.Text,
+		Driver:  report.Spec.Driver.Name,
+	}
+
+	for key, value := range report.Spec.Labels {
+		createOpts.Labels = append(createOpts.Labels, key+"="+value)
+	}
+
+	for key, value := range report.Spec.Driver.Options {
+		createOpts.DriverOptions = append(createOpts.DriverOptions, key+"="+value)
+	}
+
+	return Create(createOpts)
+}
+
+// Consumers returns the containers which use the secret (ID or name) by scanning their inspect data.
+func Consumers(id string, name string) ([]SecretConsumer, error) {
+	log.Debug().Msgf("pdcs: podman secret %s consumers", id)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	cntList, err := containers.List(conn, new(containers.ListOptions).WithAll(true))
+	if err != nil {
+		return nil, err
+	}
+
+	consumers := make([]SecretConsumer, 0)
+
+	for _, cnt := range cntList {
+		data, err := containers.Inspect(conn, cnt.ID, new(containers.InspectOptions))
+		if err != nil {
+			log.Debug().Msgf("pdcs: podman secret consumers: container %s: %v", cnt.ID, err)
+
+			continue
+		}
+
+		if !isSecretConsumer(data, id, name) {
+			continue
+		}
+
+		consumer := SecretConsumer{ID: data.ID, Name: data.Name}
+
+		if data.State != nil {
+			consumer.State = data.State.Status
+			consumer.Running = data.State.Running
+		}
+
+		consumers = append(consumers, consumer)
+	}
+
+	sort.Slice(consumers, func(i, j int) bool {
+		return consumers[i].Name < consumers[j].Name
+	})
+
+	return consumers, nil
+}
+
+// isSecretConsumer returns true if the container mounts the secret or if its podman create command
+// sets the secret. The environment variable secrets (e.g. --secret name,type=env,target=KEY) are not
+// part of the inspect secrets, they are only found for the containers created by the podman command
+// line (the containers created through the API, e.g. compose, have no create command).
+func isSecretConsumer(data *define.InspectContainerData, id string, name string) bool {
+	if data.Config == nil {
+		return false
+	}
+
+	for _, secret := range data.Config.Secrets {
+		if secret.ID == id || secret.Name == name {
+			return true
+		}
+	}
+
+	for _, value := range utils.CreateCommandOptions(data.Config.CreateCommand, "--secret") {
+		if source, _, _ := strings.Cut(value, ","); source == id || source == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,57 @@
+package secrets
+
+import (
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman/v5/libpod/define"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("secret rotate", func() {
+	It("rotate without value", func() {
+		Expect(Rotate("secID", SecretRotateOptions{})).To(MatchError(ErrSecretRotateEmptyValue))
+	})
+
+	It("rotate and consumers without connection", func() {
+		Expect(registry.ConnectionIsSet()).To(BeFalse())
+		Expect(Rotate("secID", SecretRotateOptions{Text: "s3cret"})).To(MatchError(registry.ErrConnectionNotSelected))
+
+		_, err := Consumers("secID", "db-password")
+		Expect(err).To(MatchError(registry.ErrConnectionNotSelected))
+	})
+
+	It("match secret consumers", func() {
+		mounted := &define.InspectContainerData{Config: &define.InspectContainerConfig{
+			Secrets: []*define.InspectSecret{{ID: "secID", Name: "db-password"}},
+		}}
+		Expect(isSecretConsumer(mounted, "secID", "other")).To(BeTrue())
+		Expect(isSecretConsumer(mounted, "otherID", "db-password")).To(BeTrue())
+		Expect(isSecretConsumer(mounted, "otherID", "other")).To(BeFalse())
+
+		envSecret := &define.InspectContainerData{Config: &define.InspectContainerConfig{
+			CreateCommand: []string{"podman", "run", "-d", "--secret", "db-password,type=env,target=DB_PASSWORD", "postgres"},
+		}}
+		Expect(isSecretConsumer(envSecret, "secID", "db-password")).To(BeTrue())
+		Expect(isSecretConsumer(envSecret, "secID", "db")).To(BeFalse())
+
+		envSecretByID := &define.InspectContainerData{Config: &define.InspectContainerConfig{
+			CreateCommand: []string{"podman", "create", "--secret=secID,type=env", "postgres"},
+		}}
+		Expect(isSecretConsumer(envSecretByID, "secID", "db-password")).To(BeTrue())
+
+		// the containers created through the API (e.g. compose) have no create command and their
+		// environment variable secrets are not part of the inspect data
+		apiEnvSecret := &define.InspectContainerData{Config: &define.InspectContainerConfig{
+			CreateCommand: []string{},
+			Env:           []string{"PATH=/usr/bin", "DB_PASSWORD"},
+		}}
+		Expect(isSecretConsumer(apiEnvSecret, "secID", "db-password")).To(BeFalse())
+
+		noValue := &define.InspectContainerData{Config: &define.InspectContainerConfig{
+			CreateCommand: []string{"podman", "run", "--secret"},
+		}}
+		Expect(isSecretConsumer(noValue, "secID", "db-password")).To(BeFalse())
+
+		Expect(isSecretConsumer(&define.InspectContainerData{}, "secID", "db-password")).To(BeFalse())
+	})
+})
@@ -1,11 +1,31 @@
 package sysinfo
 
 import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+
 	"github.com/containers/podman-tui/pdcs/registry"
 	"github.com/containers/podman/v5/pkg/bindings/system"
 	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/docker/docker/api/types/events"
+	"github.com/rs/zerolog/log"
 )
 
+// eventChannelSize is the events history channel buffer size.
+const eventChannelSize = 20
+
+var ErrEmptyEventsExportOutput = errors.New("empty events export output file")
+
+// EventsHistoryOptions events history time range and filters.
+type EventsHistoryOptions struct {
+	// Since and Until are timestamps or durations relative to now (e.g. 2024-05-01T03:00:00, 10m)
+	Since   string
+	Until   string
+	Filters map[string][]string
+}
+
 // Events returns libpod events.
 func Events(eventChan chan entities.Event, cancelChan chan bool) error {
 	conn, err := registry.GetConnection()
@@ -15,3 +35,109 @@ func Events(eventChan chan entities.Event, cancelChan chan bool) error {
 
 	return system.Events(conn, eventChan, cancelChan, new(system.EventsOptions))
 }
+
+// EventsByConnection returns libpod events of the named connection.
+func EventsByConnection(name string, eventChan chan entities.Event, cancelChan chan bool) error {
+	conn, err := registry.GetConnectionByName(name)
+	if err != nil {
+		return err
+	}
+
+	return system.Events(conn, eventChan, cancelChan, new(system.EventsOptions))
+}
+
+// EventsHistory returns the libpod events of the active connection in the since/until
+// time range (until now if until is empty) which match the filters.
+func EventsHistory(opts EventsHistoryOptions) ([]events.Message, error) {
+	log.Debug().Msgf("pdcs: podman events --since %q --until %q --filter %v --stream=false",
+		opts.Since, opts.Until, opts.Filters)
+
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	options := new(system.EventsOptions).WithStream(false)
+
+	if opts.Since != "" {
+		options.WithSince(opts.Since)
+	}
+
+	if opts.Until != "" {
+		options.WithUntil(opts.Until)
+	}
+
+	if len(opts.Filters) > 0 {
+		options.WithFilters(opts.Filters)
+	}
+
+	eventChan := make(chan entities.Event, eventChannelSize)
+	errChan := make(chan error, 1)
+	history := []events.Message{}
+
+	go func() {
+		errChan <- system.Events(conn, eventChan, nil, options)
+	}()
+
+	for {
+		select {
+		case event, ok := <-eventChan:
+			if !ok {
+				return history, <-errChan
+			}
+
+			history = append(history, event.Message)
+		case err := <-errChan:
+			if err != nil {
+				return nil, err
+			}
+
+			// the event channel is closed once all the events are sent
+			for event := range eventChan {
+				history = append(history, event.Message)
+			}
+
+			return history, nil
+		}
+	}
+}
+
+// ExportEvents writes the events to the output file as JSON lines,
+// the output file must not exist and it's removed if the export fails.
+func ExportEvents(output string, messages []events.Message) error {
+	log.Debug().Msgf("pdcs: podman events export %d events to %s", len(messages), output)
+
+	if output == "" {
+		return ErrEmptyEventsExportOutput
+	}
+
+	outputFile, err := os.OpenFile(output, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600) //nolint:gomnd
+	if err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriter(outputFile)
+	encoder := json.NewEncoder(writer)
+
+	for _, message := range messages {
+		if err = encoder.Encode(message); err != nil {
+			break
+		}
+	}
+
+	if err == nil {
+		err = writer.Flush()
+	}
+
+	if closeErr := outputFile.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		if removeErr := os.Remove(output); removeErr != nil {
+			log.Error().Msgf("pdcs: podman events export: %v", removeErr)
+		}
+	}
+
+	return err
+}
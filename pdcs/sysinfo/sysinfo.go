@@ -1,6 +1,8 @@
 package sysinfo
 
 import (
+	"context"
+
 	"github.com/containers/podman-tui/pdcs/registry"
 	"github.com/containers/podman/v5/pkg/bindings/system"
 )
@@ -21,13 +23,27 @@ type SystemInfo struct {
 
 // SysInfo returns basic system information.
 func SysInfo() (*SystemInfo, error) {
-	info := &SystemInfo{}
-
 	conn, err := registry.GetConnection()
 	if err != nil {
-		return info, err
+		return &SystemInfo{}, err
 	}
 
+	return sysInfo(conn)
+}
+
+// SysInfoByConnection returns basic system information of the named connection.
+func SysInfoByConnection(name string) (*SystemInfo, error) {
+	conn, err := registry.GetConnectionByName(name)
+	if err != nil {
+		return &SystemInfo{}, err
+	}
+
+	return sysInfo(conn)
+}
+
+func sysInfo(conn context.Context) (*SystemInfo, error) {
+	info := &SystemInfo{}
+
 	response, err := system.Info(conn, nil)
 	if err != nil {
 		return info, err
@@ -0,0 +1,322 @@
+package systemd
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/bindings/containers"
+	"github.com/containers/podman/v5/pkg/bindings/images"
+	"github.com/containers/podman/v5/pkg/bindings/pods"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// runtimeEnvKeys are the environment variables podman adds to the containers.
+var runtimeEnvKeys = []string{"container", "HOSTNAME", "HOME", "TERM"}
+
+// quadletSection implements a unit file section and its ordered key/value entries.
+type quadletSection struct {
+	name    string
+	entries [][2]string
+}
+
+func (section *quadletSection) add(key string, values ...string) {
+	for _, value := range values {
+		if value == "" {
+			continue
+		}
+
+		section.entries = append(section.entries, [2]string{key, value})
+	}
+}
+
+// containerQuadlets returns the container quadlet (.container) unit file.
+func containerQuadlets(id string, opts UnitOptions) ([]Unit, error) {
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	unit, err := containerQuadlet(conn, id, "", opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return []Unit{unit}, nil
+}
+
+// podQuadlets returns the pod quadlet (.pod) and its containers (.container) unit files.
+func podQuadlets(id string, opts UnitOptions) ([]Unit, error) {
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := pods.Inspect(conn, id, new(pods.InspectOptions))
+	if err != nil {
+		return nil, err
+	}
+
+	podUnitName := report.Name + ".pod"
+
+	podSection := &quadletSection{name: "Pod"}
+	podSection.add("PodName", report.Name)
+
+	if report.InfraConfig != nil {
+		podSection.add("PublishPort", publishPorts(report.InfraConfig.PortBindings)...)
+
+		if report.InfraConfig.HostNetwork {
+			podSection.add("Network", "host")
+		}
+
+		podSection.add("Network", report.InfraConfig.Networks...)
+	}
+
+	units := []Unit{{
+		Name:    podUnitName,
+		Content: quadletContent("Podman pod "+report.Name, podSection, opts),
+	}}
+
+	for _, cnt := range report.Containers {
+		if cnt.ID == report.InfraContainerID {
+			continue
+		}
+
+		unit, err := containerQuadlet(conn, cnt.ID, podUnitName, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		units = append(units, unit)
+	}
+
+	return units, nil
+}
+
+func containerQuadlet(conn context.Context, id string, podUnitName string, opts UnitOptions) (Unit, error) {
+	data, err := containers.Inspect(conn, id, new(containers.InspectOptions))
+	if err != nil {
+		return Unit{}, err
+	}
+
+	imageConfig := &v1.ImageConfig{}
+
+	image, err := images.GetImage(conn, data.Image, new(images.GetOptions))
+	if err != nil {
+		return Unit{}, err
+	}
+
+	if image.Config != nil {
+		imageConfig = image.Config
+	}
+
+	cntSection := &quadletSection{name: "Container"}
+	cntSection.add("ContainerName", data.Name)
+	cntSection.add("Image", data.ImageName)
+
+	if podUnitName == "" && data.Pod != "" {
+		podData, err := pods.Inspect(conn, data.Pod, new(pods.InspectOptions))
+		if err != nil {
+			return Unit{}, err
+		}
+
+		podUnitName = podData.Name + ".pod"
+	}
+
+	// the pod owns the container network and published ports
+	if podUnitName != "" {
+		cntSection.add("Pod", podUnitName)
+	} else if data.HostConfig != nil {
+		cntSection.add("PublishPort", publishPorts(data.HostConfig.PortBindings)...)
+		cntSection.add("Network", containerNetworks(data)...)
+	}
+
+	for _, mount := range data.Mounts {
+		cntSection.add(mountEntry(mount))
+	}
+
+	if data.Config != nil {
+		cntSection.add("Environment", containerEnv(data.Config.Env, imageConfig.Env)...)
+		cntSection.add("Label", containerLabels(data.Config.Labels, imageConfig.Labels)...)
+
+		if data.Config.User != imageConfig.User {
+			cntSection.add("User", data.Config.User)
+		}
+
+		if data.Config.WorkingDir != imageConfig.WorkingDir && data.Config.WorkingDir != "/" {
+			cntSection.add("WorkingDir", data.Config.WorkingDir)
+		}
+
+		if !slices.Equal(data.Config.Entrypoint, imageConfig.Entrypoint) {
+			cntSection.add("Entrypoint", quoteArgs(data.Config.Entrypoint))
+		}
+
+		if !slices.Equal(data.Config.Cmd, imageConfig.Cmd) {
+			cntSection.add("Exec", quoteArgs(data.Config.Cmd))
+		}
+	}
+
+	return Unit{
+		Name:    data.Name + ".container",
+		Content: quadletContent("Podman container "+data.Name, cntSection, opts),
+	}, nil
+}
+
+// quadletContent returns the quadlet unit file content with the unit, service and install sections.
+func quadletContent(description string, section *quadletSection, opts UnitOptions) string {
+	unitSection := &quadletSection{name: "Unit"}
+	unitSection.add("Description", description)
+	unitSection.add("Wants", opts.Wants...)
+	unitSection.add("After", opts.After...)
+	unitSection.add("Requires", opts.Requires...)
+
+	serviceSection := &quadletSection{name: "Service"}
+	serviceSection.add("Restart", opts.RestartPolicy)
+
+	installSection := &quadletSection{name: "Install"}
+	installSection.add("WantedBy", "default.target")
+
+	var content strings.Builder
+
+	for i, sec := range []*quadletSection{unitSection, section, serviceSection, installSection} {
+		if len(sec.entries) == 0 {
+			continue
+		}
+
+		if i > 0 {
+			content.WriteString("\n")
+		}
+
+		content.WriteString(fmt.Sprintf("[%s]\n", sec.name))
+
+		for _, entry := range sec.entries {
+			content.WriteString(fmt.Sprintf("%s=%s\n", entry[0], entry[1]))
+		}
+	}
+
+	return content.String()
+}
+
+func publishPorts(bindings map[string][]define.InspectHostPort) []string {
+	var ports []string
+
+	for cntPort, hostPorts := range bindings {
+		cntPort = strings.TrimSuffix(cntPort, "/tcp")
+
+		for _, hostPort := range hostPorts {
+			port := hostPort.HostPort + ":" + cntPort
+			if hostPort.HostIP != "" {
+				port = hostPort.HostIP + ":" + port
+			}
+
+			ports = append(ports, port)
+		}
+	}
+
+	sort.Strings(ports)
+
+	return ports
+}
+
+func containerNetworks(data *define.InspectContainerData) []string {
+	var networks []string
+
+	switch data.HostConfig.NetworkMode {
+	case "host", "none":
+		return []string{data.HostConfig.NetworkMode}
+	case "bridge":
+		if data.NetworkSettings == nil {
+			return nil
+		}
+
+		for name := range data.NetworkSettings.Networks {
+			// podman default network
+			if name == "podman" {
+				continue
+			}
+
+			networks = append(networks, name)
+		}
+	}
+
+	sort.Strings(networks)
+
+	return networks
+}
+
+func mountEntry(mount define.InspectMount) (string, string) {
+	var entry string
+
+	switch mount.Type {
+	case "bind":
+		entry = mount.Source + ":" + mount.Destination
+	case "volume":
+		entry = mount.Name + ":" + mount.Destination
+	case "tmpfs":
+		return "Tmpfs", mount.Destination
+	default:
+		return "", ""
+	}
+
+	if !mount.RW {
+		entry += ":ro"
+	}
+
+	return "Volume", entry
+}
+
+// containerEnv returns the container environment variables which are not set by the image or podman.
+func containerEnv(cntEnv []string, imageEnv []string) []string {
+	var env []string
+
+	for _, item := range cntEnv {
+		key, _, _ := strings.Cut(item, "=")
+		if slices.Contains(imageEnv, item) || slices.Contains(runtimeEnvKeys, key) {
+			continue
+		}
+
+		env = append(env, quoteArgs([]string{item}))
+	}
+
+	return env
+}
+
+// containerLabels returns the container labels which are not set by the image.
+func containerLabels(cntLabels map[string]string, imageLabels map[string]string) []string {
+	var labels []string
+
+	for key, value := range cntLabels {
+		if imageValue, ok := imageLabels[key]; ok && imageValue == value {
+			continue
+		}
+
+		labels = append(labels, quoteArgs([]string{key + "=" + value}))
+	}
+
+	sort.Strings(labels)
+
+	return labels
+}
+
+// quoteArgs returns the arguments as systemd unit command line.
+func quoteArgs(args []string) string {
+	quoted := make([]string, 0, len(args))
+
+	for _, arg := range args {
+		if arg != "" && !strings.ContainsAny(arg, " \t\"'\\") {
+			quoted = append(quoted, arg)
+
+			continue
+		}
+
+		arg = strings.ReplaceAll(arg, `\`, `\\`)
+		arg = strings.ReplaceAll(arg, `"`, `\"`)
+		quoted = append(quoted, `"`+arg+`"`)
+	}
+
+	return strings.Join(quoted, " ")
+}
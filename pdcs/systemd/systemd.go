@@ -0,0 +1,179 @@
+package systemd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman/v5/pkg/bindings/generate"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// FormatQuadlet is the podman quadlet (.container and .pod) unit files format.
+	FormatQuadlet = "quadlet"
+	// FormatSystemd is the legacy podman generate systemd (.service) unit files format.
+	FormatSystemd = "systemd"
+	// DefaultRestartPolicy is the default systemd service restart policy.
+	DefaultRestartPolicy = "on-failure"
+)
+
+var (
+	ErrEmptyUnitDir   = errors.New("empty unit files directory")
+	ErrInvalidFormat  = errors.New("invalid unit files format")
+	ErrNoUnitsToWrite = errors.New("there is no unit file to write")
+)
+
+// RestartPolicies returns the supported systemd service restart policies.
+func RestartPolicies() []string {
+	return []string{"no", "on-success", "on-failure", "on-abnormal", "on-watchdog", "on-abort", "always"}
+}
+
+// Formats returns the supported unit files formats.
+func Formats() []string {
+	return []string{FormatQuadlet, FormatSystemd}
+}
+
+// DefaultDir returns the default (rootless) unit files directory of the format.
+func DefaultDir(format string) string {
+	if format == FormatSystemd {
+		return "~/.config/systemd/user"
+	}
+
+	return "~/.config/containers/systemd"
+}
+
+// UnitOptions implements the unit files generate options.
+type UnitOptions struct {
+	Format        string
+	RestartPolicy string
+	Wants         []string
+	After         []string
+	Requires      []string
+}
+
+// Unit implements a generated unit file.
+type Unit struct {
+	Name    string
+	Content string
+}
+
+// ContainerUnits returns the unit files of the container.
+func ContainerUnits(id string, opts UnitOptions) ([]Unit, error) {
+	log.Debug().Msgf("pdcs: podman container generate %s %s", opts.Format, id)
+
+	switch opts.Format {
+	case FormatQuadlet:
+		return containerQuadlets(id, opts)
+	case FormatSystemd:
+		return systemdUnits(id, opts)
+	}
+
+	return nil, fmt.Errorf("%w %q", ErrInvalidFormat, opts.Format)
+}
+
+// PodUnits returns the unit files of the pod and its containers.
+func PodUnits(id string, opts UnitOptions) ([]Unit, error) {
+	log.Debug().Msgf("pdcs: podman pod generate %s %s", opts.Format, id)
+
+	switch opts.Format {
+	case FormatQuadlet:
+		return podQuadlets(id, opts)
+	case FormatSystemd:
+		return systemdUnits(id, opts)
+	}
+
+	return nil, fmt.Errorf("%w %q", ErrInvalidFormat, opts.Format)
+}
+
+// UnitsString returns the unit files as a single preview string.
+func UnitsString(units []Unit) string {
+	var preview []string
+
+	for _, unit := range units {
+		preview = append(preview, fmt.Sprintf("# %s\n%s", unit.Name, strings.TrimSpace(unit.Content)))
+	}
+
+	return strings.Join(preview, "\n\n")
+}
+
+// WrittenString returns the written unit files report as human readable string.
+func WrittenString(paths []string) string {
+	lines := make([]string, 0, len(paths))
+
+	for _, path := range paths {
+		lines = append(lines, "Unit file: "+path)
+	}
+
+	lines = append(lines, "", "Run \"systemctl --user daemon-reload\" to load the new unit files.")
+
+	return strings.Join(lines, "\n")
+}
+
+// WriteUnits writes the unit files into the directory and returns the written files path.
+func WriteUnits(dir string, units []Unit) ([]string, error) {
+	var written []string
+
+	if dir == "" {
+		return nil, ErrEmptyUnitDir
+	}
+
+	if len(units) == 0 {
+		return nil, ErrNoUnitsToWrite
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil { //nolint:gomnd
+		return nil, err
+	}
+
+	for _, unit := range units {
+		path := filepath.Join(dir, unit.Name)
+
+		log.Debug().Msgf("pdcs: writing unit file %s", path)
+
+		if err := os.WriteFile(path, []byte(unit.Content), 0o644); err != nil { //nolint:gosec,gomnd
+			return written, err
+		}
+
+		written = append(written, path)
+	}
+
+	return written, nil
+}
+
+// systemdUnits returns the legacy podman generate systemd unit files.
+func systemdUnits(id string, opts UnitOptions) ([]Unit, error) {
+	conn, err := registry.GetConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	genOptions := new(generate.SystemdOptions).
+		WithUseName(true).
+		WithNew(true).
+		WithRestartPolicy(opts.RestartPolicy).
+		WithWants(opts.Wants).
+		WithAfter(opts.After).
+		WithRequires(opts.Requires)
+
+	report, err := generate.Systemd(conn, id, genOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	units := make([]Unit, 0, len(report.Units))
+
+	for name, content := range report.Units {
+		units = append(units, Unit{Name: name + ".service", Content: content})
+	}
+
+	sort.Slice(units, func(i, j int) bool {
+		return units[i].Name < units[j].Name
+	})
+
+	return units, nil
+}
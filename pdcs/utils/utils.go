@@ -3,6 +3,7 @@ package utils
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -15,6 +16,27 @@ func SizeToStr(size int64) string {
 	return units.HumanSizeWithPrecision(float64(size), 3) //nolint:gomnd
 }
 
+// MemoryToStr returns the memory size with the largest exact unit suffix (e.g. 512m, 2g).
+func MemoryToStr(size int64) string {
+	if size <= 0 {
+		return strconv.FormatInt(size, 10)
+	}
+
+	suffixes := []string{"k", "m", "g", "t"}
+	unit := ""
+
+	for _, suffix := range suffixes {
+		if size%units.KiB != 0 {
+			break
+		}
+
+		size /= units.KiB
+		unit = suffix
+	}
+
+	return strconv.FormatInt(size, 10) + unit
+}
+
 // CreatedToStr converts duration to human readable format.
 func CreatedToStr(duration int64) string {
 	created := time.Unix(duration, 0).UTC()
@@ -22,6 +44,23 @@ func CreatedToStr(duration int64) string {
 	return units.HumanDuration(time.Since(created)) + " ago"
 }
 
+// CreateCommandOptions returns the values of the option (e.g. --secret) of the podman create command,
+// the create command is only part of the inspect data of the containers created by the podman command line.
+func CreateCommandOptions(createCommand []string, option string) []string {
+	var values []string
+
+	for index, arg := range createCommand {
+		switch {
+		case arg == option && index+1 < len(createCommand):
+			values = append(values, createCommand[index+1])
+		case strings.HasPrefix(arg, option+"="):
+			values = append(values, strings.TrimPrefix(arg, option+"="))
+		}
+	}
+
+	return values
+}
+
 // PrintJSON convert data interface to json string.
 func PrintJSON(data []interface{}) (string, error) {
 	buf, err := json.MarshalIndent(data, "", "    ")
@@ -0,0 +1,69 @@
+package system
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+const (
+	// healthCheckMaxBackoff is the maximum delay between the failed health checks.
+	healthCheckMaxBackoff = time.Minute
+	// healthCheckBackoffJitter is the random delay fraction added or removed
+	// from the backoff delay (the hosts failed at the same time are not retried together).
+	healthCheckBackoffJitter = 0.2
+)
+
+// healthBackoff implements the health check exponential backoff.
+type healthBackoff struct {
+	mu        sync.Mutex
+	failures  int
+	nextCheck time.Time
+}
+
+// due returns true if the next health check is due.
+func (backoff *healthBackoff) due(now time.Time) bool {
+	backoff.mu.Lock()
+	defer backoff.mu.Unlock()
+
+	return !now.Before(backoff.nextCheck)
+}
+
+// failure schedules the next health check after the failed one and returns its delay.
+func (backoff *healthBackoff) failure(now time.Time, interval time.Duration) time.Duration {
+	backoff.mu.Lock()
+	defer backoff.mu.Unlock()
+
+	backoff.failures++
+	delay := backoffDelay(interval, backoff.failures, rand.Float64()) //nolint:gosec
+	backoff.nextCheck = now.Add(delay)
+
+	return delay
+}
+
+// reset resets the backoff after a successful health check.
+func (backoff *healthBackoff) reset() {
+	backoff.mu.Lock()
+	backoff.failures = 0
+	backoff.nextCheck = time.Time{}
+	backoff.mu.Unlock()
+}
+
+// backoffDelay returns the delay after number of failures, the interval is
+// doubled after each failure up to healthCheckMaxBackoff and the random
+// value (0.0 to 1.0) jitters the delay by healthCheckBackoffJitter.
+func backoffDelay(interval time.Duration, failures int, random float64) time.Duration {
+	delay := interval
+
+	for i := 1; i < failures && delay < healthCheckMaxBackoff; i++ {
+		delay *= 2
+	}
+
+	if delay > healthCheckMaxBackoff {
+		delay = healthCheckMaxBackoff
+	}
+
+	jitter := time.Duration(float64(delay) * healthCheckBackoffJitter * (2*random - 1)) //nolint:gomnd
+
+	return delay + jitter
+}
@@ -15,70 +15,88 @@ import (
 
 var eventChannelSize = 20
 
+// Event implements a service connection resource event.
+type Event struct {
+	// Host is the service connection name
+	Host string
+	// Type is the event type (e.g. container, pod, image)
+	Type string
+	// Action is the event action (e.g. create, died, remove)
+	Action string
+	// ID is the resource ID
+	ID string
+	// Name is the resource name
+	Name string
+	// HealthStatus is the container health status of the health_status events
+	HealthStatus string
+	// Attributes are the event attributes (e.g. containerExitCode)
+	Attributes map[string]string
+}
+
 type podmanEvents struct {
 	mu                sync.Mutex
 	status            bool
 	eventChan         chan entities.Event
 	eventCancelChan   chan bool
 	cancelChan        chan bool
-	eventBuffer       []string
-	messageBuffer     []string
+	eventBuffer       []Event
+	messageBuffer     []events.Message
 	messageBufferSize int
 	hasNewEvent       bool
 }
 
-func (engine *Engine) startEventStreamer() {
-	log.Debug().Msgf("health check: start event streamer")
+func (host *hostEngine) startEventStreamer() {
+	log.Debug().Msgf("health check: %s: start event streamer", host.name)
 
-	engine.sysEvents.mu.Lock()
-	engine.sysEvents.status = true
-	engine.sysEvents.eventBuffer = []string{}
-	engine.sysEvents.messageBuffer = []string{}
-	engine.sysEvents.cancelChan = make(chan bool)
-	engine.sysEvents.eventCancelChan = make(chan bool)
-	engine.sysEvents.eventChan = make(chan entities.Event, eventChannelSize)
-	engine.sysEvents.mu.Unlock()
+	host.sysEvents.mu.Lock()
+	host.sysEvents.status = true
+	host.sysEvents.eventBuffer = []Event{}
+	host.sysEvents.messageBuffer = []events.Message{}
+	host.sysEvents.cancelChan = make(chan bool)
+	host.sysEvents.eventCancelChan = make(chan bool)
+	host.sysEvents.eventChan = make(chan entities.Event, eventChannelSize)
+	host.sysEvents.mu.Unlock()
 
-	go engine.eventReader()
-	go engine.streamEvents()
+	go host.eventReader()
+	go host.streamEvents()
 }
 
-func (engine *Engine) streamEvents() {
+func (host *hostEngine) streamEvents() {
 	log.Debug().Msg("health check: pdcs event steamer started")
 
-	if err := sysinfo.Events(engine.sysEvents.eventChan, engine.sysEvents.eventCancelChan); err != nil {
+	if err := sysinfo.EventsByConnection(host.name, host.sysEvents.eventChan, host.sysEvents.eventCancelChan); err != nil {
 		log.Error().Msgf("health check: pdcs event streamer %v", err)
-		engine.sysEvents.cancelChan <- true
-		engine.sysEvents.mu.Lock()
-		engine.sysEvents.status = false
-		engine.sysEvents.mu.Unlock()
+		host.sysEvents.cancelChan <- true
+		host.sysEvents.mu.Lock()
+		host.sysEvents.status = false
+		host.sysEvents.mu.Unlock()
 		log.Debug().Msgf("health check: pdcs event steamer cancel sent")
 	}
 
 	log.Debug().Msg("health check: pdcs event steamer stopped")
 
-	close(engine.sysEvents.eventCancelChan)
+	close(host.sysEvents.eventCancelChan)
 }
 
-func (engine *Engine) eventReader() {
+func (host *hostEngine) eventReader() {
 	log.Debug().Msg("health check: event reader started")
 
 	for {
 		select {
-		case <-engine.sysEvents.cancelChan:
+		case <-host.sysEvents.cancelChan:
 			log.Debug().Msg("health check: event reader stopped")
 
-			close(engine.sysEvents.cancelChan)
-			registry.CancelContext()
+			close(host.sysEvents.cancelChan)
+			registry.CancelContextByName(host.name)
 
 			return
 
-		case event := <-engine.sysEvents.eventChan:
+		case event := <-host.sysEvents.eventChan:
 			{
-				msg := engine.convertEventToHumanReadable(event.Message)
+				msg := convertEventToHumanReadable(event.Message)
 
-				engine.addEvent(event.Message)
-				engine.addEventMessage(msg)
+				host.addEvent(event)
+				host.addEventMessage(event.Message)
 
 				if strings.TrimSpace(msg) != "" {
 					log.Debug().Msgf("health check: event reader received %s", msg)
@@ -88,73 +106,136 @@ func (engine *Engine) eventReader() {
 	}
 }
 
-// GetEventMessages returns events buffer messages.
-func (engine *Engine) GetEventMessages() []string {
-	var events []string
+// GetEventMessages returns active connection events buffer messages (oldest first).
+func (engine *Engine) GetEventMessages() []events.Message {
+	host := engine.activeHost()
+	if host == nil {
+		return nil
+	}
+
+	return host.getEventMessages()
+}
 
-	engine.sysEvents.mu.Lock()
-	events = engine.sysEvents.messageBuffer
-	engine.sysEvents.hasNewEvent = false
-	engine.sysEvents.mu.Unlock()
+func (host *hostEngine) getEventMessages() []events.Message {
+	host.sysEvents.mu.Lock()
+	messages := make([]events.Message, len(host.sysEvents.messageBuffer))
+	copy(messages, host.sysEvents.messageBuffer)
+	host.sysEvents.hasNewEvent = false
+	host.sysEvents.mu.Unlock()
 
-	return events
+	return messages
 }
 
-// HasNewEvent returns true if there is new event added to event buffer.
+// HasNewEvent returns true if there is new event added to active connection event buffer.
 func (engine *Engine) HasNewEvent() bool {
+	host := engine.activeHost()
+	if host == nil {
+		return false
+	}
+
+	return host.hasNewEvent()
+}
+
+func (host *hostEngine) hasNewEvent() bool {
 	hasEvent := false
 
-	engine.sysEvents.mu.Lock()
-	hasEvent = engine.sysEvents.hasNewEvent
-	engine.sysEvents.mu.Unlock()
+	host.sysEvents.mu.Lock()
+	hasEvent = host.sysEvents.hasNewEvent
+	host.sysEvents.mu.Unlock()
 
 	return hasEvent
 }
 
-func (engine *Engine) addEventMessage(msg string) {
-	engine.sysEvents.mu.Lock()
-	if len(engine.sysEvents.messageBuffer) == engine.sysEvents.messageBufferSize {
-		// empty first 10 entries
-		engine.sysEvents.messageBuffer = engine.sysEvents.messageBuffer[20:]
+func (host *hostEngine) addEventMessage(msg events.Message) {
+	// the closed event stream sends empty messages
+	if msg.Type == "" {
+		return
+	}
+
+	host.sysEvents.mu.Lock()
+	if len(host.sysEvents.messageBuffer) >= host.sysEvents.messageBufferSize {
+		// drop the oldest entries
+		host.sysEvents.messageBuffer = host.sysEvents.messageBuffer[messageBufferTrimSize:]
 	}
 
-	engine.sysEvents.messageBuffer = append(engine.sysEvents.messageBuffer, msg)
-	engine.sysEvents.hasNewEvent = true
-	engine.sysEvents.mu.Unlock()
+	host.sysEvents.messageBuffer = append(host.sysEvents.messageBuffer, msg)
+	host.sysEvents.hasNewEvent = true
+	host.sysEvents.mu.Unlock()
 }
 
-// GetEvents returns event buffer types.
-func (engine *Engine) GetEvents() []string {
-	var events []string
+// GetEvents returns active connection event buffer (in the received order) and the container
+// and pod events of the other connected services (they are listed in the aggregated views).
+func (engine *Engine) GetEvents() []Event {
+	var events []Event
 
-	engine.sysEvents.mu.Lock()
-	events = engine.sysEvents.eventBuffer
-	// empty buffer.
-	engine.sysEvents.eventBuffer = []string{}
-	engine.sysEvents.mu.Unlock()
+	activeName := registry.ConnectionName()
+
+	engine.mu.Lock()
+	hosts := make([]*hostEngine, 0, len(engine.hosts))
+
+	for _, host := range engine.hosts {
+		hosts = append(hosts, host)
+	}
+
+	engine.mu.Unlock()
 
-	events = unique(events)
+	for _, host := range hosts {
+		for _, evt := range host.getEvents() {
+			if host.name == activeName || evt.Type == "container" || evt.Type == "pod" {
+				events = append(events, evt)
+			}
+		}
+	}
 
 	return events
 }
 
-// EventStatus returns event stats.
+func (host *hostEngine) getEvents() []Event {
+	var events []Event
+
+	host.sysEvents.mu.Lock()
+	events = host.sysEvents.eventBuffer
+	// empty buffer.
+	host.sysEvents.eventBuffer = []Event{}
+	host.sysEvents.mu.Unlock()
+
+	return events
+}
+
+// EventStatus returns active connection event stats.
 func (engine *Engine) EventStatus() bool {
-	engine.sysEvents.mu.Lock()
-	defer engine.sysEvents.mu.Unlock()
+	host := engine.activeHost()
+	if host == nil {
+		return false
+	}
+
+	return host.eventStatus()
+}
+
+func (host *hostEngine) eventStatus() bool {
+	host.sysEvents.mu.Lock()
+	defer host.sysEvents.mu.Unlock()
 
-	return engine.sysEvents.status
+	return host.sysEvents.status
 }
 
-func (engine *Engine) addEvent(event events.Message) {
-	engine.sysEvents.mu.Lock()
-	engine.sysEvents.hasNewEvent = true
-	engine.sysEvents.eventBuffer = append(engine.sysEvents.eventBuffer, string(event.Type))
-	engine.sysEvents.mu.Unlock()
+func (host *hostEngine) addEvent(event entities.Event) {
+	host.sysEvents.mu.Lock()
+	host.sysEvents.hasNewEvent = true
+	host.sysEvents.eventBuffer = append(host.sysEvents.eventBuffer, Event{
+		Host:         host.name,
+		Type:         string(event.Type),
+		Action:       string(event.Action),
+		ID:           event.Actor.ID,
+		Name:         event.Actor.Attributes["name"],
+		HealthStatus: event.HealthStatus,
+		Attributes:   event.Actor.Attributes,
+	})
+	host.sysEvents.mu.Unlock()
 }
 
 // convertEventToHumanReadable returns human readable event as a formatted string.
-func (engine *Engine) convertEventToHumanReadable(event events.Message) string {
+func convertEventToHumanReadable(event events.Message) string {
 	var humanFormat string
 
 	id := event.Actor.ID
@@ -3,6 +3,8 @@ package system
 import (
 	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/containers/podman-tui/pdcs/registry"
@@ -11,66 +13,126 @@ import (
 )
 
 const (
-	messageBufferSize = 100
+	// messageBufferSize is the number of the active connection events kept for the events dialog.
+	messageBufferSize = 1000
+	// messageBufferTrimSize is the number of the oldest events dropped when the buffer is full.
+	messageBufferTrimSize = 100
 )
 
-// Engine implements connection and system info check.
+// Engine implements connections and system info check.
 type Engine struct {
+	mu              sync.Mutex
 	refreshInterval time.Duration
-	sysinfo         systemInfo
-	sysEvents       podmanEvents
-	conn            apiConn
+	hosts           map[string]*hostEngine
+	histories       map[string]*healthHistory
+}
+
+// hostEngine implements a service connection health state, system info and event stream.
+type hostEngine struct {
+	name      string
+	sysinfo   systemInfo
+	sysEvents podmanEvents
+	conn      apiConn
+	backoff   healthBackoff
+	history   *healthHistory
+	checking  atomic.Bool
 }
 
 // NewEngine returns new health checker.
 func NewEngine(refreshInterval time.Duration) *Engine {
 	health := &Engine{
+		refreshInterval: refreshInterval,
+		hosts:           make(map[string]*hostEngine),
+		histories:       make(map[string]*healthHistory),
+	}
+
+	return health
+}
+
+func newHostEngine(name string, history *healthHistory) *hostEngine {
+	return &hostEngine{
+		name:    name,
+		history: history,
 		conn: apiConn{
 			connStaus:  registry.ConnectionStatusDisconnected,
 			prevStatus: registry.ConnectionStatusDisconnected,
 		},
-		refreshInterval: refreshInterval,
 		sysEvents: podmanEvents{
 			messageBufferSize: messageBufferSize,
 		},
-		sysinfo: systemInfo{},
+		sysinfo: systemInfo{
+			info: &sysinfo.SystemInfo{},
+		},
 	}
-
-	return health
 }
 
 // Start starts health checkers.
 func (engine *Engine) Start() {
-	engine.sysinfo.info = &sysinfo.SystemInfo{}
 	go engine.healthCheckLoop()
 }
 
-// ConnStatus returns connection status.
+// ConnStatus returns active connection status.
 func (engine *Engine) ConnStatus() (registry.ConnStatus, string) {
-	return engine.conn.ConnStatus()
+	host := engine.activeHost()
+	if host == nil {
+		return registry.ConnectionStatusDisconnected, ""
+	}
+
+	return host.conn.ConnStatus()
+}
+
+// ConnectedHosts returns sorted list of connected service connection names.
+func (engine *Engine) ConnectedHosts() []string {
+	hosts := []string{}
+
+	for _, conn := range registry.Connections() {
+		if conn.Status == registry.ConnectionStatusConnected {
+			hosts = append(hosts, conn.Name)
+		}
+	}
+
+	return hosts
 }
 
-// Connect sets engine connection.
+// Connect connects to the service connection and sets it as active connection.
+// The other connected services remain connected.
 func (engine *Engine) Connect(connection registry.Connection) {
 	log.Debug().Msgf("health: connect to %v", connection)
 
-	if registry.ConnectionIsSet() {
-		engine.Disconnect()
+	if registry.HasConnection(connection.Name) {
+		if err := registry.SetActiveConnection(connection.Name); err != nil {
+			log.Error().Msgf("health: %v", err)
+		}
+
+		return
 	}
 
 	registry.SetConnection(connection)
 }
 
-// Disconnect disconnects engine and unsets the connection.
-func (engine *Engine) Disconnect() {
-	if !registry.ConnectionIsSet() {
+// Disconnect disconnects the named service connection.
+func (engine *Engine) Disconnect(name string) {
+	if !registry.HasConnection(name) {
 		return
 	}
 
-	log.Debug().Msgf("health: disconnect")
-	registry.SetConnectionStatus(registry.ConnectionStatusDisconnected)
-	engine.conn.setStatus(registry.ConnectionStatusDisconnected, "")
-	registry.UnsetConnection()
+	log.Debug().Msgf("health: disconnect %q", name)
+	registry.SetConnectionStatusByName(name, registry.ConnectionStatusDisconnected)
+
+	if host := engine.host(name); host != nil {
+		host.conn.setStatus(registry.ConnectionStatusDisconnected, "")
+	}
+
+	engine.mu.Lock()
+	history := engine.history(name)
+	engine.mu.Unlock()
+
+	history.record(registry.ConnectionTransition{
+		Time:   time.Now(),
+		Status: registry.ConnectionStatusDisconnected,
+	})
+
+	registry.RemoveConnection(name)
 }
 
 func (engine *Engine) healthCheckLoop() {
@@ -82,39 +144,135 @@ func (engine *Engine) healthCheckLoop() {
 	}
 }
 
+// healthCheck starts the check of each connection which is due, the connections are checked
+// independently (a slow or hung connection doesn't delay the others) and a connection is not
+// checked again until its running check is done.
 func (engine *Engine) healthCheck() {
-	info, err := sysinfo.SysInfo()
-	if err != nil { //nolint:nestif
-		if errors.Is(err, registry.ErrConnectionNotSelected) {
-			engine.conn.setStatus(registry.ConnectionStatusDisconnected, "")
-		} else {
-			engine.conn.setStatus(registry.ConnectionStatusConnectionError, fmt.Sprintf("%v", err))
-			log.Error().Msgf("health check: %v", err)
+	connections := registry.Connections()
+	engine.updateHosts(connections)
+
+	now := time.Now()
+
+	for _, conn := range connections {
+		// the failed connections are checked after their backoff delay
+		host := engine.host(conn.Name)
+		if host == nil || !host.backoff.due(now) {
+			continue
+		}
+
+		if !host.checking.CompareAndSwap(false, true) {
+			log.Debug().Msgf("health check: %s: previous check is still running", host.name)
+
+			continue
 		}
 
-		if registry.ConnectionIsSet() {
-			registry.SetConnectionStatus(registry.ConnectionStatusConnectionError)
-		} else {
-			registry.SetConnectionStatus(registry.ConnectionStatusDisconnected)
+		go func() {
+			defer host.checking.Store(false)
+
+			host.healthCheck(engine.refreshInterval)
+		}()
+	}
+}
+
+// updateHosts adds the new registry connections and removes the disconnected ones.
+func (engine *Engine) updateHosts(connections []registry.Connection) {
+	engine.mu.Lock()
+	defer engine.mu.Unlock()
+
+	names := make(map[string]bool, len(connections))
+
+	for _, conn := range connections {
+		names[conn.Name] = true
+
+		if _, ok := engine.hosts[conn.Name]; !ok {
+			engine.hosts[conn.Name] = newHostEngine(conn.Name, engine.history(conn.Name))
+		}
+	}
+
+	for name := range engine.hosts {
+		if !names[name] {
+			delete(engine.hosts, name)
+		}
+	}
+}
+
+func (engine *Engine) host(name string) *hostEngine {
+	engine.mu.Lock()
+	defer engine.mu.Unlock()
+
+	return engine.hosts[name]
+}
+
+func (engine *Engine) activeHost() *hostEngine {
+	if !registry.ConnectionIsSet() {
+		return nil
+	}
+
+	return engine.host(registry.ConnectionName())
+}
+
+func (host *hostEngine) healthCheck(interval time.Duration) {
+	start := time.Now()
+	info, err := sysinfo.SysInfoByConnection(host.name)
+	latency := time.Since(start)
+
+	if err != nil {
+		if errors.Is(err, registry.ErrConnectionNotFound) {
+			// the connection is removed (i.e. disconnected)
+			host.conn.setStatus(registry.ConnectionStatusDisconnected, "")
+
+			return
 		}
 
-		if engine.conn.previousStatus() == registry.ConnectionStatusDisconnected {
-			engine.clearSysInfoData()
+		message := fmt.Sprintf("%v", err)
+		host.conn.setStatus(registry.ConnectionStatusConnectionError, message)
+
+		// new connection will be established on next health check
+		registry.CancelContextByName(host.name)
+
+		delay := host.backoff.failure(time.Now(), interval)
+
+		// only log the error once (or if its changed) to not fill the log file
+		if host.history.record(registry.ConnectionTransition{
+			Time:    start,
+			Status:  registry.ConnectionStatusConnectionError,
+			Message: message,
+			Latency: latency,
+		}) {
+			log.Error().Msgf("health check: %s: %v", host.name, err)
+		}
+
+		log.Debug().Msgf("health check: %s: next check in %v", host.name, delay)
+
+		registry.SetConnectionStatusByName(host.name, registry.ConnectionStatusConnectionError)
+
+		if host.conn.previousStatus() == registry.ConnectionStatusDisconnected {
+			host.clearSysInfoData()
 		}
 
 		return
 	}
 
+	host.backoff.reset()
+
+	if host.history.record(registry.ConnectionTransition{
+		Time:    start,
+		Status:  registry.ConnectionStatusConnected,
+		Latency: latency,
+	}) {
+		log.Info().Msgf("health check: %s: connected (latency %v)", host.name, latency)
+	}
+
 	// starting event streaming process after reconnecting
-	if !engine.EventStatus() {
-		if engine.conn.previousStatus() == registry.ConnectionStatusConnected {
-			engine.startEventStreamer()
+	if !host.eventStatus() {
+		if host.conn.previousStatus() == registry.ConnectionStatusConnected {
+			host.startEventStreamer()
 		}
 	}
 
-	engine.conn.setStatus(registry.ConnectionStatusConnected, "")
-	engine.sysinfo.mu.Lock()
-	engine.sysinfo.info = info
-	engine.sysinfo.mu.Unlock()
-	registry.SetConnectionStatus(registry.ConnectionStatusConnected)
+	host.conn.setStatus(registry.ConnectionStatusConnected, "")
+	host.sysinfo.mu.Lock()
+	host.sysinfo.info = info
+	host.sysinfo.mu.Unlock()
+	registry.SetConnectionStatusByName(host.name, registry.ConnectionStatusConnected)
 }
@@ -0,0 +1,69 @@
+package system
+
+import (
+	"sync"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+)
+
+// healthHistorySize is the maximum number of the connection transitions kept in history.
+const healthHistorySize = 50
+
+// healthHistory implements the connection status transitions history.
+type healthHistory struct {
+	mu          sync.Mutex
+	transitions []registry.ConnectionTransition
+}
+
+// record adds the transition to the history if the connection status or the
+// error message is changed and returns true if it is added.
+func (history *healthHistory) record(transition registry.ConnectionTransition) bool {
+	history.mu.Lock()
+	defer history.mu.Unlock()
+
+	if count := len(history.transitions); count > 0 {
+		last := history.transitions[count-1]
+		if last.Status == transition.Status && last.Message == transition.Message {
+			return false
+		}
+	}
+
+	if len(history.transitions) == healthHistorySize {
+		history.transitions = history.transitions[1:]
+	}
+
+	history.transitions = append(history.transitions, transition)
+
+	return true
+}
+
+func (history *healthHistory) list() []registry.ConnectionTransition {
+	history.mu.Lock()
+	defer history.mu.Unlock()
+
+	transitions := make([]registry.ConnectionTransition, len(history.transitions))
+	copy(transitions, history.transitions)
+
+	return transitions
+}
+
+// ConnectionHistory returns the named connection status transitions (oldest first).
+func (engine *Engine) ConnectionHistory(name string) []registry.ConnectionTransition {
+	engine.mu.Lock()
+	history := engine.history(name)
+	engine.mu.Unlock()
+
+	return history.list()
+}
+
+// history returns the named connection history (the engine shall be locked),
+// the history is kept after disconnecting from the service.
+func (engine *Engine) history(name string) *healthHistory {
+	history, ok := engine.histories[name]
+	if !ok {
+		history = &healthHistory{}
+		engine.histories[name] = history
+	}
+
+	return history
+}
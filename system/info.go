@@ -20,11 +20,16 @@ func (engine *Engine) GetSysInfo() (string, string, string) {
 		ostype   string
 	)
 
-	engine.sysinfo.mu.Lock()
-	hostname = engine.sysinfo.info.Hostname
-	kernel = engine.sysinfo.info.Kernel
-	ostype = engine.sysinfo.info.OS
-	engine.sysinfo.mu.Unlock()
+	host := engine.activeHost()
+	if host == nil {
+		return hostname, kernel, ostype
+	}
+
+	host.sysinfo.mu.Lock()
+	hostname = host.sysinfo.info.Hostname
+	kernel = host.sysinfo.info.Kernel
+	ostype = host.sysinfo.info.OS
+	host.sysinfo.mu.Unlock()
 
 	return hostname, kernel, ostype
 }
@@ -36,10 +41,15 @@ func (engine *Engine) GetSysUsage() (float64, float64) {
 		swapUsage float64
 	)
 
-	engine.sysinfo.mu.Lock()
-	memUsage = engine.sysinfo.info.MemUsagePC
-	swapUsage = engine.sysinfo.info.SwapUsagePC
-	engine.sysinfo.mu.Unlock()
+	host := engine.activeHost()
+	if host == nil {
+		return memUsage, swapUsage
+	}
+
+	host.sysinfo.mu.Lock()
+	memUsage = host.sysinfo.info.MemUsagePC
+	swapUsage = host.sysinfo.info.SwapUsagePC
+	host.sysinfo.mu.Unlock()
 
 	return memUsage, swapUsage
 }
@@ -53,12 +63,17 @@ func (engine *Engine) GetPodmanInfo() (string, string, string, string) {
 		runtime        string
 	)
 
-	engine.sysinfo.mu.Lock()
-	apiVersion = engine.sysinfo.info.APIVersion
-	conmonVersion = engine.sysinfo.info.ConmonVersion
-	buildahVersion = engine.sysinfo.info.BuildahVersion
-	runtime = engine.sysinfo.info.Runtime
-	engine.sysinfo.mu.Unlock()
+	host := engine.activeHost()
+	if host == nil {
+		return apiVersion, runtime, conmonVersion, buildahVersion
+	}
+
+	host.sysinfo.mu.Lock()
+	apiVersion = host.sysinfo.info.APIVersion
+	conmonVersion = host.sysinfo.info.ConmonVersion
+	buildahVersion = host.sysinfo.info.BuildahVersion
+	runtime = host.sysinfo.info.Runtime
+	host.sysinfo.mu.Unlock()
 
 	// conmon version
 	conmonVersion = strings.Split(conmonVersion, ",")[0]
@@ -72,16 +87,16 @@ func (engine *Engine) GetPodmanInfo() (string, string, string, string) {
 	return apiVersion, runtime, conmonVersion, buildahVersion
 }
 
-func (engine *Engine) clearSysInfoData() {
-	engine.sysinfo.mu.Lock()
-	engine.sysinfo.info.Hostname = ""
-	engine.sysinfo.info.Kernel = ""
-	engine.sysinfo.info.OS = ""
-	engine.sysinfo.info.MemUsagePC = 0.00
-	engine.sysinfo.info.SwapUsagePC = 0.00
-	engine.sysinfo.info.APIVersion = ""
-	engine.sysinfo.info.ConmonVersion = ""
-	engine.sysinfo.info.BuildahVersion = ""
-	engine.sysinfo.info.Runtime = ""
-	engine.sysinfo.mu.Unlock()
+func (host *hostEngine) clearSysInfoData() {
+	host.sysinfo.mu.Lock()
+	host.sysinfo.info.Hostname = ""
+	host.sysinfo.info.Kernel = ""
+	host.sysinfo.info.OS = ""
+	host.sysinfo.info.MemUsagePC = 0.00
+	host.sysinfo.info.SwapUsagePC = 0.00
+	host.sysinfo.info.APIVersion = ""
+	host.sysinfo.info.ConmonVersion = ""
+	host.sysinfo.info.BuildahVersion = ""
+	host.sysinfo.info.Runtime = ""
+	host.sysinfo.mu.Unlock()
 }
@@ -0,0 +1,421 @@
+package cntdialogs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	cntCloneDialogMaxWidth = 90
+	cntCloneDialogHeight   = 15
+)
+
+const (
+	cntCloneNameFocus = 0 + iota
+	cntCloneImageFocus
+	cntCloneCPUsFocus
+	cntCloneMemoryFocus
+	cntCloneDestroyFocus
+	cntCloneStartFocus
+	cntCloneFormFocus
+)
+
+// ContainerCloneDialog represents container clone dialog primitive.
+type ContainerCloneDialog struct {
+	*tview.Box
+	layout        *tview.Flex
+	cntInfo       *tview.InputField
+	name          *tview.InputField
+	image         *tview.InputField
+	cpus          *tview.InputField
+	memory        *tview.InputField
+	destroy       *tview.Checkbox
+	start         *tview.Checkbox
+	form          *tview.Form
+	display       bool
+	focusElement  int
+	cloneHandler  func()
+	cancelHandler func()
+}
+
+// NewContainerCloneDialog returns new container clone dialog primitive.
+func NewContainerCloneDialog() *ContainerCloneDialog {
+	dialog := &ContainerCloneDialog{
+		Box:     tview.NewBox(),
+		layout:  tview.NewFlex().SetDirection(tview.FlexRow),
+		cntInfo: tview.NewInputField(),
+		name:    tview.NewInputField(),
+		image:   tview.NewInputField(),
+		cpus:    tview.NewInputField(),
+		memory:  tview.NewInputField(),
+		destroy: tview.NewCheckbox(),
+		start:   tview.NewCheckbox(),
+		form:    tview.NewForm(),
+	}
+
+	bgColor := style.DialogBgColor
+	fgColor := style.DialogFgColor
+	inputFieldBgColor := style.InputFieldBgColor
+	labelWidth := 8
+
+	// container info input field
+	cntInfoLabel := "CONTAINER ID:"
+
+	dialog.cntInfo.SetBackgroundColor(bgColor)
+	dialog.cntInfo.SetLabel("[::b]" + cntInfoLabel)
+	dialog.cntInfo.SetLabelWidth(len(cntInfoLabel) + 1)
+	dialog.cntInfo.SetFieldBackgroundColor(bgColor)
+	dialog.cntInfo.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(fgColor))
+
+	// name input field
+	dialog.name.SetBackgroundColor(bgColor)
+	dialog.name.SetLabelColor(fgColor)
+	dialog.name.SetLabel("name:")
+	dialog.name.SetLabelWidth(labelWidth)
+	dialog.name.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// image input field
+	dialog.image.SetBackgroundColor(bgColor)
+	dialog.image.SetLabelColor(fgColor)
+	dialog.image.SetLabel("image:")
+	dialog.image.SetLabelWidth(labelWidth)
+	dialog.image.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// cpus input field
+	dialog.cpus.SetBackgroundColor(bgColor)
+	dialog.cpus.SetLabelColor(fgColor)
+	dialog.cpus.SetLabel("cpus:")
+	dialog.cpus.SetLabelWidth(labelWidth)
+	dialog.cpus.SetFieldBackgroundColor(inputFieldBgColor)
+	dialog.cpus.SetPlaceholder("number of CPUs (e.g. 1.5)")
+	dialog.cpus.SetPlaceholderTextColor(style.InfoBarItemFgColor)
+
+	// memory input field
+	dialog.memory.SetBackgroundColor(bgColor)
+	dialog.memory.SetLabelColor(fgColor)
+	dialog.memory.SetLabel("memory:")
+	dialog.memory.SetLabelWidth(labelWidth)
+	dialog.memory.SetFieldBackgroundColor(inputFieldBgColor)
+	dialog.memory.SetPlaceholder("memory limit (e.g. 512m, 2g)")
+	dialog.memory.SetPlaceholderTextColor(style.InfoBarItemFgColor)
+
+	// destroy checkbox
+	destroyLabel := "destroy original:"
+
+	dialog.destroy.SetBackgroundColor(bgColor)
+	dialog.destroy.SetLabelColor(fgColor)
+	dialog.destroy.SetLabel(destroyLabel)
+	dialog.destroy.SetLabelWidth(len(destroyLabel) + 1)
+	dialog.destroy.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// start checkbox
+	startLabel := "start clone:"
+
+	dialog.start.SetBackgroundColor(bgColor)
+	dialog.start.SetLabelColor(fgColor)
+	dialog.start.SetLabel(startLabel)
+	dialog.start.SetLabelWidth(len(startLabel) + 1)
+	dialog.start.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// form
+	dialog.form.AddButton("Cancel", nil)
+	dialog.form.AddButton("Clone", nil)
+	dialog.form.SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// cpus and memory layout row
+	resourcesLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	resourcesLayout.SetBackgroundColor(bgColor)
+	resourcesLayout.AddItem(dialog.cpus, 0, 1, true)
+	resourcesLayout.AddItem(utils.EmptyBoxSpace(bgColor), 2, 0, false) //nolint:gomnd
+	resourcesLayout.AddItem(dialog.memory, 0, 1, true)
+
+	// checkboxes layout row
+	checkboxLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	checkboxLayout.SetBackgroundColor(bgColor)
+	checkboxLayout.AddItem(dialog.destroy, len(destroyLabel)+4, 0, true) //nolint:gomnd
+	checkboxLayout.AddItem(dialog.start, 0, 1, true)
+
+	// layout
+	inputLayout := tview.NewFlex().SetDirection(tview.FlexRow)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.cntInfo, 1, 0, false)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.name, 1, 0, true)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.image, 1, 0, true)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(resourcesLayout, 1, 0, true)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(checkboxLayout, 1, 0, true)
+
+	mainLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	mainLayout.SetBackgroundColor(bgColor)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainLayout.AddItem(inputLayout, 0, 1, true)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetTitle("PODMAN CONTAINER CLONE")
+	dialog.layout.AddItem(mainLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, dialogs.DialogFormHeight, 0, true)
+
+	dialog.Hide()
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *ContainerCloneDialog) Display() {
+	d.display = true
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *ContainerCloneDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *ContainerCloneDialog) Hide() {
+	d.display = false
+	d.focusElement = cntCloneNameFocus
+
+	d.cntInfo.SetText("")
+	d.name.SetText("")
+	d.image.SetText("")
+	d.cpus.SetText("")
+	d.memory.SetText("")
+	d.destroy.SetChecked(false)
+	d.start.SetChecked(false)
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *ContainerCloneDialog) HasFocus() bool {
+	if d.name.HasFocus() || d.image.HasFocus() {
+		return true
+	}
+
+	if d.cpus.HasFocus() || d.memory.HasFocus() {
+		return true
+	}
+
+	if d.destroy.HasFocus() || d.start.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus() || d.form.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *ContainerCloneDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case cntCloneNameFocus:
+		delegate(d.name)
+	case cntCloneImageFocus:
+		delegate(d.image)
+	case cntCloneCPUsFocus:
+		delegate(d.cpus)
+	case cntCloneMemoryFocus:
+		delegate(d.memory)
+	case cntCloneDestroyFocus:
+		delegate(d.destroy)
+	case cntCloneStartFocus:
+		delegate(d.start)
+	case cntCloneFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = cntCloneNameFocus
+				d.Focus(delegate)
+				d.form.SetFocus(0)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *ContainerCloneDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) { //nolint:cyclop,lll
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("container clone dialog: event %v received", event)
+
+		if event.Key() == utils.SwitchFocusKey.Key {
+			d.setFocusElement()
+		}
+
+		if event.Key() == tcell.KeyEsc {
+			d.cancelHandler()
+
+			return
+		}
+
+		if d.name.HasFocus() {
+			if nameHandler := d.name.InputHandler(); nameHandler != nil {
+				nameHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.image.HasFocus() {
+			if imageHandler := d.image.InputHandler(); imageHandler != nil {
+				imageHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.cpus.HasFocus() {
+			if cpusHandler := d.cpus.InputHandler(); cpusHandler != nil {
+				cpusHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.memory.HasFocus() {
+			if memoryHandler := d.memory.InputHandler(); memoryHandler != nil {
+				memoryHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.destroy.HasFocus() {
+			if destroyHandler := d.destroy.InputHandler(); destroyHandler != nil {
+				destroyHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.start.HasFocus() {
+			if startHandler := d.start.InputHandler(); startHandler != nil {
+				startHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+func (d *ContainerCloneDialog) setFocusElement() {
+	switch d.focusElement {
+	case cntCloneNameFocus:
+		d.focusElement = cntCloneImageFocus
+	case cntCloneImageFocus:
+		d.focusElement = cntCloneCPUsFocus
+	case cntCloneCPUsFocus:
+		d.focusElement = cntCloneMemoryFocus
+	case cntCloneMemoryFocus:
+		d.focusElement = cntCloneDestroyFocus
+	case cntCloneDestroyFocus:
+		d.focusElement = cntCloneStartFocus
+	case cntCloneStartFocus:
+		d.focusElement = cntCloneFormFocus
+	}
+}
+
+// SetRect set rects for this primitive.
+func (d *ContainerCloneDialog) SetRect(x, y, width, height int) {
+	if width > cntCloneDialogMaxWidth {
+		emptySpace := (width - cntCloneDialogMaxWidth) / 2 //nolint:gomnd
+		x += emptySpace
+		width = cntCloneDialogMaxWidth
+	}
+
+	if height > cntCloneDialogHeight {
+		emptySpace := (height - cntCloneDialogHeight) / 2 //nolint:gomnd
+		y += emptySpace
+		height = cntCloneDialogHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *ContainerCloneDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.Box.DrawForSubclass(screen, d)
+
+	x, y, width, height := d.Box.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetCloneFunc sets form clone button selected function.
+func (d *ContainerCloneDialog) SetCloneFunc(handler func()) *ContainerCloneDialog {
+	d.cloneHandler = handler
+	cloneButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+	cloneButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *ContainerCloneDialog) SetCancelFunc(handler func()) *ContainerCloneDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 2) //nolint:gomnd
+
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetContainerInfo sets selected container ID and name in clone dialog.
+func (d *ContainerCloneDialog) SetContainerInfo(id string, name string) {
+	containerInfo := fmt.Sprintf("%s (%s)", id, name)
+
+	d.cntInfo.SetText(containerInfo)
+}
+
+// SetCloneOptions sets the clone name, image, cpus and memory input fields.
+func (d *ContainerCloneDialog) SetCloneOptions(opts containers.CntCloneOptions) {
+	d.name.SetText(opts.Name)
+	d.image.SetText(opts.Image)
+	d.cpus.SetText(opts.CPUs)
+	d.memory.SetText(opts.Memory)
+}
+
+// GetContainerCloneOptions returns container clone options based on user inputs.
+func (d *ContainerCloneDialog) GetContainerCloneOptions() containers.CntCloneOptions {
+	return containers.CntCloneOptions{
+		Name:    strings.TrimSpace(d.name.GetText()),
+		Image:   strings.TrimSpace(d.image.GetText()),
+		CPUs:    strings.TrimSpace(d.cpus.GetText()),
+		Memory:  strings.TrimSpace(d.memory.GetText()),
+		Destroy: d.destroy.IsChecked(),
+		Start:   d.start.IsChecked(),
+	}
+}
@@ -0,0 +1,152 @@
+package cntdialogs
+
+import (
+	"fmt"
+
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("container clone", Ordered, func() {
+	var containerCloneApp *tview.Application
+	var containerCloneScreen tcell.SimulationScreen
+	var cloneDialog *ContainerCloneDialog
+	var runApp func()
+
+	BeforeAll(func() {
+		containerCloneApp = tview.NewApplication()
+		cloneDialog = NewContainerCloneDialog()
+		containerCloneScreen = tcell.NewSimulationScreen("UTF-8")
+		err := containerCloneScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := containerCloneApp.SetScreen(containerCloneScreen).SetRoot(cloneDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		cloneDialog.Display()
+		Expect(cloneDialog.IsDisplay()).To(Equal(true))
+	})
+
+	It("set focus", func() {
+		containerCloneApp.SetFocus(cloneDialog)
+		Expect(cloneDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("set container info", func() {
+		cntID := "cntID"
+		cntName := "cntName"
+		cntInfoWants := fmt.Sprintf("%s (%s)", cntID, cntName)
+		cloneDialog.SetContainerInfo(cntID, cntName)
+		Expect(cloneDialog.cntInfo.GetText()).To(Equal(cntInfoWants))
+	})
+
+	It("cancel button selected", func() {
+		cancelFunc := func() {
+			cloneDialog.Hide()
+		}
+		cloneDialog.Hide()
+		containerCloneApp.Draw()
+		cloneDialog.SetCancelFunc(cancelFunc)
+		cloneDialog.Display()
+		containerCloneApp.Draw()
+		containerCloneApp.SetFocus(cloneDialog.form)
+		containerCloneApp.Draw()
+		containerCloneApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		containerCloneApp.Draw()
+		Expect(cloneDialog.IsDisplay()).To(Equal(false))
+	})
+
+	It("clone button selected", func() {
+		cloneButton := "initial"
+		cloneButtonWants := "clone selected"
+		cloneFunc := func() {
+			cloneButton = cloneButtonWants
+		}
+		cloneDialog.Hide()
+		containerCloneApp.Draw()
+		cloneDialog.SetCloneFunc(cloneFunc)
+		cloneDialog.Display()
+		containerCloneApp.Draw()
+		containerCloneApp.SetFocus(cloneDialog.form)
+		containerCloneApp.Draw()
+		containerCloneApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		containerCloneApp.Draw()
+		containerCloneApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		containerCloneApp.Draw()
+		Expect(cloneButton).To(Equal(cloneButtonWants))
+	})
+
+	It("get clone options", func() {
+		cloneOpts := containers.CntCloneOptions{
+			Name:   "cnt01-clone",
+			Image:  "docker.io/library/alpine:latest",
+			CPUs:   "1.5",
+			Memory: "512m",
+		}
+
+		cloneDialog.Hide()
+		containerCloneApp.Draw()
+		cloneDialog.SetCloneOptions(cloneOpts)
+		cloneDialog.Display()
+		containerCloneApp.Draw()
+		Expect(cloneDialog.GetContainerCloneOptions()).To(Equal(cloneOpts))
+
+		// override memory
+		cloneDialog.focusElement = cntCloneMemoryFocus
+		containerCloneApp.SetFocus(cloneDialog)
+		containerCloneApp.Draw()
+		containerCloneApp.QueueEvent(tcell.NewEventKey(tcell.KeyBackspace2, 0, tcell.ModNone))
+		containerCloneApp.Draw()
+		containerCloneApp.QueueEvent(tcell.NewEventKey(tcell.KeyBackspace2, 0, tcell.ModNone))
+		containerCloneApp.Draw()
+		containerCloneApp.QueueEvent(tcell.NewEventKey(tcell.KeyBackspace2, 0, tcell.ModNone))
+		containerCloneApp.Draw()
+		containerCloneApp.QueueEvent(tcell.NewEventKey(tcell.KeyBackspace2, 0, tcell.ModNone))
+		containerCloneApp.Draw()
+		containerCloneApp.QueueEvent(tcell.NewEventKey(256, '1', tcell.ModNone))
+		containerCloneApp.Draw()
+		containerCloneApp.QueueEvent(tcell.NewEventKey(256, 'g', tcell.ModNone))
+		containerCloneApp.Draw()
+
+		// destroy original checkbox
+		cloneDialog.setFocusElement()
+		containerCloneApp.SetFocus(cloneDialog)
+		containerCloneApp.Draw()
+		containerCloneApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		containerCloneApp.Draw()
+
+		// start clone checkbox
+		cloneDialog.setFocusElement()
+		containerCloneApp.SetFocus(cloneDialog)
+		containerCloneApp.Draw()
+		containerCloneApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		containerCloneApp.Draw()
+
+		cloneOpts.Memory = "1g"
+		cloneOpts.Destroy = true
+		cloneOpts.Start = true
+		Expect(cloneDialog.GetContainerCloneOptions()).To(Equal(cloneOpts))
+	})
+
+	It("hide", func() {
+		cloneDialog.Hide()
+		Expect(cloneDialog.IsDisplay()).To(Equal(false))
+		Expect(cloneDialog.GetContainerCloneOptions()).To(Equal(containers.CntCloneOptions{}))
+	})
+
+	AfterAll(func() {
+		containerCloneApp.Stop()
+	})
+})
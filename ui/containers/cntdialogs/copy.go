@@ -0,0 +1,363 @@
+package cntdialogs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	cntCopyDialogMaxWidth = 90
+	cntCopyDialogHeight   = 13
+)
+
+const (
+	cntCopyDirectionFocus = 0 + iota
+	cntCopySourceFocus
+	cntCopyDestinationFocus
+	cntCopyFormFocus
+)
+
+const (
+	// CopyToContainer is the local machine to container copy direction.
+	CopyToContainer = "local to container"
+	// CopyFromContainer is the container to local machine copy direction.
+	CopyFromContainer = "container to local"
+)
+
+// ContainerCopyDialog represents container copy (cp) dialog primitive.
+type ContainerCopyDialog struct {
+	*tview.Box
+	layout        *tview.Flex
+	cntInfo       *tview.InputField
+	direction     *tview.DropDown
+	source        *tview.InputField
+	destination   *tview.InputField
+	form          *tview.Form
+	display       bool
+	focusElement  int
+	copyHandler   func()
+	cancelHandler func()
+}
+
+// NewContainerCopyDialog returns new container copy dialog primitive.
+func NewContainerCopyDialog() *ContainerCopyDialog {
+	dialog := &ContainerCopyDialog{
+		Box:         tview.NewBox(),
+		layout:      tview.NewFlex().SetDirection(tview.FlexRow),
+		cntInfo:     tview.NewInputField(),
+		direction:   tview.NewDropDown(),
+		source:      tview.NewInputField(),
+		destination: tview.NewInputField(),
+		form:        tview.NewForm(),
+	}
+
+	bgColor := style.DialogBgColor
+	fgColor := style.DialogFgColor
+	inputFieldBgColor := style.InputFieldBgColor
+	labelWidth := 13
+
+	// container info input field
+	cntInfoLabel := "CONTAINER ID:"
+
+	dialog.cntInfo.SetBackgroundColor(bgColor)
+	dialog.cntInfo.SetLabel("[::b]" + cntInfoLabel)
+	dialog.cntInfo.SetLabelWidth(len(cntInfoLabel) + 1)
+	dialog.cntInfo.SetFieldBackgroundColor(bgColor)
+	dialog.cntInfo.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(fgColor))
+
+	// direction dropdown
+	dialog.direction.SetLabel("direction:")
+	dialog.direction.SetLabelWidth(labelWidth)
+	dialog.direction.SetLabelColor(fgColor)
+	dialog.direction.SetBackgroundColor(bgColor)
+	dialog.direction.SetListStyles(style.DropDownUnselected, style.DropDownSelected)
+	dialog.direction.SetFieldBackgroundColor(inputFieldBgColor)
+	dialog.direction.SetOptions([]string{CopyToContainer, CopyFromContainer}, func(_ string, _ int) {
+		dialog.setPathPlaceholders()
+	})
+
+	// source input field
+	dialog.source.SetBackgroundColor(bgColor)
+	dialog.source.SetLabelColor(fgColor)
+	dialog.source.SetLabel("source:")
+	dialog.source.SetLabelWidth(labelWidth)
+	dialog.source.SetFieldBackgroundColor(inputFieldBgColor)
+	dialog.source.SetPlaceholderTextColor(style.InfoBarItemFgColor)
+
+	// destination input field
+	dialog.destination.SetBackgroundColor(bgColor)
+	dialog.destination.SetLabelColor(fgColor)
+	dialog.destination.SetLabel("destination:")
+	dialog.destination.SetLabelWidth(labelWidth)
+	dialog.destination.SetFieldBackgroundColor(inputFieldBgColor)
+	dialog.destination.SetPlaceholderTextColor(style.InfoBarItemFgColor)
+
+	// form
+	dialog.form.AddButton("Cancel", nil)
+	dialog.form.AddButton("Copy", nil)
+	dialog.form.SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	inputLayout := tview.NewFlex().SetDirection(tview.FlexRow)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.cntInfo, 1, 0, false)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.direction, 1, 0, true)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.source, 1, 0, true)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.destination, 1, 0, true)
+
+	mainLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	mainLayout.SetBackgroundColor(bgColor)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainLayout.AddItem(inputLayout, 0, 1, true)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetTitle("PODMAN CONTAINER COPY")
+	dialog.layout.AddItem(mainLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, dialogs.DialogFormHeight, 0, true)
+
+	dialog.Hide()
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *ContainerCopyDialog) Display() {
+	d.display = true
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *ContainerCopyDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *ContainerCopyDialog) Hide() {
+	d.display = false
+	d.focusElement = cntCopyDirectionFocus
+
+	d.cntInfo.SetText("")
+	d.direction.SetCurrentOption(0)
+	d.source.SetText("")
+	d.destination.SetText("")
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *ContainerCopyDialog) HasFocus() bool {
+	if d.direction.HasFocus() || d.source.HasFocus() {
+		return true
+	}
+
+	if d.destination.HasFocus() || d.form.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *ContainerCopyDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case cntCopyDirectionFocus:
+		delegate(d.direction)
+	case cntCopySourceFocus:
+		delegate(d.source)
+	case cntCopyDestinationFocus:
+		delegate(d.destination)
+	case cntCopyFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = cntCopyDirectionFocus
+				d.Focus(delegate)
+				d.form.SetFocus(0)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *ContainerCopyDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("container copy dialog: event %v received", event)
+
+		if event.Key() == utils.SwitchFocusKey.Key {
+			d.setFocusElement()
+		}
+
+		// dropdown widgets shall handle events before "Esc" key handler
+		if d.direction.HasFocus() {
+			event = utils.ParseKeyEventKey(event)
+			if directionHandler := d.direction.InputHandler(); directionHandler != nil {
+				directionHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if event.Key() == tcell.KeyEsc {
+			d.cancelHandler()
+
+			return
+		}
+
+		if d.source.HasFocus() {
+			if sourceHandler := d.source.InputHandler(); sourceHandler != nil {
+				sourceHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.destination.HasFocus() {
+			if destinationHandler := d.destination.InputHandler(); destinationHandler != nil {
+				destinationHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+func (d *ContainerCopyDialog) setFocusElement() {
+	switch d.focusElement {
+	case cntCopyDirectionFocus:
+		d.focusElement = cntCopySourceFocus
+	case cntCopySourceFocus:
+		d.focusElement = cntCopyDestinationFocus
+	case cntCopyDestinationFocus:
+		d.focusElement = cntCopyFormFocus
+	}
+}
+
+// SetRect set rects for this primitive.
+func (d *ContainerCopyDialog) SetRect(x, y, width, height int) {
+	if width > cntCopyDialogMaxWidth {
+		emptySpace := (width - cntCopyDialogMaxWidth) / 2 //nolint:gomnd
+		x += emptySpace
+		width = cntCopyDialogMaxWidth
+	}
+
+	if height > cntCopyDialogHeight {
+		emptySpace := (height - cntCopyDialogHeight) / 2 //nolint:gomnd
+		y += emptySpace
+		height = cntCopyDialogHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *ContainerCopyDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.Box.DrawForSubclass(screen, d)
+
+	x, y, width, height := d.Box.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetCopyFunc sets form copy button selected function.
+func (d *ContainerCopyDialog) SetCopyFunc(handler func()) *ContainerCopyDialog {
+	d.copyHandler = handler
+	copyButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+	copyButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *ContainerCopyDialog) SetCancelFunc(handler func()) *ContainerCopyDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 2) //nolint:gomnd
+
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetContainerInfo sets selected container ID and name in copy dialog.
+func (d *ContainerCopyDialog) SetContainerInfo(id string, name string) {
+	containerInfo := fmt.Sprintf("%s (%s)", id, name)
+
+	d.cntInfo.SetText(containerInfo)
+}
+
+// SetCopyFromContainer sets container to local copy direction and the container source path.
+func (d *ContainerCopyDialog) SetCopyFromContainer(source string) {
+	d.direction.SetCurrentOption(1)
+	d.source.SetText(source)
+	d.focusElement = cntCopyDestinationFocus
+}
+
+// GetContainerCopyOptions returns container copy options based on user inputs.
+func (d *ContainerCopyDialog) GetContainerCopyOptions() (containers.CntCopyOptions, error) {
+	var err error
+
+	_, direction := d.direction.GetCurrentOption()
+
+	opts := containers.CntCopyOptions{
+		ToContainer: direction == CopyToContainer,
+		Source:      strings.TrimSpace(d.source.GetText()),
+		Destination: strings.TrimSpace(d.destination.GetText()),
+	}
+
+	// the local path can reference the home directory
+	if opts.ToContainer {
+		opts.Source, err = utils.ResolveHomeDir(opts.Source)
+	} else {
+		opts.Destination, err = utils.ResolveHomeDir(opts.Destination)
+	}
+
+	return opts, err
+}
+
+func (d *ContainerCopyDialog) setPathPlaceholders() {
+	sourcePlaceholder := "local file or directory path"
+	destinationPlaceholder := "container path"
+
+	_, direction := d.direction.GetCurrentOption()
+	if direction == CopyFromContainer {
+		sourcePlaceholder = "container file or directory path"
+		destinationPlaceholder = "local path"
+	}
+
+	d.source.SetPlaceholder(sourcePlaceholder)
+	d.destination.SetPlaceholder(destinationPlaceholder)
+}
@@ -0,0 +1,241 @@
+package cntdialogs
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/docker/go-units"
+	"github.com/gdamore/tcell/v2"
+	"github.com/navidys/tvxwidgets"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	cntCopyPrgDialogMaxWidth = 100
+	cntCopyPrgDialogHeight   = 14
+	// cntCopyPrgGaugeMaxValue is the progress gauge max value (the copied bytes are scaled).
+	cntCopyPrgGaugeMaxValue = 1000
+)
+
+// ContainerCopyProgressDialog implements container copy progress dialog primitive.
+type ContainerCopyProgressDialog struct {
+	*tview.Box
+	layout        *tview.Flex
+	copyInfo      *tview.InputField
+	progressBar   *tvxwidgets.PercentageModeGauge
+	info          *tview.TextView
+	form          *tview.Form
+	display       bool
+	mu            sync.Mutex
+	cancelHandler func()
+}
+
+// NewContainerCopyProgressDialog returns new container copy progress dialog.
+func NewContainerCopyProgressDialog() *ContainerCopyProgressDialog {
+	dialog := &ContainerCopyProgressDialog{
+		Box:         tview.NewBox(),
+		layout:      tview.NewFlex().SetDirection(tview.FlexRow),
+		copyInfo:    tview.NewInputField(),
+		progressBar: tvxwidgets.NewPercentageModeGauge(),
+		info:        tview.NewTextView(),
+	}
+
+	bgColor := style.DialogBgColor
+	fgColor := style.DialogFgColor
+
+	// copy info field
+	copyInfoLabel := "COPY:"
+
+	dialog.copyInfo.SetBackgroundColor(bgColor)
+	dialog.copyInfo.SetLabel("[::b]" + copyInfoLabel)
+	dialog.copyInfo.SetLabelWidth(len(copyInfoLabel) + 1)
+	dialog.copyInfo.SetFieldBackgroundColor(bgColor)
+	dialog.copyInfo.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(fgColor))
+
+	// progressbar
+	dialog.progressBar.SetBorder(true)
+	dialog.progressBar.SetBorderColor(style.DialogSubBoxBorderColor)
+	dialog.progressBar.SetPgBgColor(style.PrgBarColor)
+	dialog.progressBar.SetMaxValue(cntCopyPrgGaugeMaxValue)
+
+	// info
+	dialog.info.SetDynamicColors(true)
+	dialog.info.SetBackgroundColor(bgColor)
+	dialog.info.SetTextColor(fgColor)
+
+	// form
+	dialog.form = tview.NewForm().
+		AddButton("Cancel", nil).
+		SetButtonsAlign(tview.AlignRight)
+
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	infoLayout := tview.NewFlex().SetDirection(tview.FlexRow)
+	infoLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	infoLayout.AddItem(dialog.copyInfo, 1, 0, false)
+	infoLayout.AddItem(dialog.progressBar, 3, 0, false) //nolint:gomnd
+	infoLayout.AddItem(dialog.info, 0, 1, false)
+
+	mainLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainLayout.AddItem(infoLayout, 0, 1, false)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetTitle("PODMAN CONTAINER COPY")
+	dialog.layout.AddItem(mainLayout, 0, 1, false)
+	dialog.layout.AddItem(dialog.form, dialogs.DialogFormHeight, 0, true)
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *ContainerCopyProgressDialog) Display() {
+	d.display = true
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *ContainerCopyProgressDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *ContainerCopyProgressDialog) Hide() {
+	d.display = false
+
+	d.mu.Lock()
+	d.copyInfo.SetText("")
+	d.info.SetText("")
+	d.progressBar.Reset()
+	d.mu.Unlock()
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *ContainerCopyProgressDialog) HasFocus() bool {
+	if d.layout.HasFocus() || d.form.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *ContainerCopyProgressDialog) Focus(delegate func(p tview.Primitive)) {
+	delegate(d.form)
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *ContainerCopyProgressDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("container copy progress dialog: event %v received", event)
+
+		if event.Key() == tcell.KeyEsc || event.Key() == tcell.KeyEnter {
+			d.cancelHandler()
+
+			return
+		}
+
+		if formHandler := d.form.InputHandler(); formHandler != nil {
+			formHandler(event, setFocus)
+
+			return
+		}
+	})
+}
+
+// SetRect set rects for this primitive.
+func (d *ContainerCopyProgressDialog) SetRect(x, y, width, height int) {
+	if width > cntCopyPrgDialogMaxWidth {
+		emptySpace := (width - cntCopyPrgDialogMaxWidth) / 2 //nolint:gomnd
+		x += emptySpace
+		width = cntCopyPrgDialogMaxWidth
+	}
+
+	if height > cntCopyPrgDialogHeight {
+		emptySpace := (height - cntCopyPrgDialogHeight) / 2 //nolint:gomnd
+		y += emptySpace
+		height = cntCopyPrgDialogHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *ContainerCopyProgressDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.Box.DrawForSubclass(screen, d)
+	x, y, width, height := d.Box.GetInnerRect()
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *ContainerCopyProgressDialog) SetCancelFunc(handler func()) *ContainerCopyProgressDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetCopyInfo sets the copy source and destination paths.
+func (d *ContainerCopyProgressDialog) SetCopyInfo(source string, destination string) {
+	d.setInfo("PODMAN CONTAINER COPY", "COPY:", source, destination)
+}
+
+// SetExportInfo sets the exported container and the export output file.
+func (d *ContainerCopyProgressDialog) SetExportInfo(cntInfo string, output string) {
+	d.setInfo("PODMAN CONTAINER EXPORT", "EXPORT:", cntInfo, output)
+}
+
+func (d *ContainerCopyProgressDialog) setInfo(title string, label string, source string, destination string) {
+	d.mu.Lock()
+	d.layout.SetTitle(title)
+	d.copyInfo.SetLabel("[::b]" + label)
+	d.copyInfo.SetLabelWidth(len(label) + 1)
+	d.copyInfo.SetText(fmt.Sprintf("%s -> %s", source, destination))
+	d.mu.Unlock()
+}
+
+// UpdateProgress updates the dialog with the container copy progress report.
+func (d *ContainerCopyProgressDialog) UpdateProgress(progress containers.CntCopyProgress) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	copied := units.HumanSize(float64(progress.Current))
+
+	if progress.Total > 0 {
+		value := int(progress.Current * cntCopyPrgGaugeMaxValue / progress.Total)
+		if value > cntCopyPrgGaugeMaxValue {
+			value = cntCopyPrgGaugeMaxValue
+		}
+
+		d.progressBar.SetValue(value)
+
+		copied = fmt.Sprintf("%s / %s", copied, units.HumanSize(float64(progress.Total)))
+	}
+
+	labelColor := style.GetColorHex(style.DialogFgColor)
+	info := fmt.Sprintf("[%s::b]FILES:[-::-]  %d\n", labelColor, progress.Files)
+	info += fmt.Sprintf("[%s::b]COPIED:[-::-] %s\n", labelColor, copied)
+	info += fmt.Sprintf("[%s::b]FILE:[-::-]   %s", labelColor, tview.Escape(progress.File))
+
+	d.info.SetText(info)
+}
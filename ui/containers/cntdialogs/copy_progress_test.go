@@ -0,0 +1,102 @@
+package cntdialogs
+
+import (
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("container copy progress", Ordered, func() {
+	var copyPrgDialogApp *tview.Application
+	var copyPrgDialogScreen tcell.SimulationScreen
+	var copyPrgDialog *ContainerCopyProgressDialog
+	var runApp func()
+
+	BeforeAll(func() {
+		copyPrgDialogApp = tview.NewApplication()
+		copyPrgDialog = NewContainerCopyProgressDialog()
+		copyPrgDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := copyPrgDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := copyPrgDialogApp.SetScreen(copyPrgDialogScreen).SetRoot(copyPrgDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		copyPrgDialog.Display()
+		Expect(copyPrgDialog.IsDisplay()).To(Equal(true))
+	})
+
+	It("set focus", func() {
+		copyPrgDialogApp.SetFocus(copyPrgDialog)
+		Expect(copyPrgDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("update progress", func() {
+		copyPrgDialog.SetCopyInfo("/tmp/data", "cntID:/data")
+		copyPrgDialog.UpdateProgress(containers.CntCopyProgress{
+			File:    "data/a.txt",
+			Files:   3,
+			Current: 250,
+			Total:   1000,
+		})
+		copyPrgDialogApp.Draw()
+
+		info := copyPrgDialog.info.GetText(true)
+		Expect(copyPrgDialog.copyInfo.GetText()).To(Equal("/tmp/data -> cntID:/data"))
+		Expect(copyPrgDialog.progressBar.GetValue()).To(Equal(cntCopyPrgGaugeMaxValue / 4))
+		Expect(strings.Contains(info, "data/a.txt")).To(Equal(true))
+	})
+
+	It("update progress without total size", func() {
+		copyPrgDialog.UpdateProgress(containers.CntCopyProgress{
+			Files:   5,
+			Current: 2048,
+		})
+		Expect(copyPrgDialog.progressBar.GetValue()).To(Equal(cntCopyPrgGaugeMaxValue / 4))
+		Expect(strings.Contains(copyPrgDialog.info.GetText(true), "5")).To(Equal(true))
+	})
+
+	It("set export info", func() {
+		copyPrgDialog.SetExportInfo("cntID (cntName)", "/tmp/cntName.tar")
+		Expect(copyPrgDialog.copyInfo.GetText()).To(Equal("cntID (cntName) -> /tmp/cntName.tar"))
+		Expect(copyPrgDialog.copyInfo.GetLabel()).To(Equal("[::b]EXPORT:"))
+		Expect(copyPrgDialog.layout.GetTitle()).To(Equal("PODMAN CONTAINER EXPORT"))
+
+		copyPrgDialog.SetCopyInfo("/tmp/data", "cntID:/data")
+		Expect(copyPrgDialog.copyInfo.GetLabel()).To(Equal("[::b]COPY:"))
+		Expect(copyPrgDialog.layout.GetTitle()).To(Equal("PODMAN CONTAINER COPY"))
+	})
+
+	It("cancel key pressed", func() {
+		cancel := "initial"
+		cancelWants := "cancel"
+		copyPrgDialog.SetCancelFunc(func() {
+			cancel = cancelWants
+			copyPrgDialog.Hide()
+		})
+		copyPrgDialogApp.SetFocus(copyPrgDialog)
+		copyPrgDialogApp.Draw()
+		copyPrgDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone))
+		copyPrgDialogApp.Draw()
+		Expect(cancel).To(Equal(cancelWants))
+		Expect(copyPrgDialog.IsDisplay()).To(Equal(false))
+		Expect(copyPrgDialog.progressBar.GetValue()).To(Equal(0))
+	})
+
+	AfterAll(func() {
+		copyPrgDialogApp.Stop()
+	})
+})
@@ -0,0 +1,138 @@
+package cntdialogs
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("container copy", Ordered, func() {
+	var containerCopyApp *tview.Application
+	var containerCopyScreen tcell.SimulationScreen
+	var copyDialog *ContainerCopyDialog
+	var runApp func()
+
+	BeforeAll(func() {
+		containerCopyApp = tview.NewApplication()
+		copyDialog = NewContainerCopyDialog()
+		containerCopyScreen = tcell.NewSimulationScreen("UTF-8")
+		err := containerCopyScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := containerCopyApp.SetScreen(containerCopyScreen).SetRoot(copyDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		copyDialog.Display()
+		Expect(copyDialog.IsDisplay()).To(Equal(true))
+	})
+
+	It("set focus", func() {
+		containerCopyApp.SetFocus(copyDialog)
+		Expect(copyDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("set container info", func() {
+		cntID := "cntID"
+		cntName := "cntName"
+		cntInfoWants := fmt.Sprintf("%s (%s)", cntID, cntName)
+		copyDialog.SetContainerInfo(cntID, cntName)
+		Expect(copyDialog.cntInfo.GetText()).To(Equal(cntInfoWants))
+	})
+
+	It("cancel button selected", func() {
+		cancelFunc := func() {
+			copyDialog.Hide()
+		}
+		copyDialog.Hide()
+		containerCopyApp.Draw()
+		copyDialog.SetCancelFunc(cancelFunc)
+		copyDialog.Display()
+		containerCopyApp.Draw()
+		containerCopyApp.SetFocus(copyDialog.form)
+		containerCopyApp.Draw()
+		containerCopyApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		containerCopyApp.Draw()
+		Expect(copyDialog.IsDisplay()).To(Equal(false))
+	})
+
+	It("copy button selected", func() {
+		copyButton := "initial"
+		copyButtonWants := "copy selected"
+		copyFunc := func() {
+			copyButton = copyButtonWants
+		}
+		copyDialog.Hide()
+		containerCopyApp.Draw()
+		copyDialog.SetCopyFunc(copyFunc)
+		copyDialog.Display()
+		containerCopyApp.Draw()
+		containerCopyApp.SetFocus(copyDialog.form)
+		containerCopyApp.Draw()
+		containerCopyApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		containerCopyApp.Draw()
+		containerCopyApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		containerCopyApp.Draw()
+		Expect(copyButton).To(Equal(copyButtonWants))
+	})
+
+	It("cancel key pressed", func() {
+		cancel := "initial"
+		cancelWants := "cancel"
+		copyDialog.SetCancelFunc(func() {
+			cancel = cancelWants
+			copyDialog.Hide()
+		})
+		copyDialog.Display()
+		containerCopyApp.SetFocus(copyDialog)
+		containerCopyApp.Draw()
+		containerCopyApp.QueueEvent(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone))
+		containerCopyApp.Draw()
+		Expect(cancel).To(Equal(cancelWants))
+		Expect(copyDialog.IsDisplay()).To(Equal(false))
+	})
+
+	It("get copy options", func() {
+		copyDialog.Hide()
+		containerCopyApp.Draw()
+		copyDialog.Display()
+		containerCopyApp.Draw()
+		copyDialog.source.SetText(" /tmp/a ")
+		copyDialog.destination.SetText("/b")
+
+		copyOpts, err := copyDialog.GetContainerCopyOptions()
+		Expect(err).To(BeNil())
+		Expect(copyOpts.ToContainer).To(Equal(true))
+		Expect(copyOpts.Source).To(Equal("/tmp/a"))
+		Expect(copyOpts.Destination).To(Equal("/b"))
+
+		// container to local direction
+		copyDialog.direction.SetCurrentOption(1)
+
+		copyOpts, err = copyDialog.GetContainerCopyOptions()
+		Expect(err).To(BeNil())
+		Expect(copyOpts.ToContainer).To(Equal(false))
+	})
+
+	It("hide", func() {
+		copyDialog.Hide()
+		Expect(copyDialog.IsDisplay()).To(Equal(false))
+		Expect(copyDialog.source.GetText()).To(Equal(""))
+		Expect(copyDialog.destination.GetText()).To(Equal(""))
+	})
+
+	AfterAll(func() {
+		containerCopyApp.Stop()
+	})
+})
@@ -1408,7 +1408,7 @@ func (d *ContainerCreateDialog) initData() {
 	networkList, _ := networks.List()
 
 	for i := 0; i < len(networkList); i++ {
-		networkOptions = append(networkOptions, networkList[i][1])
+		networkOptions = append(networkOptions, networkList[i].Name)
 	}
 
 	// get available volumes
@@ -0,0 +1,426 @@
+package cntdialogs
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/containers"
+	putils "github.com/containers/podman-tui/pdcs/utils"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	cntFilesTableFocus = 0 + iota
+	cntFilesFormFocus
+)
+
+const (
+	viewCntFilesNameColIndex = 0 + iota
+	viewCntFilesModeColIndex
+	viewCntFilesSizeColIndex
+	viewCntFilesModTimeColIndex
+)
+
+const (
+	cntFilesViewButton     = "View"
+	cntFilesDownloadButton = "Download"
+	cntFilesCancelButton   = "Cancel"
+	cntFilesParentDir      = ".."
+	cntFilesModTimeFormat  = "2006-01-02 15:04:05"
+)
+
+// ContainerFilesDialog represents container filesystem browser dialog primitive.
+type ContainerFilesDialog struct {
+	*tview.Box
+	layout        *tview.Flex
+	cntInfo       *tview.InputField
+	pathInfo      *tview.InputField
+	table         *tview.Table
+	form          *tview.Form
+	headers       []string
+	tree          *containers.CntFileTree
+	files         []containers.CntFileInfo
+	currentDir    string
+	display       bool
+	focusElement  int
+	viewHandler   func()
+	cancelHandler func()
+}
+
+// NewContainerFilesDialog returns new container filesystem browser dialog.
+func NewContainerFilesDialog() *ContainerFilesDialog {
+	dialog := &ContainerFilesDialog{
+		Box:        tview.NewBox(),
+		cntInfo:    tview.NewInputField(),
+		pathInfo:   tview.NewInputField(),
+		table:      tview.NewTable(),
+		headers:    []string{"name", "mode", "size", "modified"},
+		currentDir: "/",
+	}
+
+	bgColor := style.DialogBgColor
+	fgColor := style.DialogFgColor
+
+	// container and path info fields
+	cntInfoLabel := "CONTAINER ID:"
+	pathInfoLabel := "PATH:"
+
+	dialog.cntInfo.SetBackgroundColor(bgColor)
+	dialog.cntInfo.SetLabel("[::b]" + cntInfoLabel)
+	dialog.cntInfo.SetLabelWidth(len(cntInfoLabel) + 1)
+	dialog.cntInfo.SetFieldBackgroundColor(bgColor)
+	dialog.cntInfo.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(fgColor))
+
+	dialog.pathInfo.SetBackgroundColor(bgColor)
+	dialog.pathInfo.SetLabel("[::b]" + pathInfoLabel)
+	dialog.pathInfo.SetLabelWidth(len(pathInfoLabel) + 1)
+	dialog.pathInfo.SetFieldBackgroundColor(bgColor)
+	dialog.pathInfo.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(fgColor))
+
+	// files table
+	dialog.table.SetBackgroundColor(bgColor)
+	dialog.table.SetBorder(true)
+	dialog.table.SetBorderColor(style.DialogSubBoxBorderColor)
+	dialog.table.SetSelectedFunc(func(_, _ int) {
+		dialog.open()
+	})
+	dialog.initTable()
+
+	// form
+	dialog.form = tview.NewForm().
+		AddButton(cntFilesCancelButton, nil).
+		AddButton(cntFilesDownloadButton, nil).
+		AddButton(cntFilesViewButton, nil).
+		SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	infoLayout := tview.NewFlex().SetDirection(tview.FlexRow)
+	infoLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	infoLayout.AddItem(dialog.cntInfo, 1, 0, false)
+	infoLayout.AddItem(dialog.pathInfo, 1, 0, false)
+	infoLayout.AddItem(dialog.table, 0, 1, true)
+
+	mainLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	mainLayout.SetBackgroundColor(bgColor)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainLayout.AddItem(infoLayout, 0, 1, true)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout = tview.NewFlex().SetDirection(tview.FlexRow)
+	dialog.layout.SetTitle("PODMAN CONTAINER FILES")
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.AddItem(mainLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, dialogs.DialogFormHeight, 0, true)
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *ContainerFilesDialog) Display() {
+	d.display = true
+	d.focusElement = cntFilesTableFocus
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *ContainerFilesDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *ContainerFilesDialog) Hide() {
+	d.display = false
+	d.focusElement = cntFilesTableFocus
+	d.tree = nil
+	d.files = nil
+	d.currentDir = "/"
+
+	d.cntInfo.SetText("")
+	d.pathInfo.SetText("")
+	d.initTable()
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *ContainerFilesDialog) HasFocus() bool {
+	if d.table.HasFocus() || d.form.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *ContainerFilesDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case cntFilesTableFocus:
+		delegate(d.table)
+	case cntFilesFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = cntFilesTableFocus
+				d.Focus(delegate)
+				d.form.SetFocus(0)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *ContainerFilesDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("container files dialog: event %v received", event)
+
+		if event.Key() == tcell.KeyEsc {
+			d.cancelHandler()
+
+			return
+		}
+
+		if event.Key() == utils.SwitchFocusKey.Key {
+			d.setFocusElement()
+		}
+
+		if d.table.HasFocus() {
+			// backspace opens the parent directory
+			if event.Key() == tcell.KeyBackspace || event.Key() == tcell.KeyBackspace2 {
+				d.SetPath(path.Dir(d.currentDir))
+
+				return
+			}
+
+			if tableHandler := d.table.InputHandler(); tableHandler != nil {
+				event = utils.ParseKeyEventKey(event)
+				tableHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+func (d *ContainerFilesDialog) setFocusElement() {
+	if d.focusElement == cntFilesTableFocus {
+		d.focusElement = cntFilesFormFocus
+	}
+}
+
+// SetRect set rects for this primitive.
+func (d *ContainerFilesDialog) SetRect(x, y, width, height int) {
+	dX := x + dialogs.DialogPadding
+	dY := y + 1
+	dWidth := width - (2 * dialogs.DialogPadding) //nolint:gomnd
+	dHeight := height - 2                         //nolint:gomnd
+
+	d.Box.SetRect(dX, dY, dWidth, dHeight)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *ContainerFilesDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.Box.DrawForSubclass(screen, d)
+	x, y, width, height := d.Box.GetInnerRect()
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetViewFunc sets form view button selected function, it's also called when a file is opened.
+func (d *ContainerFilesDialog) SetViewFunc(handler func()) *ContainerFilesDialog {
+	d.viewHandler = handler
+	d.setButtonFunc(cntFilesViewButton, handler)
+
+	return d
+}
+
+// SetDownloadFunc sets form download button selected function.
+func (d *ContainerFilesDialog) SetDownloadFunc(handler func()) *ContainerFilesDialog {
+	d.setButtonFunc(cntFilesDownloadButton, handler)
+
+	return d
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *ContainerFilesDialog) SetCancelFunc(handler func()) *ContainerFilesDialog {
+	d.cancelHandler = handler
+	d.setButtonFunc(cntFilesCancelButton, handler)
+
+	return d
+}
+
+func (d *ContainerFilesDialog) setButtonFunc(label string, handler func()) {
+	button := d.form.GetButton(d.form.GetButtonIndex(label))
+	button.SetSelectedFunc(handler)
+}
+
+// SetContainerInfo sets selected container ID and name in files dialog.
+func (d *ContainerFilesDialog) SetContainerInfo(id string, name string) {
+	containerInfo := fmt.Sprintf("%s (%s)", id, name)
+
+	d.cntInfo.SetText(containerInfo)
+}
+
+// SetFileTree sets the container filesystem tree and displays its root directory.
+func (d *ContainerFilesDialog) SetFileTree(tree *containers.CntFileTree) {
+	d.tree = tree
+	d.SetPath("/")
+}
+
+// SetPath displays the files of the specified directory path.
+func (d *ContainerFilesDialog) SetPath(dir string) {
+	if d.tree == nil {
+		return
+	}
+
+	files, err := d.tree.List(dir)
+	if err != nil {
+		log.Error().Msgf("container files dialog: %v", err)
+
+		return
+	}
+
+	previousDir := d.currentDir
+	selectedRow := 1
+
+	d.currentDir = path.Clean("/" + dir)
+	d.files = files
+	d.pathInfo.SetText(d.currentDir)
+	d.initTable()
+
+	row := 1
+
+	if d.currentDir != "/" {
+		d.table.SetCell(row, viewCntFilesNameColIndex,
+			tview.NewTableCell(cntFilesParentDir+"/").SetExpansion(1))
+
+		row++
+	}
+
+	for _, file := range files {
+		name := file.Name
+		size := putils.SizeToStr(file.Size)
+
+		switch {
+		case file.IsDir():
+			name += "/"
+			size = ""
+		case file.LinkTarget != "":
+			name = fmt.Sprintf("%s -> %s", name, file.LinkTarget)
+		}
+
+		// select the directory we came from when going up
+		if file.Path == previousDir {
+			selectedRow = row
+		}
+
+		d.table.SetCell(row, viewCntFilesNameColIndex,
+			tview.NewTableCell(tview.Escape(name)).SetExpansion(1))
+		d.table.SetCell(row, viewCntFilesModeColIndex,
+			tview.NewTableCell(file.Mode.String()).SetExpansion(0))
+		d.table.SetCell(row, viewCntFilesSizeColIndex,
+			tview.NewTableCell(size).SetExpansion(0).SetAlign(tview.AlignRight))
+		d.table.SetCell(row, viewCntFilesModTimeColIndex,
+			tview.NewTableCell(file.ModTime.Format(cntFilesModTimeFormat)).SetExpansion(0))
+
+		row++
+	}
+
+	if d.table.GetRowCount() > 1 {
+		d.table.Select(selectedRow, 0)
+	}
+
+	d.table.ScrollToBeginning()
+}
+
+// GetPath returns current directory path.
+func (d *ContainerFilesDialog) GetPath() string {
+	return d.currentDir
+}
+
+// GetSelectedFile returns the selected file information, symbolic links are resolved.
+// It returns false if no file is selected or the link target does not exist.
+func (d *ContainerFilesDialog) GetSelectedFile() (containers.CntFileInfo, bool) {
+	row, _ := d.table.GetSelection()
+	if d.currentDir != "/" {
+		row--
+	}
+
+	if d.tree == nil || row < 1 || row > len(d.files) {
+		return containers.CntFileInfo{}, false
+	}
+
+	return d.tree.Resolve(d.files[row-1].Path)
+}
+
+// open opens the selected directory or calls the view handler for the selected file.
+func (d *ContainerFilesDialog) open() {
+	row, _ := d.table.GetSelection()
+	if row == 1 && d.currentDir != "/" {
+		d.SetPath(path.Dir(d.currentDir))
+
+		return
+	}
+
+	file, ok := d.GetSelectedFile()
+	if !ok {
+		return
+	}
+
+	if file.IsDir() {
+		d.SetPath(file.Path)
+
+		return
+	}
+
+	if d.viewHandler != nil {
+		d.viewHandler()
+	}
+}
+
+func (d *ContainerFilesDialog) initTable() {
+	bgColor := style.TableHeaderBgColor
+	fgColor := style.TableHeaderFgColor
+
+	d.table.Clear()
+	d.table.SetFixed(1, 1)
+	d.table.SetSelectable(true, false)
+
+	for i := 0; i < len(d.headers); i++ {
+		d.table.SetCell(0, i,
+			tview.NewTableCell(fmt.Sprintf("[%s::b]%s", style.GetColorHex(fgColor), strings.ToUpper(d.headers[i]))).
+				SetExpansion(1).
+				SetBackgroundColor(bgColor).
+				SetTextColor(fgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
+	}
+}
@@ -0,0 +1,149 @@
+package cntdialogs
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("container files", Ordered, func() {
+	var containerFilesApp *tview.Application
+	var containerFilesScreen tcell.SimulationScreen
+	var filesDialog *ContainerFilesDialog
+	var runApp func()
+
+	BeforeAll(func() {
+		containerFilesApp = tview.NewApplication()
+		filesDialog = NewContainerFilesDialog()
+		containerFilesScreen = tcell.NewSimulationScreen("UTF-8")
+		err := containerFilesScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := containerFilesApp.SetScreen(containerFilesScreen).SetRoot(filesDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		filesDialog.Display()
+		Expect(filesDialog.IsDisplay()).To(Equal(true))
+	})
+
+	It("set focus", func() {
+		containerFilesApp.SetFocus(filesDialog)
+		Expect(filesDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("set container info", func() {
+		cntID := "cntID"
+		cntName := "cntName"
+		cntInfoWants := fmt.Sprintf("%s (%s)", cntID, cntName)
+		filesDialog.SetContainerInfo(cntID, cntName)
+		Expect(filesDialog.cntInfo.GetText()).To(Equal(cntInfoWants))
+	})
+
+	It("set file tree", func() {
+		tree := containers.NewCntFileTree()
+		tree.Add(containers.CntFileInfo{Name: "etc", Path: "/etc", Mode: os.ModeDir | 0o755})
+		tree.Add(containers.CntFileInfo{Name: "hosts", Path: "/etc/hosts", Mode: 0o644, Size: 10})
+		tree.Add(containers.CntFileInfo{Name: "a.txt", Path: "/a.txt", Mode: 0o644})
+		tree.Add(containers.CntFileInfo{Name: "h", Path: "/h", Mode: os.ModeSymlink | 0o777, LinkTarget: "etc/hosts"})
+
+		filesDialog.SetFileTree(tree)
+		containerFilesApp.Draw()
+		Expect(filesDialog.GetPath()).To(Equal("/"))
+		// header and the 3 root files (directories first)
+		Expect(filesDialog.table.GetRowCount()).To(Equal(4))
+		Expect(filesDialog.table.GetCell(1, viewCntFilesNameColIndex).Text).To(Equal("etc/"))
+
+		file, ok := filesDialog.GetSelectedFile()
+		Expect(ok).To(Equal(true))
+		Expect(file.Path).To(Equal("/etc"))
+	})
+
+	It("open directory", func() {
+		containerFilesApp.SetFocus(filesDialog)
+		containerFilesApp.Draw()
+		containerFilesApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		containerFilesApp.Draw()
+		Expect(filesDialog.GetPath()).To(Equal("/etc"))
+		// header, parent directory and hosts file
+		Expect(filesDialog.table.GetRowCount()).To(Equal(3))
+
+		filesDialog.table.Select(2, 0)
+		file, ok := filesDialog.GetSelectedFile()
+		Expect(ok).To(Equal(true))
+		Expect(file.Path).To(Equal("/etc/hosts"))
+	})
+
+	It("open parent directory", func() {
+		filesDialog.table.Select(1, 0)
+		containerFilesApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		containerFilesApp.Draw()
+		Expect(filesDialog.GetPath()).To(Equal("/"))
+	})
+
+	It("view selected symbolic link", func() {
+		view := "initial"
+		viewWants := "view"
+		filesDialog.SetViewFunc(func() {
+			view = viewWants
+		})
+		filesDialog.table.Select(3, 0)
+
+		file, ok := filesDialog.GetSelectedFile()
+		Expect(ok).To(Equal(true))
+		Expect(file.Path).To(Equal("/etc/hosts"))
+
+		containerFilesApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		containerFilesApp.Draw()
+		Expect(view).To(Equal(viewWants))
+	})
+
+	It("download button selected", func() {
+		download := "initial"
+		downloadWants := "download selected"
+		filesDialog.SetDownloadFunc(func() {
+			download = downloadWants
+		})
+		containerFilesApp.SetFocus(filesDialog.form)
+		containerFilesApp.Draw()
+		containerFilesApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		containerFilesApp.Draw()
+		containerFilesApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		containerFilesApp.Draw()
+		Expect(download).To(Equal(downloadWants))
+	})
+
+	It("cancel key pressed", func() {
+		cancel := "initial"
+		cancelWants := "cancel"
+		filesDialog.SetCancelFunc(func() {
+			cancel = cancelWants
+			filesDialog.Hide()
+		})
+		filesDialog.Display()
+		containerFilesApp.SetFocus(filesDialog)
+		containerFilesApp.Draw()
+		containerFilesApp.QueueEvent(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone))
+		containerFilesApp.Draw()
+		Expect(cancel).To(Equal(cancelWants))
+		Expect(filesDialog.IsDisplay()).To(Equal(false))
+		Expect(filesDialog.GetPath()).To(Equal("/"))
+	})
+
+	AfterAll(func() {
+		containerFilesApp.Stop()
+	})
+})
@@ -0,0 +1,335 @@
+package cntdialogs
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	cntHealthTableFocus = 0 + iota
+	cntHealthFormFocus
+)
+
+const (
+	viewCntHealthStartColIndex = 0 + iota
+	viewCntHealthDurationColIndex
+	viewCntHealthExitCodeColIndex
+	viewCntHealthOutputColIndex
+)
+
+const (
+	cntHealthCheckButton  = "Run check"
+	cntHealthCancelButton = "Cancel"
+	cntHealthTimeFormat   = "2006-01-02 15:04:05"
+)
+
+// ContainerHealthDialog represents container healthcheck history dialog primitive.
+type ContainerHealthDialog struct {
+	*tview.Box
+	layout        *tview.Flex
+	cntInfo       *tview.InputField
+	healthInfo    *tview.InputField
+	table         *tview.Table
+	form          *tview.Form
+	headers       []string
+	display       bool
+	focusElement  int
+	cancelHandler func()
+}
+
+// NewContainerHealthDialog returns new container healthcheck history dialog.
+func NewContainerHealthDialog() *ContainerHealthDialog {
+	dialog := &ContainerHealthDialog{
+		Box:        tview.NewBox(),
+		cntInfo:    tview.NewInputField(),
+		healthInfo: tview.NewInputField(),
+		table:      tview.NewTable(),
+		headers:    []string{"start", "duration", "exit code", "output"},
+	}
+
+	bgColor := style.DialogBgColor
+	fgColor := style.DialogFgColor
+
+	// container and health info fields
+	cntInfoLabel := "CONTAINER ID:"
+	healthInfoLabel := "HEALTH:"
+
+	dialog.cntInfo.SetBackgroundColor(bgColor)
+	dialog.cntInfo.SetLabel("[::b]" + cntInfoLabel)
+	dialog.cntInfo.SetLabelWidth(len(cntInfoLabel) + 1)
+	dialog.cntInfo.SetFieldBackgroundColor(bgColor)
+	dialog.cntInfo.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(fgColor))
+
+	dialog.healthInfo.SetBackgroundColor(bgColor)
+	dialog.healthInfo.SetLabel("[::b]" + healthInfoLabel)
+	dialog.healthInfo.SetLabelWidth(len(cntInfoLabel) + 1)
+	dialog.healthInfo.SetFieldBackgroundColor(bgColor)
+	dialog.healthInfo.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(fgColor))
+
+	// healthcheck log table
+	dialog.table.SetBackgroundColor(bgColor)
+	dialog.table.SetBorder(true)
+	dialog.table.SetBorderColor(style.DialogSubBoxBorderColor)
+	dialog.initTable()
+
+	// form
+	dialog.form = tview.NewForm().
+		AddButton(cntHealthCancelButton, nil).
+		AddButton(cntHealthCheckButton, nil).
+		SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	infoLayout := tview.NewFlex().SetDirection(tview.FlexRow)
+	infoLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	infoLayout.AddItem(dialog.cntInfo, 1, 0, false)
+	infoLayout.AddItem(dialog.healthInfo, 1, 0, false)
+	infoLayout.AddItem(dialog.table, 0, 1, true)
+
+	mainLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	mainLayout.SetBackgroundColor(bgColor)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainLayout.AddItem(infoLayout, 0, 1, true)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout = tview.NewFlex().SetDirection(tview.FlexRow)
+	dialog.layout.SetTitle("PODMAN CONTAINER HEALTH HISTORY")
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.AddItem(mainLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, dialogs.DialogFormHeight, 0, true)
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *ContainerHealthDialog) Display() {
+	d.display = true
+	d.focusElement = cntHealthTableFocus
+	d.table.ScrollToBeginning()
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *ContainerHealthDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *ContainerHealthDialog) Hide() {
+	d.display = false
+	d.focusElement = cntHealthTableFocus
+
+	d.cntInfo.SetText("")
+	d.healthInfo.SetText("")
+	d.initTable()
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *ContainerHealthDialog) HasFocus() bool {
+	if d.table.HasFocus() || d.form.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *ContainerHealthDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case cntHealthTableFocus:
+		delegate(d.table)
+	case cntHealthFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = cntHealthTableFocus
+				d.Focus(delegate)
+				d.form.SetFocus(0)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *ContainerHealthDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("container health dialog: event %v received", event)
+
+		if event.Key() == tcell.KeyEsc {
+			d.cancelHandler()
+
+			return
+		}
+
+		if event.Key() == utils.SwitchFocusKey.Key {
+			d.setFocusElement()
+		}
+
+		if d.table.HasFocus() {
+			if tableHandler := d.table.InputHandler(); tableHandler != nil {
+				event = utils.ParseKeyEventKey(event)
+				tableHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+func (d *ContainerHealthDialog) setFocusElement() {
+	if d.focusElement == cntHealthTableFocus {
+		d.focusElement = cntHealthFormFocus
+	}
+}
+
+// SetRect set rects for this primitive.
+func (d *ContainerHealthDialog) SetRect(x, y, width, height int) {
+	dX := x + dialogs.DialogPadding
+	dY := y + 1
+	dWidth := width - (2 * dialogs.DialogPadding) //nolint:gomnd
+	dHeight := height - 2                         //nolint:gomnd
+
+	d.Box.SetRect(dX, dY, dWidth, dHeight)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *ContainerHealthDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.Box.DrawForSubclass(screen, d)
+	x, y, width, height := d.Box.GetInnerRect()
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetHealthCheckFunc sets form run check button selected function.
+func (d *ContainerHealthDialog) SetHealthCheckFunc(handler func()) *ContainerHealthDialog {
+	d.setButtonFunc(cntHealthCheckButton, handler)
+
+	return d
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *ContainerHealthDialog) SetCancelFunc(handler func()) *ContainerHealthDialog {
+	d.cancelHandler = handler
+	d.setButtonFunc(cntHealthCancelButton, handler)
+
+	return d
+}
+
+func (d *ContainerHealthDialog) setButtonFunc(label string, handler func()) {
+	button := d.form.GetButton(d.form.GetButtonIndex(label))
+	button.SetSelectedFunc(handler)
+}
+
+// SetContainerInfo sets selected container ID and name in health dialog.
+func (d *ContainerHealthDialog) SetContainerInfo(id string, name string) {
+	containerInfo := fmt.Sprintf("%s (%s)", id, name)
+
+	d.cntInfo.SetText(containerInfo)
+}
+
+// SetHealth sets the container health status and failing streak and updates the
+// healthcheck log table, the most recent healthcheck is displayed first.
+func (d *ContainerHealthDialog) SetHealth(health *define.HealthCheckResults) {
+	d.initTable()
+
+	if health == nil {
+		d.healthInfo.SetText("no healthcheck")
+
+		return
+	}
+
+	d.healthInfo.SetText(fmt.Sprintf("%s (failing streak %d)", health.Status, health.FailingStreak))
+
+	rowIndex := 1
+
+	for i := len(health.Log) - 1; i >= 0; i-- {
+		entry := health.Log[i]
+		start, startErr := time.Parse(time.RFC3339Nano, entry.Start)
+		end, endErr := time.Parse(time.RFC3339Nano, entry.End)
+
+		startText := entry.Start
+		duration := ""
+
+		if startErr == nil {
+			startText = start.Local().Format(cntHealthTimeFormat)
+
+			if endErr == nil {
+				duration = end.Sub(start).Round(time.Millisecond).String()
+			}
+		}
+
+		exitCodeColor := "green"
+		if entry.ExitCode != 0 {
+			exitCodeColor = "red"
+		}
+
+		cells := []string{
+			startText,
+			duration,
+			fmt.Sprintf("[%s::]%s", exitCodeColor, strconv.Itoa(entry.ExitCode)),
+			strings.Join(strings.Fields(entry.Output), " "),
+		}
+
+		for col, text := range cells {
+			d.table.SetCell(rowIndex, col,
+				tview.NewTableCell(text).
+					SetExpansion(1).
+					SetAlign(tview.AlignLeft))
+		}
+
+		rowIndex++
+	}
+}
+
+func (d *ContainerHealthDialog) initTable() {
+	bgColor := style.TableHeaderBgColor
+	fgColor := style.TableHeaderFgColor
+
+	d.table.Clear()
+	d.table.SetFixed(1, 1)
+	d.table.SetSelectable(true, false)
+
+	for i := 0; i < len(d.headers); i++ {
+		d.table.SetCell(0, i,
+			tview.NewTableCell(fmt.Sprintf("[%s::b]%s", style.GetColorHex(fgColor), strings.ToUpper(d.headers[i]))).
+				SetExpansion(1).
+				SetBackgroundColor(bgColor).
+				SetTextColor(fgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
+	}
+}
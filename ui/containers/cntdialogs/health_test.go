@@ -0,0 +1,130 @@
+package cntdialogs
+
+import (
+	"fmt"
+
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("container health", Ordered, func() {
+	var containerHealthApp *tview.Application
+	var containerHealthScreen tcell.SimulationScreen
+	var healthDialog *ContainerHealthDialog
+	var runApp func()
+
+	BeforeAll(func() {
+		containerHealthApp = tview.NewApplication()
+		healthDialog = NewContainerHealthDialog()
+		containerHealthScreen = tcell.NewSimulationScreen("UTF-8")
+		err := containerHealthScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := containerHealthApp.SetScreen(containerHealthScreen).SetRoot(healthDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		healthDialog.Display()
+		Expect(healthDialog.IsDisplay()).To(Equal(true))
+		Expect(healthDialog.focusElement).To(Equal(cntHealthTableFocus))
+	})
+
+	It("set focus", func() {
+		containerHealthApp.SetFocus(healthDialog)
+		Expect(healthDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("set container info", func() {
+		cntID := "cntID"
+		cntName := "cntName"
+		cntInfoWants := fmt.Sprintf("%s (%s)", cntID, cntName)
+		healthDialog.SetContainerInfo(cntID, cntName)
+		Expect(healthDialog.cntInfo.GetText()).To(Equal(cntInfoWants))
+	})
+
+	It("set health", func() {
+		healthDialog.SetHealth(&define.HealthCheckResults{
+			Status:        define.HealthCheckUnhealthy,
+			FailingStreak: 1,
+			Log: []define.HealthCheckLog{
+				{
+					Start:    "2024-05-01T10:00:00.000000000Z",
+					End:      "2024-05-01T10:00:00.250000000Z",
+					ExitCode: 0,
+					Output:   "ok\n",
+				},
+				{
+					Start:    "2024-05-01T10:00:30.000000000Z",
+					End:      "2024-05-01T10:00:31.000000000Z",
+					ExitCode: 1,
+					Output:   "connection\nrefused",
+				},
+			},
+		})
+		containerHealthApp.Draw()
+
+		Expect(healthDialog.healthInfo.GetText()).To(Equal("unhealthy (failing streak 1)"))
+		// header and the 2 healthcheck log entries (most recent first)
+		Expect(healthDialog.table.GetRowCount()).To(Equal(3))
+		Expect(healthDialog.table.GetCell(1, viewCntHealthDurationColIndex).Text).To(Equal("1s"))
+		Expect(healthDialog.table.GetCell(1, viewCntHealthExitCodeColIndex).Text).To(Equal("[red::]1"))
+		Expect(healthDialog.table.GetCell(1, viewCntHealthOutputColIndex).Text).To(Equal("connection refused"))
+		Expect(healthDialog.table.GetCell(2, viewCntHealthDurationColIndex).Text).To(Equal("250ms"))
+		Expect(healthDialog.table.GetCell(2, viewCntHealthOutputColIndex).Text).To(Equal("ok"))
+		Expect(healthDialog.table.GetCell(2, viewCntHealthStartColIndex).Text).NotTo(BeEmpty())
+	})
+
+	It("set health without healthcheck", func() {
+		healthDialog.SetHealth(nil)
+		Expect(healthDialog.healthInfo.GetText()).To(Equal("no healthcheck"))
+		Expect(healthDialog.table.GetRowCount()).To(Equal(1))
+	})
+
+	It("run check button selected", func() {
+		checkWants := "run check selected"
+		checkAction := "run check init"
+		checkFunc := func() {
+			checkAction = checkWants
+		}
+		healthDialog.SetHealthCheckFunc(checkFunc)
+		healthDialog.focusElement = cntHealthFormFocus
+		containerHealthApp.SetFocus(healthDialog)
+		containerHealthApp.Draw()
+		containerHealthApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		containerHealthApp.Draw()
+		containerHealthApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		containerHealthApp.Draw()
+		Expect(checkAction).To(Equal(checkWants))
+	})
+
+	It("cancel key pressed", func() {
+		cancelWants := "cancel"
+		cancelAction := "cancel init"
+		healthDialog.SetCancelFunc(func() {
+			cancelAction = cancelWants
+			healthDialog.Hide()
+		})
+		containerHealthApp.SetFocus(healthDialog)
+		containerHealthApp.Draw()
+		containerHealthApp.QueueEvent(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone))
+		containerHealthApp.Draw()
+		Expect(cancelAction).To(Equal(cancelWants))
+		Expect(healthDialog.IsDisplay()).To(Equal(false))
+		Expect(healthDialog.cntInfo.GetText()).To(Equal(""))
+	})
+
+	AfterAll(func() {
+		containerHealthApp.Stop()
+	})
+})
@@ -0,0 +1,806 @@
+package cntdialogs
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	cntLogsDialogLabelWidth = 11
+	// maximum number of log lines kept in the dialog buffer.
+	cntLogsMaxLines = 5000
+	// screen refresh interval while log lines are received.
+	cntLogsRefreshInterval = 200 * time.Millisecond
+)
+
+const (
+	cntLogsSinceFocus = 0 + iota
+	cntLogsUntilFocus
+	cntLogsTailFocus
+	cntLogsTimestampsFocus
+	cntLogsFollowFocus
+	cntLogsSearchFocus
+	cntLogsOutputFocus
+	cntLogsFormFocus
+)
+
+const (
+	cntLogsStreamStopped = 0 + iota
+	cntLogsStreamRunning
+	cntLogsStreamEnded
+)
+
+// ContainerLogsDialog implements the container logs dialog primitive.
+type ContainerLogsDialog struct {
+	*tview.Box
+	layout             *tview.Flex
+	cntInfo            *tview.InputField
+	since              *tview.InputField
+	until              *tview.InputField
+	tail               *tview.InputField
+	timestamps         *tview.Checkbox
+	follow             *tview.Checkbox
+	search             *tview.InputField
+	status             *tview.TextView
+	output             *tview.TextView
+	form               *tview.Form
+	display            bool
+	focusElement       int
+	mu                 sync.Mutex
+	lines              []containers.CntLogLine
+	paused             bool
+	pendingLines       int
+	streamID           int
+	streamStatus       int
+	cancelChan         chan bool
+	searchPattern      *regexp.Regexp
+	searchErr          error
+	matchCount         int
+	currentMatch       int
+	cancelHandler      func()
+	reloadHandler      func()
+	fastRefreshHandler func()
+	queueUpdateHandler func(f func())
+}
+
+// NewContainerLogsDialog returns new container logs dialog primitive.
+func NewContainerLogsDialog() *ContainerLogsDialog {
+	dialog := &ContainerLogsDialog{
+		Box:        tview.NewBox(),
+		layout:     tview.NewFlex().SetDirection(tview.FlexRow),
+		cntInfo:    tview.NewInputField(),
+		since:      tview.NewInputField(),
+		until:      tview.NewInputField(),
+		tail:       tview.NewInputField(),
+		timestamps: tview.NewCheckbox(),
+		follow:     tview.NewCheckbox(),
+		search:     tview.NewInputField(),
+		status:     tview.NewTextView(),
+		output:     tview.NewTextView(),
+		form:       tview.NewForm(),
+	}
+
+	bgColor := style.DialogBgColor
+	inputFieldBgColor := style.InputFieldBgColor
+
+	// container info input field
+	cntInfoLabel := "CONTAINER ID:"
+
+	dialog.cntInfo.SetBackgroundColor(bgColor)
+	dialog.cntInfo.SetLabel("[::b]" + cntInfoLabel)
+	dialog.cntInfo.SetLabelWidth(len(cntInfoLabel) + 1)
+	dialog.cntInfo.SetFieldBackgroundColor(bgColor)
+	dialog.cntInfo.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(style.DialogFgColor))
+
+	// since field
+	dialog.since.SetBackgroundColor(bgColor)
+	dialog.since.SetLabelColor(style.DialogFgColor)
+	dialog.since.SetLabel("since:")
+	dialog.since.SetLabelWidth(cntLogsDialogLabelWidth)
+	dialog.since.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// until field
+	untilLabel := "until:"
+
+	dialog.until.SetBackgroundColor(bgColor)
+	dialog.until.SetLabelColor(style.DialogFgColor)
+	dialog.until.SetLabel(untilLabel)
+	dialog.until.SetLabelWidth(len(untilLabel) + 1)
+	dialog.until.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// tail field
+	tailLabel := "tail:"
+
+	dialog.tail.SetBackgroundColor(bgColor)
+	dialog.tail.SetLabelColor(style.DialogFgColor)
+	dialog.tail.SetLabel(tailLabel)
+	dialog.tail.SetLabelWidth(len(tailLabel) + 1)
+	dialog.tail.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// timestamps checkbox
+	timestampsLabel := "timestamps:"
+
+	dialog.timestamps.SetBackgroundColor(bgColor)
+	dialog.timestamps.SetLabelColor(style.DialogFgColor)
+	dialog.timestamps.SetLabel(timestampsLabel)
+	dialog.timestamps.SetLabelWidth(len(timestampsLabel) + 1)
+	dialog.timestamps.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// follow checkbox
+	followLabel := "follow:"
+
+	dialog.follow.SetBackgroundColor(bgColor)
+	dialog.follow.SetLabelColor(style.DialogFgColor)
+	dialog.follow.SetLabel(followLabel)
+	dialog.follow.SetLabelWidth(len(followLabel) + 1)
+	dialog.follow.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// search field
+	dialog.search.SetBackgroundColor(bgColor)
+	dialog.search.SetLabelColor(style.DialogFgColor)
+	dialog.search.SetLabel("search:")
+	dialog.search.SetLabelWidth(cntLogsDialogLabelWidth)
+	dialog.search.SetFieldBackgroundColor(inputFieldBgColor)
+	dialog.search.SetChangedFunc(dialog.setSearchPattern)
+
+	// status
+	dialog.status.SetDynamicColors(true)
+	dialog.status.SetTextAlign(tview.AlignRight)
+	dialog.status.SetBackgroundColor(bgColor)
+	dialog.status.SetTextColor(style.DialogFgColor)
+
+	// output
+	dialog.output.SetDynamicColors(true).
+		SetRegions(true).
+		SetWrap(true).
+		SetTextAlign(tview.AlignLeft)
+	dialog.output.SetBackgroundColor(style.TerminalBgColor)
+	dialog.output.SetTextColor(style.TerminalFgColor)
+	dialog.output.SetBorder(true)
+	dialog.output.SetBorderColor(style.DialogSubBoxBorderColor)
+
+	// form
+	dialog.form.AddButton("Cancel", nil)
+	dialog.form.AddButton("Pause", nil)
+	dialog.form.AddButton("Reload", nil)
+	dialog.form.SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	dialog.form.GetButton(dialog.form.GetButtonCount() - 2).SetSelectedFunc(dialog.togglePause) //nolint:gomnd
+
+	// options layout row
+	optionsLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	optionsLayout.SetBackgroundColor(bgColor)
+	optionsLayout.AddItem(dialog.since, 0, 1, true)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(dialog.until, 0, 1, true)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(dialog.tail, 0, 1, true)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(dialog.timestamps, len(timestampsLabel)+3, 0, true) //nolint:gomnd
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(dialog.follow, len(followLabel)+3, 0, true) //nolint:gomnd
+
+	// search layout row
+	searchLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	searchLayout.SetBackgroundColor(bgColor)
+	searchLayout.AddItem(dialog.search, 0, 1, true)
+	searchLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	searchLayout.AddItem(dialog.status, 0, 1, false)
+
+	// inputs layout
+	layout := tview.NewFlex().SetDirection(tview.FlexRow)
+	layout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	layout.AddItem(dialog.cntInfo, 1, 0, false)
+	layout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	layout.AddItem(optionsLayout, 1, 0, true)
+	layout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	layout.AddItem(searchLayout, 1, 0, true)
+	layout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	layout.AddItem(dialog.output, 0, 1, true)
+
+	mainLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	mainLayout.SetBackgroundColor(bgColor)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainLayout.AddItem(layout, 0, 1, true)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	// main layout
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetTitle("PODMAN CONTAINER LOGS")
+	dialog.layout.AddItem(mainLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, dialogs.DialogFormHeight, 0, true)
+
+	dialog.Hide()
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *ContainerLogsDialog) Display() {
+	d.display = true
+	d.focusElement = cntLogsOutputFocus
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *ContainerLogsDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *ContainerLogsDialog) Hide() {
+	d.display = false
+	d.focusElement = cntLogsOutputFocus
+
+	d.stopLogStream()
+
+	d.mu.Lock()
+	d.lines = nil
+	d.paused = false
+	d.pendingLines = 0
+	d.streamStatus = cntLogsStreamStopped
+	d.mu.Unlock()
+
+	d.form.GetButton(d.form.GetButtonCount() - 2).SetLabel("Pause") //nolint:gomnd
+	d.SetContainerInfo("", "")
+	d.since.SetText("")
+	d.until.SetText("")
+	d.tail.SetText("")
+	d.timestamps.SetChecked(false)
+	d.follow.SetChecked(true)
+	d.search.SetText("")
+	d.output.Clear()
+	d.updateStatus()
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *ContainerLogsDialog) HasFocus() bool { //nolint:cyclop
+	if d.since.HasFocus() || d.until.HasFocus() {
+		return true
+	}
+
+	if d.tail.HasFocus() || d.timestamps.HasFocus() {
+		return true
+	}
+
+	if d.follow.HasFocus() || d.search.HasFocus() {
+		return true
+	}
+
+	if d.output.HasFocus() || d.form.HasFocus() {
+		return true
+	}
+
+	if d.layout.HasFocus() || d.Box.HasFocus() {
+		return true
+	}
+
+	return false
+}
+
+// Focus is called when this primitive receives focus.
+func (d *ContainerLogsDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case cntLogsSinceFocus:
+		delegate(d.since)
+	case cntLogsUntilFocus:
+		delegate(d.until)
+	case cntLogsTailFocus:
+		delegate(d.tail)
+	case cntLogsTimestampsFocus:
+		delegate(d.timestamps)
+	case cntLogsFollowFocus:
+		delegate(d.follow)
+	case cntLogsSearchFocus:
+		delegate(d.search)
+	case cntLogsOutputFocus:
+		delegate(d.output)
+	case cntLogsFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = cntLogsSinceFocus
+
+				d.Focus(delegate)
+				d.form.SetFocus(0)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *ContainerLogsDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) { //nolint:gocognit,lll,cyclop
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("container logs dialog: event %v received", event)
+
+		if event.Key() == utils.CloseDialogKey.Key {
+			d.cancelHandler()
+
+			return
+		}
+
+		if event.Key() == utils.SwitchFocusKey.Key {
+			d.setFocusElement()
+		}
+
+		if d.since.HasFocus() {
+			if sinceHandler := d.since.InputHandler(); sinceHandler != nil {
+				sinceHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.until.HasFocus() {
+			if untilHandler := d.until.InputHandler(); untilHandler != nil {
+				untilHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.tail.HasFocus() {
+			if tailHandler := d.tail.InputHandler(); tailHandler != nil {
+				tailHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.timestamps.HasFocus() {
+			if timestampsHandler := d.timestamps.InputHandler(); timestampsHandler != nil {
+				timestampsHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.follow.HasFocus() {
+			if followHandler := d.follow.InputHandler(); followHandler != nil {
+				followHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.search.HasFocus() {
+			// enter key jumps to the next search match
+			if event.Key() == tcell.KeyEnter {
+				d.nextMatch()
+
+				return
+			}
+
+			if searchHandler := d.search.InputHandler(); searchHandler != nil {
+				searchHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.output.HasFocus() {
+			if outputHandler := d.output.InputHandler(); outputHandler != nil {
+				outputHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+func (d *ContainerLogsDialog) setFocusElement() {
+	switch d.focusElement {
+	case cntLogsSinceFocus:
+		d.focusElement = cntLogsUntilFocus
+	case cntLogsUntilFocus:
+		d.focusElement = cntLogsTailFocus
+	case cntLogsTailFocus:
+		d.focusElement = cntLogsTimestampsFocus
+	case cntLogsTimestampsFocus:
+		d.focusElement = cntLogsFollowFocus
+	case cntLogsFollowFocus:
+		d.focusElement = cntLogsSearchFocus
+	case cntLogsSearchFocus:
+		d.focusElement = cntLogsOutputFocus
+	case cntLogsOutputFocus:
+		d.focusElement = cntLogsFormFocus
+	}
+}
+
+// SetRect set rects for this primitive.
+func (d *ContainerLogsDialog) SetRect(x, y, width, height int) {
+	dX := x + dialogs.DialogPadding
+	dY := y + dialogs.DialogPadding - 1
+	dWidth := width - (2 * dialogs.DialogPadding)         //nolint:gomnd
+	dHeight := height - (2 * (dialogs.DialogPadding - 1)) //nolint:gomnd
+
+	d.Box.SetRect(dX, dY, dWidth, dHeight)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *ContainerLogsDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.Box.DrawForSubclass(screen, d)
+
+	x, y, width, height := d.Box.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *ContainerLogsDialog) SetCancelFunc(handler func()) *ContainerLogsDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 3) //nolint:gomnd
+
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetReloadFunc sets form reload button selected function.
+func (d *ContainerLogsDialog) SetReloadFunc(handler func()) *ContainerLogsDialog {
+	d.reloadHandler = handler
+	reloadButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+	reloadButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetFastRefreshHandler sets fast refresh handler
+// fast refresh is used to print the log lines as soon as they are received.
+func (d *ContainerLogsDialog) SetFastRefreshHandler(handler func()) {
+	d.fastRefreshHandler = handler
+}
+
+// SetQueueUpdateDrawHandler sets the handler which runs a function on the application
+// UI goroutine and draws the screen, the received log lines are written to the output by batches
+// through the handler.
+func (d *ContainerLogsDialog) SetQueueUpdateDrawHandler(handler func(f func())) {
+	d.queueUpdateHandler = handler
+}
+
+// SetContainerInfo sets selected container ID and name in logs dialog.
+func (d *ContainerLogsDialog) SetContainerInfo(id string, name string) {
+	containerInfo := fmt.Sprintf("%s (%s)", id, name)
+
+	d.cntInfo.SetText(containerInfo)
+}
+
+// GetContainerLogsOptions returns container logs options based on user inputs.
+func (d *ContainerLogsDialog) GetContainerLogsOptions() containers.CntLogsOptions {
+	var opts containers.CntLogsOptions
+
+	opts.Since = strings.TrimSpace(d.since.GetText())
+	opts.Until = strings.TrimSpace(d.until.GetText())
+	opts.Tail = strings.TrimSpace(d.tail.GetText())
+	opts.Timestamps = d.timestamps.IsChecked()
+	opts.Follow = d.follow.IsChecked()
+
+	return opts
+}
+
+// NewLogStream stops the running log stream (if any), clears the output
+// and returns the log and cancel channels of a new stream.
+// The log channel shall be closed by its writer once the stream ends.
+func (d *ContainerLogsDialog) NewLogStream() (chan containers.CntLogLine, chan bool) {
+	d.stopLogStream()
+
+	logChan := make(chan containers.CntLogLine, 100) //nolint:gomnd
+	cancelChan := make(chan bool)
+
+	d.mu.Lock()
+	d.streamID++
+	d.cancelChan = cancelChan
+	d.streamStatus = cntLogsStreamRunning
+	d.lines = nil
+	d.pendingLines = 0
+	streamID := d.streamID
+	d.mu.Unlock()
+
+	d.output.Clear()
+	d.render()
+
+	go d.logReaderLoop(streamID, logChan)
+
+	return logChan, cancelChan
+}
+
+func (d *ContainerLogsDialog) stopLogStream() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.cancelChan != nil {
+		close(d.cancelChan)
+
+		d.cancelChan = nil
+	}
+}
+
+// logReaderLoop reads the log stream lines and writes them to the output by batches
+// (once per refresh interval) from the UI goroutine.
+func (d *ContainerLogsDialog) logReaderLoop(streamID int, logChan chan containers.CntLogLine) {
+	var batch []containers.CntLogLine
+
+	tick := time.NewTicker(cntLogsRefreshInterval)
+
+	log.Debug().Msgf("container logs dialog: log reader %d started", streamID)
+
+	defer tick.Stop()
+
+	for {
+		select {
+		case line, ok := <-logChan:
+			if !ok {
+				log.Debug().Msgf("container logs dialog: log reader %d stopped", streamID)
+
+				d.mu.Lock()
+				if d.streamID == streamID {
+					d.streamStatus = cntLogsStreamEnded
+				}
+				d.mu.Unlock()
+
+				d.writeLines(streamID, batch)
+
+				return
+			}
+
+			batch = append(batch, line)
+		case <-tick.C:
+			if len(batch) > 0 {
+				d.writeLines(streamID, batch)
+
+				batch = nil
+			}
+		}
+	}
+}
+
+// writeLines appends the log lines batch to the output and updates the status
+// from the UI goroutine.
+func (d *ContainerLogsDialog) writeLines(streamID int, lines []containers.CntLogLine) {
+	write := func() {
+		for _, line := range lines {
+			d.appendLine(streamID, line)
+		}
+
+		d.updateStatus()
+	}
+
+	if d.queueUpdateHandler != nil {
+		d.queueUpdateHandler(write)
+
+		return
+	}
+
+	write()
+	d.refresh()
+}
+
+// appendLine appends the line to the lines buffer and the output, it shall be
+// called from the UI goroutine.
+func (d *ContainerLogsDialog) appendLine(streamID int, line containers.CntLogLine) {
+	d.mu.Lock()
+
+	if d.streamID != streamID {
+		d.mu.Unlock()
+
+		return
+	}
+
+	d.lines = append(d.lines, line)
+
+	// drop the oldest lines and redraw the whole buffer
+	// so match regions are numbered from the start again.
+	overflow := len(d.lines) > cntLogsMaxLines
+	if overflow {
+		d.lines = d.lines[len(d.lines)-cntLogsMaxLines+cntLogsMaxLines/10:]
+	}
+
+	if d.paused {
+		d.pendingLines++
+		d.mu.Unlock()
+
+		return
+	}
+
+	if overflow {
+		d.mu.Unlock()
+		d.render()
+
+		return
+	}
+
+	text := d.formatLine(line)
+	d.mu.Unlock()
+
+	d.output.Write([]byte(text)) //nolint:errcheck
+}
+
+func (d *ContainerLogsDialog) togglePause() {
+	d.mu.Lock()
+	d.paused = !d.paused
+	paused := d.paused
+	d.pendingLines = 0
+	d.mu.Unlock()
+
+	label := "Pause"
+	if paused {
+		label = "Resume"
+	}
+
+	d.form.GetButton(d.form.GetButtonCount() - 2).SetLabel(label) //nolint:gomnd
+
+	if !paused {
+		d.render()
+	}
+
+	d.updateStatus()
+}
+
+func (d *ContainerLogsDialog) setSearchPattern(text string) {
+	var (
+		pattern *regexp.Regexp
+		err     error
+	)
+
+	if text != "" {
+		pattern, err = regexp.Compile(text)
+	}
+
+	d.mu.Lock()
+	d.searchPattern = pattern
+	d.searchErr = err
+	d.mu.Unlock()
+
+	d.render()
+	d.updateStatus()
+}
+
+func (d *ContainerLogsDialog) nextMatch() {
+	d.mu.Lock()
+
+	if d.matchCount == 0 {
+		d.mu.Unlock()
+
+		return
+	}
+
+	d.currentMatch = (d.currentMatch + 1) % d.matchCount
+	region := fmt.Sprintf("%d", d.currentMatch) //nolint:perfsprint
+	d.mu.Unlock()
+
+	d.output.Highlight(region).ScrollToHighlight()
+	d.updateStatus()
+}
+
+// render rewrites the output text view from the log lines buffer.
+func (d *ContainerLogsDialog) render() {
+	var text strings.Builder
+
+	d.mu.Lock()
+	d.matchCount = 0
+	d.currentMatch = -1
+
+	for _, line := range d.lines {
+		text.WriteString(d.formatLine(line))
+	}
+	d.mu.Unlock()
+
+	d.output.Highlight()
+	d.output.SetText(text.String())
+	d.output.ScrollToEnd()
+}
+
+// formatLine returns the escaped and colored line text with search
+// matches marked as regions, it shall be called while holding the lock.
+func (d *ContainerLogsDialog) formatLine(line containers.CntLogLine) string {
+	var text strings.Builder
+
+	lineText := strings.TrimRight(line.Text, "\r\n")
+	fgColor := style.GetColorHex(style.TerminalFgColor)
+
+	if line.Stderr {
+		fgColor = style.GetColorHex(style.LogsStderrFgColor)
+	}
+
+	fmt.Fprintf(&text, "[%s::]", fgColor)
+
+	if d.searchPattern == nil {
+		text.WriteString(tview.Escape(lineText))
+		text.WriteString("[-::]\n")
+
+		return text.String()
+	}
+
+	matchBgColor := style.GetColorHex(style.LogsMatchBgColor)
+	matchFgColor := style.GetColorHex(style.LogsMatchFgColor)
+	lastIndex := 0
+
+	for _, loc := range d.searchPattern.FindAllStringIndex(lineText, -1) {
+		if loc[0] == loc[1] {
+			continue
+		}
+
+		text.WriteString(tview.Escape(lineText[lastIndex:loc[0]]))
+		fmt.Fprintf(&text, "[\"%d\"][%s:%s:]%s[%s:-:][\"\"]",
+			d.matchCount, matchFgColor, matchBgColor, tview.Escape(lineText[loc[0]:loc[1]]), fgColor)
+
+		d.matchCount++
+		lastIndex = loc[1]
+	}
+
+	text.WriteString(tview.Escape(lineText[lastIndex:]))
+	text.WriteString("[-::]\n")
+
+	return text.String()
+}
+
+func (d *ContainerLogsDialog) updateStatus() {
+	var status string
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	switch {
+	case d.paused:
+		status = fmt.Sprintf("paused (%d new lines)", d.pendingLines)
+	case d.streamStatus == cntLogsStreamRunning:
+		status = "streaming"
+	case d.streamStatus == cntLogsStreamEnded:
+		status = "end of logs"
+	default:
+		status = "stopped"
+	}
+
+	status = fmt.Sprintf("lines: %d  status: %s", len(d.lines), status)
+
+	switch {
+	case d.searchErr != nil:
+		status = "invalid search pattern  " + status
+	case d.searchPattern != nil:
+		status = fmt.Sprintf("matches: %d/%d  %s", d.currentMatch+1, d.matchCount, status)
+	}
+
+	d.status.SetText(status)
+}
+
+func (d *ContainerLogsDialog) refresh() {
+	if d.fastRefreshHandler != nil {
+		d.fastRefreshHandler()
+	}
+}
@@ -0,0 +1,199 @@
+package cntdialogs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("container logs", Ordered, func() {
+	var logsDialogApp *tview.Application
+	var logsDialogScreen tcell.SimulationScreen
+	var logsDialog *ContainerLogsDialog
+	var runApp func()
+
+	BeforeAll(func() {
+		logsDialogApp = tview.NewApplication()
+		logsDialog = NewContainerLogsDialog()
+		logsDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := logsDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := logsDialogApp.SetScreen(logsDialogScreen).SetRoot(logsDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		logsDialog.SetQueueUpdateDrawHandler(func(f func()) {
+			logsDialogApp.QueueUpdateDraw(f)
+		})
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		logsDialog.Display()
+		Expect(logsDialog.IsDisplay()).To(Equal(true))
+		Expect(logsDialog.focusElement).To(Equal(cntLogsOutputFocus))
+	})
+
+	It("set focus", func() {
+		logsDialogApp.SetFocus(logsDialog)
+		Expect(logsDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("set container info", func() {
+		cntID := "cntID"
+		cntName := "cntName"
+		cntInfoWants := fmt.Sprintf("%s (%s)", cntID, cntName)
+		logsDialog.SetContainerInfo(cntID, cntName)
+		Expect(strings.TrimSpace(logsDialog.cntInfo.GetText())).To(Equal(cntInfoWants))
+	})
+
+	It("get container logs options", func() {
+		optsWants := containers.CntLogsOptions{
+			Follow:     true,
+			Since:      "10m",
+			Until:      "1m",
+			Tail:       "100",
+			Timestamps: true,
+		}
+
+		logsDialog.since.SetText(optsWants.Since)
+		logsDialog.until.SetText(optsWants.Until)
+		logsDialog.tail.SetText(optsWants.Tail)
+		logsDialog.timestamps.SetChecked(optsWants.Timestamps)
+		logsDialog.follow.SetChecked(optsWants.Follow)
+		Expect(logsDialog.GetContainerLogsOptions()).To(Equal(optsWants))
+	})
+
+	It("log stream", func() {
+		logChan, cancelChan := logsDialog.NewLogStream()
+		logChan <- containers.CntLogLine{Text: "stdout [line]\n"}
+		logChan <- containers.CntLogLine{Stderr: true, Text: "stderr line\n"}
+		close(logChan)
+
+		Eventually(func() string {
+			return logsDialog.output.GetText(true)
+		}).Should(Equal("stdout [line]\nstderr line\n"))
+
+		Eventually(func() int {
+			logsDialog.mu.Lock()
+			defer logsDialog.mu.Unlock()
+
+			return logsDialog.streamStatus
+		}).Should(Equal(cntLogsStreamEnded))
+
+		logsDialog.stopLogStream()
+		Expect(cancelChan).To(BeClosed())
+	})
+
+	It("search", func() {
+		logsDialog.search.SetText("line")
+		Expect(logsDialog.matchCount).To(Equal(2))
+		logsDialog.search.SetText("stderr")
+		Expect(logsDialog.matchCount).To(Equal(1))
+		logsDialog.search.SetText("[")
+		Expect(logsDialog.searchErr).NotTo(BeNil())
+		Expect(logsDialog.matchCount).To(Equal(0))
+		logsDialog.search.SetText("")
+	})
+
+	It("pause and resume", func() {
+		logsDialog.togglePause()
+		Expect(logsDialog.paused).To(Equal(true))
+		Expect(logsDialog.form.GetButton(1).GetLabel()).To(Equal("Resume"))
+		logsDialog.togglePause()
+		Expect(logsDialog.paused).To(Equal(false))
+		Expect(logsDialog.form.GetButton(1).GetLabel()).To(Equal("Pause"))
+	})
+
+	It("log stream batches", func() {
+		var batches []func()
+
+		batchChan := make(chan int)
+
+		logsDialog.SetQueueUpdateDrawHandler(func(f func()) {
+			batches = append(batches, f)
+			batchChan <- len(batches)
+		})
+
+		defer logsDialog.SetQueueUpdateDrawHandler(func(f func()) {
+			logsDialogApp.QueueUpdateDraw(f)
+		})
+
+		logChan, _ := logsDialog.NewLogStream()
+		for index := 0; index < 3; index++ {
+			logChan <- containers.CntLogLine{Text: fmt.Sprintf("line %d\n", index)}
+		}
+		close(logChan)
+
+		// the lines are written by the queued batches only
+		Eventually(batchChan).Should(Receive())
+		Expect(logsDialog.output.GetText(true)).To(Equal(""))
+
+		for {
+			logsDialogApp.QueueUpdateDraw(batches[len(batches)-1])
+
+			if strings.Contains(logsDialog.status.GetText(true), "end of logs") {
+				break
+			}
+
+			Eventually(batchChan).Should(Receive())
+		}
+
+		Expect(logsDialog.output.GetText(true)).To(Equal("line 0\nline 1\nline 2\n"))
+	})
+
+	It("cancel button selected", func() {
+		cancelWants := "cancel selected"
+		cancelAction := "cancel init"
+		cancelFunc := func() {
+			cancelAction = cancelWants
+		}
+		logsDialog.SetCancelFunc(cancelFunc)
+		logsDialog.focusElement = cntLogsFormFocus
+		logsDialogApp.SetFocus(logsDialog)
+		logsDialogApp.Draw()
+		logsDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone))
+		logsDialogApp.Draw()
+		Expect(cancelAction).To(Equal(cancelWants))
+	})
+
+	It("reload button selected", func() {
+		reloadWants := "reload selected"
+		reloadAction := "reload init"
+		reloadFunc := func() {
+			reloadAction = reloadWants
+		}
+		logsDialog.SetReloadFunc(reloadFunc)
+		logsDialog.focusElement = cntLogsFormFocus
+		logsDialogApp.SetFocus(logsDialog)
+		logsDialogApp.Draw()
+		logsDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		logsDialogApp.Draw()
+		logsDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		logsDialogApp.Draw()
+		logsDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		logsDialogApp.Draw()
+		Expect(reloadAction).To(Equal(reloadWants))
+	})
+
+	It("hide", func() {
+		logsDialog.Hide()
+		Expect(logsDialog.IsDisplay()).To(Equal(false))
+		Expect(logsDialog.GetContainerLogsOptions().Follow).To(Equal(true))
+		Expect(logsDialog.search.GetText()).To(Equal(""))
+	})
+
+	AfterAll(func() {
+		logsDialogApp.Stop()
+	})
+})
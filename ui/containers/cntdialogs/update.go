@@ -0,0 +1,645 @@
+package cntdialogs
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/docker/go-units"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	cntUpdateDialogMaxWidth = 120
+	cntUpdateDialogHeight   = 23
+)
+
+const (
+	cntUpdateCPUsFocus = 0 + iota
+	cntUpdateCPUSharesFocus
+	cntUpdateMemoryFocus
+	cntUpdateMemorySwapFocus
+	cntUpdatePidsLimitFocus
+	cntUpdateBlkioWeightFocus
+	cntUpdateRestartPolicyFocus
+	cntUpdateRestartRetriesFocus
+	cntUpdateFormFocus
+)
+
+const (
+	cntUpdateCPUsRow = 1 + iota
+	cntUpdateCPUSharesRow
+	cntUpdateMemoryRow
+	cntUpdateMemorySwapRow
+	cntUpdatePidsLimitRow
+	cntUpdateBlkioWeightRow
+)
+
+const (
+	cntUpdateLimitCol = 1 + iota
+	cntUpdateUsageCol
+)
+
+// cntUpdateRestartPolicies is the list of restart policies, the empty policy keeps the current one.
+var cntUpdateRestartPolicies = []string{"", "no", "always", "on-failure", "unless-stopped"}
+
+// ContainerUpdateDialog represents container update dialog primitive.
+type ContainerUpdateDialog struct {
+	*tview.Box
+	layout         *tview.Flex
+	cntInfo        *tview.InputField
+	cpus           *tview.InputField
+	cpuShares      *tview.InputField
+	memory         *tview.InputField
+	memorySwap     *tview.InputField
+	pidsLimit      *tview.InputField
+	blkioWeight    *tview.InputField
+	restartPolicy  *tview.DropDown
+	restartRetries *tview.InputField
+	resources      *tview.Table
+	form           *tview.Form
+	display        bool
+	focusElement   int
+	currentOpts    containers.CntUpdateOptions
+	statsChan      chan entities.ContainerStatsReport
+	mu             sync.Mutex
+	updateHandler  func()
+	cancelHandler  func()
+}
+
+// NewContainerUpdateDialog returns new container update dialog primitive.
+func NewContainerUpdateDialog() *ContainerUpdateDialog {
+	dialog := &ContainerUpdateDialog{
+		Box:            tview.NewBox(),
+		layout:         tview.NewFlex().SetDirection(tview.FlexRow),
+		cntInfo:        tview.NewInputField(),
+		cpus:           tview.NewInputField(),
+		cpuShares:      tview.NewInputField(),
+		memory:         tview.NewInputField(),
+		memorySwap:     tview.NewInputField(),
+		pidsLimit:      tview.NewInputField(),
+		blkioWeight:    tview.NewInputField(),
+		restartPolicy:  tview.NewDropDown(),
+		restartRetries: tview.NewInputField(),
+		resources:      tview.NewTable(),
+		form:           tview.NewForm(),
+	}
+
+	bgColor := style.DialogBgColor
+	fgColor := style.DialogFgColor
+	inputFieldBgColor := style.InputFieldBgColor
+	labelWidth := 17
+
+	// container info input field
+	cntInfoLabel := "CONTAINER ID:"
+
+	dialog.cntInfo.SetBackgroundColor(bgColor)
+	dialog.cntInfo.SetLabel("[::b]" + cntInfoLabel)
+	dialog.cntInfo.SetLabelWidth(len(cntInfoLabel) + 1)
+	dialog.cntInfo.SetFieldBackgroundColor(bgColor)
+	dialog.cntInfo.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(fgColor))
+
+	// cpus input field
+	dialog.cpus.SetBackgroundColor(bgColor)
+	dialog.cpus.SetLabelColor(fgColor)
+	dialog.cpus.SetLabel("cpus:")
+	dialog.cpus.SetLabelWidth(labelWidth)
+	dialog.cpus.SetFieldBackgroundColor(inputFieldBgColor)
+	dialog.cpus.SetPlaceholder("number of CPUs (e.g. 1.5)")
+	dialog.cpus.SetPlaceholderTextColor(style.InfoBarItemFgColor)
+
+	// cpu shares input field
+	dialog.cpuShares.SetBackgroundColor(bgColor)
+	dialog.cpuShares.SetLabelColor(fgColor)
+	dialog.cpuShares.SetLabel("cpu shares:")
+	dialog.cpuShares.SetLabelWidth(labelWidth)
+	dialog.cpuShares.SetFieldBackgroundColor(inputFieldBgColor)
+	dialog.cpuShares.SetPlaceholder("CPU shares relative weight (e.g. 1024)")
+	dialog.cpuShares.SetPlaceholderTextColor(style.InfoBarItemFgColor)
+
+	// memory input field
+	dialog.memory.SetBackgroundColor(bgColor)
+	dialog.memory.SetLabelColor(fgColor)
+	dialog.memory.SetLabel("memory:")
+	dialog.memory.SetLabelWidth(labelWidth)
+	dialog.memory.SetFieldBackgroundColor(inputFieldBgColor)
+	dialog.memory.SetPlaceholder("memory limit (e.g. 512m, 2g)")
+	dialog.memory.SetPlaceholderTextColor(style.InfoBarItemFgColor)
+
+	// memory swap input field
+	dialog.memorySwap.SetBackgroundColor(bgColor)
+	dialog.memorySwap.SetLabelColor(fgColor)
+	dialog.memorySwap.SetLabel("memory swap:")
+	dialog.memorySwap.SetLabelWidth(labelWidth)
+	dialog.memorySwap.SetFieldBackgroundColor(inputFieldBgColor)
+	dialog.memorySwap.SetPlaceholder("memory plus swap limit (-1 unlimited)")
+	dialog.memorySwap.SetPlaceholderTextColor(style.InfoBarItemFgColor)
+
+	// pids limit input field
+	dialog.pidsLimit.SetBackgroundColor(bgColor)
+	dialog.pidsLimit.SetLabelColor(fgColor)
+	dialog.pidsLimit.SetLabel("pids limit:")
+	dialog.pidsLimit.SetLabelWidth(labelWidth)
+	dialog.pidsLimit.SetFieldBackgroundColor(inputFieldBgColor)
+	dialog.pidsLimit.SetPlaceholder("maximum number of processes (-1 unlimited)")
+	dialog.pidsLimit.SetPlaceholderTextColor(style.InfoBarItemFgColor)
+
+	// blkio weight input field
+	dialog.blkioWeight.SetBackgroundColor(bgColor)
+	dialog.blkioWeight.SetLabelColor(fgColor)
+	dialog.blkioWeight.SetLabel("blkio weight:")
+	dialog.blkioWeight.SetLabelWidth(labelWidth)
+	dialog.blkioWeight.SetFieldBackgroundColor(inputFieldBgColor)
+	dialog.blkioWeight.SetPlaceholder("block IO relative weight (10-1000)")
+	dialog.blkioWeight.SetPlaceholderTextColor(style.InfoBarItemFgColor)
+
+	// restart retries input field
+	dialog.restartRetries.SetBackgroundColor(bgColor)
+	dialog.restartRetries.SetLabelColor(fgColor)
+	dialog.restartRetries.SetLabel("restart retries:")
+	dialog.restartRetries.SetLabelWidth(labelWidth)
+	dialog.restartRetries.SetFieldBackgroundColor(inputFieldBgColor)
+	dialog.restartRetries.SetPlaceholder("on-failure restart policy maximum retries")
+	dialog.restartRetries.SetPlaceholderTextColor(style.InfoBarItemFgColor)
+
+	// restart policy dropdown
+	dialog.restartPolicy.SetLabel("restart policy:")
+	dialog.restartPolicy.SetLabelWidth(labelWidth)
+	dialog.restartPolicy.SetLabelColor(fgColor)
+	dialog.restartPolicy.SetBackgroundColor(bgColor)
+	dialog.restartPolicy.SetListStyles(style.DropDownUnselected, style.DropDownSelected)
+	dialog.restartPolicy.SetFieldBackgroundColor(inputFieldBgColor)
+	dialog.restartPolicy.SetOptions(cntUpdateRestartPolicies, nil)
+
+	// resources table
+	dialog.resources.SetBackgroundColor(bgColor)
+	dialog.resources.SetBorder(true)
+	dialog.resources.SetBorderColor(style.DialogSubBoxBorderColor)
+	dialog.initResourcesTable()
+
+	// form
+	dialog.form.AddButton("Cancel", nil)
+	dialog.form.AddButton("Apply", nil)
+	dialog.form.SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	inputLayout := tview.NewFlex().SetDirection(tview.FlexRow)
+	inputLayout.AddItem(dialog.cpus, 1, 0, true)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.cpuShares, 1, 0, true)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.memory, 1, 0, true)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.memorySwap, 1, 0, true)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.pidsLimit, 1, 0, true)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.blkioWeight, 1, 0, true)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.restartPolicy, 1, 0, true)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.restartRetries, 1, 0, true)
+
+	resourcesLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	resourcesLayout.SetBackgroundColor(bgColor)
+	resourcesLayout.AddItem(inputLayout, 0, 1, true)
+	resourcesLayout.AddItem(utils.EmptyBoxSpace(bgColor), 2, 0, false) //nolint:gomnd
+	resourcesLayout.AddItem(dialog.resources, 0, 1, false)
+
+	mainLayout := tview.NewFlex().SetDirection(tview.FlexRow)
+	mainLayout.SetBackgroundColor(bgColor)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainLayout.AddItem(dialog.cntInfo, 1, 0, false)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainLayout.AddItem(resourcesLayout, 0, 1, true)
+
+	paddingLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	paddingLayout.SetBackgroundColor(bgColor)
+	paddingLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	paddingLayout.AddItem(mainLayout, 0, 1, true)
+	paddingLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetTitle("PODMAN CONTAINER UPDATE RESOURCES")
+	dialog.layout.AddItem(paddingLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, dialogs.DialogFormHeight, 0, true)
+
+	dialog.Hide()
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *ContainerUpdateDialog) Display() {
+	d.display = true
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *ContainerUpdateDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *ContainerUpdateDialog) Hide() {
+	d.display = false
+	d.focusElement = cntUpdateCPUsFocus
+
+	d.cntInfo.SetText("")
+	d.SetUpdateOptions(containers.CntUpdateOptions{})
+	d.SetStatsChannel(nil)
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *ContainerUpdateDialog) HasFocus() bool {
+	if d.cpus.HasFocus() || d.cpuShares.HasFocus() {
+		return true
+	}
+
+	if d.memory.HasFocus() || d.memorySwap.HasFocus() {
+		return true
+	}
+
+	if d.pidsLimit.HasFocus() || d.blkioWeight.HasFocus() {
+		return true
+	}
+
+	if d.restartPolicy.HasFocus() || d.restartRetries.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus() || d.form.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *ContainerUpdateDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case cntUpdateCPUsFocus:
+		delegate(d.cpus)
+	case cntUpdateCPUSharesFocus:
+		delegate(d.cpuShares)
+	case cntUpdateMemoryFocus:
+		delegate(d.memory)
+	case cntUpdateMemorySwapFocus:
+		delegate(d.memorySwap)
+	case cntUpdatePidsLimitFocus:
+		delegate(d.pidsLimit)
+	case cntUpdateBlkioWeightFocus:
+		delegate(d.blkioWeight)
+	case cntUpdateRestartPolicyFocus:
+		delegate(d.restartPolicy)
+	case cntUpdateRestartRetriesFocus:
+		delegate(d.restartRetries)
+	case cntUpdateFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = cntUpdateCPUsFocus
+				d.Focus(delegate)
+				d.form.SetFocus(0)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *ContainerUpdateDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("container update dialog: event %v received", event)
+
+		if event.Key() == utils.SwitchFocusKey.Key {
+			d.setFocusElement()
+		}
+
+		// dropdown widgets shall handle events before "Esc" key handler
+		if d.restartPolicy.HasFocus() {
+			event = utils.ParseKeyEventKey(event)
+			if restartPolicyHandler := d.restartPolicy.InputHandler(); restartPolicyHandler != nil {
+				restartPolicyHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if event.Key() == tcell.KeyEsc {
+			d.cancelHandler()
+
+			return
+		}
+
+		if d.cpus.HasFocus() {
+			if cpusHandler := d.cpus.InputHandler(); cpusHandler != nil {
+				cpusHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.cpuShares.HasFocus() {
+			if cpuSharesHandler := d.cpuShares.InputHandler(); cpuSharesHandler != nil {
+				cpuSharesHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.memory.HasFocus() {
+			if memoryHandler := d.memory.InputHandler(); memoryHandler != nil {
+				memoryHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.memorySwap.HasFocus() {
+			if memorySwapHandler := d.memorySwap.InputHandler(); memorySwapHandler != nil {
+				memorySwapHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.pidsLimit.HasFocus() {
+			if pidsLimitHandler := d.pidsLimit.InputHandler(); pidsLimitHandler != nil {
+				pidsLimitHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.blkioWeight.HasFocus() {
+			if blkioWeightHandler := d.blkioWeight.InputHandler(); blkioWeightHandler != nil {
+				blkioWeightHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.restartRetries.HasFocus() {
+			if restartRetriesHandler := d.restartRetries.InputHandler(); restartRetriesHandler != nil {
+				restartRetriesHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+func (d *ContainerUpdateDialog) setFocusElement() {
+	switch d.focusElement {
+	case cntUpdateCPUsFocus:
+		d.focusElement = cntUpdateCPUSharesFocus
+	case cntUpdateCPUSharesFocus:
+		d.focusElement = cntUpdateMemoryFocus
+	case cntUpdateMemoryFocus:
+		d.focusElement = cntUpdateMemorySwapFocus
+	case cntUpdateMemorySwapFocus:
+		d.focusElement = cntUpdatePidsLimitFocus
+	case cntUpdatePidsLimitFocus:
+		d.focusElement = cntUpdateBlkioWeightFocus
+	case cntUpdateBlkioWeightFocus:
+		d.focusElement = cntUpdateRestartPolicyFocus
+	case cntUpdateRestartPolicyFocus:
+		d.focusElement = cntUpdateRestartRetriesFocus
+	case cntUpdateRestartRetriesFocus:
+		d.focusElement = cntUpdateFormFocus
+	}
+}
+
+// SetRect set rects for this primitive.
+func (d *ContainerUpdateDialog) SetRect(x, y, width, height int) {
+	if width > cntUpdateDialogMaxWidth {
+		emptySpace := (width - cntUpdateDialogMaxWidth) / 2 //nolint:gomnd
+		x += emptySpace
+		width = cntUpdateDialogMaxWidth
+	}
+
+	if height > cntUpdateDialogHeight {
+		emptySpace := (height - cntUpdateDialogHeight) / 2 //nolint:gomnd
+		y += emptySpace
+		height = cntUpdateDialogHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *ContainerUpdateDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.Box.DrawForSubclass(screen, d)
+
+	x, y, width, height := d.Box.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetUpdateFunc sets form apply button selected function.
+func (d *ContainerUpdateDialog) SetUpdateFunc(handler func()) *ContainerUpdateDialog {
+	d.updateHandler = handler
+	updateButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+	updateButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *ContainerUpdateDialog) SetCancelFunc(handler func()) *ContainerUpdateDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 2) //nolint:gomnd
+
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetContainerInfo sets selected container ID and name in update dialog.
+func (d *ContainerUpdateDialog) SetContainerInfo(id string, name string) {
+	containerInfo := fmt.Sprintf("%s (%s)", id, name)
+
+	d.cntInfo.SetText(containerInfo)
+}
+
+// SetUpdateOptions sets the container current resource limits and restart policy,
+// the input fields are filled with these values.
+func (d *ContainerUpdateDialog) SetUpdateOptions(opts containers.CntUpdateOptions) {
+	d.currentOpts = opts
+
+	d.cpus.SetText(opts.CPUs)
+	d.cpuShares.SetText(opts.CPUShares)
+	d.memory.SetText(opts.Memory)
+	d.memorySwap.SetText(opts.MemorySwap)
+	d.pidsLimit.SetText(opts.PidsLimit)
+	d.blkioWeight.SetText(opts.BlkioWeight)
+	d.restartRetries.SetText(opts.RestartRetries)
+	d.restartPolicy.SetCurrentOption(0)
+
+	for index, policy := range cntUpdateRestartPolicies {
+		if policy == opts.RestartPolicy {
+			d.restartPolicy.SetCurrentOption(index)
+
+			break
+		}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.setResourceLimit(cntUpdateCPUsRow, opts.CPUs)
+	d.setResourceLimit(cntUpdateCPUSharesRow, opts.CPUShares)
+	d.setResourceLimit(cntUpdateMemoryRow, opts.Memory)
+	d.setResourceLimit(cntUpdateMemorySwapRow, opts.MemorySwap)
+	d.setResourceLimit(cntUpdatePidsLimitRow, opts.PidsLimit)
+	d.setResourceLimit(cntUpdateBlkioWeightRow, opts.BlkioWeight)
+}
+
+// SetStatsChannel sets the container live stats channel, the usage column of the
+// resources table is updated until the channel is closed or replaced.
+func (d *ContainerUpdateDialog) SetStatsChannel(statsChan chan entities.ContainerStatsReport) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.statsChan = statsChan
+	d.setResourcesUsage(entities.ContainerStatsReport{})
+
+	if statsChan == nil {
+		return
+	}
+
+	go func() {
+		for report := range statsChan {
+			d.mu.Lock()
+
+			if d.statsChan == statsChan {
+				d.setResourcesUsage(report)
+			}
+
+			d.mu.Unlock()
+		}
+
+		log.Debug().Msgf("container update dialog: stats reader stopped")
+	}()
+}
+
+// GetContainerUpdateOptions returns container update options based on user inputs,
+// the values not changed from the current ones are empty.
+func (d *ContainerUpdateDialog) GetContainerUpdateOptions() containers.CntUpdateOptions {
+	_, restartPolicy := d.restartPolicy.GetCurrentOption()
+
+	opts := containers.CntUpdateOptions{
+		CPUs:           changedValue(d.cpus.GetText(), d.currentOpts.CPUs),
+		CPUShares:      changedValue(d.cpuShares.GetText(), d.currentOpts.CPUShares),
+		Memory:         changedValue(d.memory.GetText(), d.currentOpts.Memory),
+		MemorySwap:     changedValue(d.memorySwap.GetText(), d.currentOpts.MemorySwap),
+		PidsLimit:      changedValue(d.pidsLimit.GetText(), d.currentOpts.PidsLimit),
+		BlkioWeight:    changedValue(d.blkioWeight.GetText(), d.currentOpts.BlkioWeight),
+		RestartPolicy:  changedValue(restartPolicy, d.currentOpts.RestartPolicy),
+		RestartRetries: changedValue(d.restartRetries.GetText(), d.currentOpts.RestartRetries),
+	}
+
+	// the restart retries are only applied with the restart policy
+	if opts.RestartRetries != "" && opts.RestartPolicy == "" {
+		opts.RestartPolicy = restartPolicy
+	}
+
+	return opts
+}
+
+func (d *ContainerUpdateDialog) initResourcesTable() {
+	headerFgColor := style.TableHeaderFgColor
+	headers := []string{"RESOURCE", "LIMIT", "USAGE"}
+
+	for col, header := range headers {
+		d.resources.SetCell(0, col,
+			tview.NewTableCell(fmt.Sprintf("[::b]%s", header)).
+				SetTextColor(headerFgColor).
+				SetSelectable(false).
+				SetExpansion(1))
+	}
+
+	rows := map[int]string{
+		cntUpdateCPUsRow:        "cpus",
+		cntUpdateCPUSharesRow:   "cpu shares",
+		cntUpdateMemoryRow:      "memory",
+		cntUpdateMemorySwapRow:  "memory swap",
+		cntUpdatePidsLimitRow:   "pids limit",
+		cntUpdateBlkioWeightRow: "blkio weight",
+	}
+
+	for row, resource := range rows {
+		d.resources.SetCell(row, 0, tview.NewTableCell(resource).SetTextColor(headerFgColor))
+		d.resources.SetCell(row, cntUpdateLimitCol, tview.NewTableCell("--").SetTextColor(style.DialogFgColor))
+		d.resources.SetCell(row, cntUpdateUsageCol, tview.NewTableCell("").SetTextColor(style.DialogFgColor))
+	}
+}
+
+func (d *ContainerUpdateDialog) setResourceLimit(row int, value string) {
+	if value == "" {
+		value = "--"
+	}
+
+	d.resources.GetCell(row, cntUpdateLimitCol).SetText(value)
+}
+
+func (d *ContainerUpdateDialog) setResourcesUsage(report entities.ContainerStatsReport) {
+	cpuUsage := "--"
+	memUsage := "--"
+	pidsUsage := "--"
+	blockUsage := "--"
+
+	if report.Error == nil && len(report.Stats) > 0 {
+		metric := report.Stats[0]
+
+		cpuUsage = fmt.Sprintf("%.2f%%", metric.CPU)
+		memUsage = fmt.Sprintf("%s / %s (%.2f%%)",
+			units.HumanSize(float64(metric.MemUsage)), units.HumanSize(float64(metric.MemLimit)), metric.MemPerc)
+		pidsUsage = fmt.Sprintf("%d", metric.PIDs) //nolint:perfsprint
+		blockUsage = fmt.Sprintf("%s / %s",
+			units.HumanSize(float64(metric.BlockInput)), units.HumanSize(float64(metric.BlockOutput)))
+	}
+
+	d.resources.GetCell(cntUpdateCPUsRow, cntUpdateUsageCol).SetText(cpuUsage)
+	d.resources.GetCell(cntUpdateMemoryRow, cntUpdateUsageCol).SetText(memUsage)
+	d.resources.GetCell(cntUpdatePidsLimitRow, cntUpdateUsageCol).SetText(pidsUsage)
+	d.resources.GetCell(cntUpdateBlkioWeightRow, cntUpdateUsageCol).SetText(blockUsage)
+}
+
+// changedValue returns the trimmed input value or empty if it is the current value.
+func changedValue(value string, current string) string {
+	value = strings.TrimSpace(value)
+	if value == current {
+		return ""
+	}
+
+	return value
+}
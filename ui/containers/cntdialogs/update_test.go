@@ -0,0 +1,211 @@
+package cntdialogs
+
+import (
+	"fmt"
+
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("container update", Ordered, func() {
+	var containerUpdateApp *tview.Application
+	var containerUpdateScreen tcell.SimulationScreen
+	var updateDialog *ContainerUpdateDialog
+	var runApp func()
+
+	BeforeAll(func() {
+		containerUpdateApp = tview.NewApplication()
+		updateDialog = NewContainerUpdateDialog()
+		containerUpdateScreen = tcell.NewSimulationScreen("UTF-8")
+		err := containerUpdateScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := containerUpdateApp.SetScreen(containerUpdateScreen).SetRoot(updateDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		updateDialog.Display()
+		Expect(updateDialog.IsDisplay()).To(Equal(true))
+	})
+
+	It("set focus", func() {
+		containerUpdateApp.SetFocus(updateDialog)
+		Expect(updateDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("set container info", func() {
+		cntID := "cntID"
+		cntName := "cntName"
+		cntInfoWants := fmt.Sprintf("%s (%s)", cntID, cntName)
+		updateDialog.SetContainerInfo(cntID, cntName)
+		Expect(updateDialog.cntInfo.GetText()).To(Equal(cntInfoWants))
+	})
+
+	It("cancel button selected", func() {
+		cancelFunc := func() {
+			updateDialog.Hide()
+		}
+		updateDialog.Hide()
+		containerUpdateApp.Draw()
+		updateDialog.SetCancelFunc(cancelFunc)
+		updateDialog.Display()
+		containerUpdateApp.Draw()
+		containerUpdateApp.SetFocus(updateDialog.form)
+		containerUpdateApp.Draw()
+		containerUpdateApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		containerUpdateApp.Draw()
+		Expect(updateDialog.IsDisplay()).To(Equal(false))
+	})
+
+	It("update button selected", func() {
+		updateButton := "initial"
+		updateButtonWants := "update selected"
+		updateFunc := func() {
+			updateButton = updateButtonWants
+		}
+		updateDialog.Hide()
+		containerUpdateApp.Draw()
+		updateDialog.SetUpdateFunc(updateFunc)
+		updateDialog.Display()
+		containerUpdateApp.Draw()
+		containerUpdateApp.SetFocus(updateDialog.form)
+		containerUpdateApp.Draw()
+		containerUpdateApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		containerUpdateApp.Draw()
+		containerUpdateApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		containerUpdateApp.Draw()
+		Expect(updateButton).To(Equal(updateButtonWants))
+	})
+
+	It("get update options", func() {
+		updateDialog.Hide()
+		containerUpdateApp.Draw()
+		updateDialog.Display()
+		containerUpdateApp.Draw()
+		containerUpdateApp.SetFocus(updateDialog)
+		containerUpdateApp.Draw()
+		// cpus input field
+		containerUpdateApp.QueueEvent(tcell.NewEventKey(256, '2', tcell.ModNone))
+		containerUpdateApp.Draw()
+		// cpu shares input field
+		updateDialog.setFocusElement()
+		containerUpdateApp.SetFocus(updateDialog)
+		containerUpdateApp.Draw()
+		containerUpdateApp.QueueEvent(tcell.NewEventKey(256, '5', tcell.ModNone))
+		containerUpdateApp.Draw()
+		// memory input field
+		updateDialog.setFocusElement()
+		containerUpdateApp.SetFocus(updateDialog)
+		containerUpdateApp.Draw()
+		containerUpdateApp.QueueEvent(tcell.NewEventKey(256, '1', tcell.ModNone))
+		containerUpdateApp.Draw()
+		containerUpdateApp.QueueEvent(tcell.NewEventKey(256, 'g', tcell.ModNone))
+		containerUpdateApp.Draw()
+		// memory swap input field
+		updateDialog.setFocusElement()
+		containerUpdateApp.SetFocus(updateDialog)
+		containerUpdateApp.Draw()
+		containerUpdateApp.QueueEvent(tcell.NewEventKey(256, '2', tcell.ModNone))
+		containerUpdateApp.Draw()
+		containerUpdateApp.QueueEvent(tcell.NewEventKey(256, 'g', tcell.ModNone))
+		containerUpdateApp.Draw()
+		// pids limit input field
+		updateDialog.setFocusElement()
+		containerUpdateApp.SetFocus(updateDialog)
+		containerUpdateApp.Draw()
+		containerUpdateApp.QueueEvent(tcell.NewEventKey(256, '9', tcell.ModNone))
+		containerUpdateApp.Draw()
+		// blkio weight input field
+		updateDialog.setFocusElement()
+		containerUpdateApp.SetFocus(updateDialog)
+		containerUpdateApp.Draw()
+		containerUpdateApp.QueueEvent(tcell.NewEventKey(256, '5', tcell.ModNone))
+		containerUpdateApp.Draw()
+		containerUpdateApp.QueueEvent(tcell.NewEventKey(256, '0', tcell.ModNone))
+		containerUpdateApp.Draw()
+		// restart policy dropdown (always)
+		updateDialog.setFocusElement()
+		containerUpdateApp.SetFocus(updateDialog)
+		containerUpdateApp.Draw()
+		containerUpdateApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		containerUpdateApp.Draw()
+		containerUpdateApp.QueueEvent(tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone))
+		containerUpdateApp.Draw()
+		containerUpdateApp.QueueEvent(tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone))
+		containerUpdateApp.Draw()
+		containerUpdateApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		containerUpdateApp.Draw()
+		// restart retries input field
+		updateDialog.setFocusElement()
+		containerUpdateApp.SetFocus(updateDialog)
+		containerUpdateApp.Draw()
+		containerUpdateApp.QueueEvent(tcell.NewEventKey(256, '3', tcell.ModNone))
+		containerUpdateApp.Draw()
+
+		updateOpts := updateDialog.GetContainerUpdateOptions()
+		Expect(updateOpts.CPUs).To(Equal("2"))
+		Expect(updateOpts.CPUShares).To(Equal("5"))
+		Expect(updateOpts.Memory).To(Equal("1g"))
+		Expect(updateOpts.MemorySwap).To(Equal("2g"))
+		Expect(updateOpts.PidsLimit).To(Equal("9"))
+		Expect(updateOpts.BlkioWeight).To(Equal("50"))
+		Expect(updateOpts.RestartPolicy).To(Equal("always"))
+		Expect(updateOpts.RestartRetries).To(Equal("3"))
+	})
+
+	It("set update options", func() {
+		currentOpts := containers.CntUpdateOptions{
+			CPUs:          "1.5",
+			CPUShares:     "1024",
+			Memory:        "512m",
+			MemorySwap:    "1g",
+			PidsLimit:     "2048",
+			BlkioWeight:   "500",
+			RestartPolicy: "always",
+		}
+
+		updateDialog.Hide()
+		containerUpdateApp.Draw()
+		updateDialog.SetUpdateOptions(currentOpts)
+		updateDialog.Display()
+		containerUpdateApp.Draw()
+		Expect(updateDialog.cpus.GetText()).To(Equal(currentOpts.CPUs))
+		Expect(updateDialog.memorySwap.GetText()).To(Equal(currentOpts.MemorySwap))
+		Expect(updateDialog.blkioWeight.GetText()).To(Equal(currentOpts.BlkioWeight))
+		Expect(updateDialog.resources.GetCell(cntUpdateMemoryRow, cntUpdateLimitCol).Text).To(Equal(currentOpts.Memory))
+		Expect(updateDialog.resources.GetCell(cntUpdateMemoryRow, cntUpdateUsageCol).Text).To(Equal("--"))
+
+		_, restartPolicy := updateDialog.restartPolicy.GetCurrentOption()
+		Expect(restartPolicy).To(Equal(currentOpts.RestartPolicy))
+
+		// only the changed values are returned
+		Expect(updateDialog.GetContainerUpdateOptions()).To(Equal(containers.CntUpdateOptions{}))
+
+		updateDialog.memory.SetText("768m")
+		Expect(updateDialog.GetContainerUpdateOptions()).To(Equal(containers.CntUpdateOptions{Memory: "768m"}))
+	})
+
+	It("hide", func() {
+		updateDialog.Hide()
+		Expect(updateDialog.IsDisplay()).To(Equal(false))
+
+		updateOpts := updateDialog.GetContainerUpdateOptions()
+		Expect(updateOpts.CPUs).To(Equal(""))
+		Expect(updateOpts.RestartPolicy).To(Equal(""))
+	})
+
+	AfterAll(func() {
+		containerUpdateApp.Stop()
+	})
+})
@@ -0,0 +1,259 @@
+package cntdialogs
+
+import (
+	"fmt"
+
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	cntWaitDialogMaxWidth = 70
+	cntWaitDialogHeight   = 9
+)
+
+const (
+	cntWaitConditionFocus = 0 + iota
+	cntWaitFormFocus
+)
+
+// ContainerWaitDialog represents container wait dialog primitive.
+type ContainerWaitDialog struct {
+	*tview.Box
+	layout        *tview.Flex
+	cntInfo       *tview.InputField
+	condition     *tview.DropDown
+	form          *tview.Form
+	display       bool
+	focusElement  int
+	waitHandler   func()
+	cancelHandler func()
+}
+
+// NewContainerWaitDialog returns new container wait dialog primitive.
+func NewContainerWaitDialog() *ContainerWaitDialog {
+	dialog := &ContainerWaitDialog{
+		Box:       tview.NewBox(),
+		layout:    tview.NewFlex().SetDirection(tview.FlexRow),
+		cntInfo:   tview.NewInputField(),
+		condition: tview.NewDropDown(),
+		form:      tview.NewForm(),
+	}
+
+	bgColor := style.DialogBgColor
+	fgColor := style.DialogFgColor
+	inputFieldBgColor := style.InputFieldBgColor
+
+	// container info input field
+	cntInfoLabel := "CONTAINER ID:"
+
+	dialog.cntInfo.SetBackgroundColor(bgColor)
+	dialog.cntInfo.SetLabel("[::b]" + cntInfoLabel)
+	dialog.cntInfo.SetLabelWidth(len(cntInfoLabel) + 1)
+	dialog.cntInfo.SetFieldBackgroundColor(bgColor)
+	dialog.cntInfo.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(fgColor))
+
+	// condition dropdown
+	dialog.condition.SetLabel("condition:")
+	dialog.condition.SetLabelWidth(len(cntInfoLabel) + 1)
+	dialog.condition.SetLabelColor(fgColor)
+	dialog.condition.SetBackgroundColor(bgColor)
+	dialog.condition.SetListStyles(style.DropDownUnselected, style.DropDownSelected)
+	dialog.condition.SetFieldBackgroundColor(inputFieldBgColor)
+	dialog.condition.SetOptions(containers.WaitConditions, nil)
+
+	// form
+	dialog.form.AddButton("Cancel", nil)
+	dialog.form.AddButton("Wait", nil)
+	dialog.form.SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	inputLayout := tview.NewFlex().SetDirection(tview.FlexRow)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.cntInfo, 1, 0, false)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.condition, 1, 0, true)
+
+	mainLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	mainLayout.SetBackgroundColor(bgColor)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainLayout.AddItem(inputLayout, 0, 1, true)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetTitle("PODMAN CONTAINER WAIT")
+	dialog.layout.AddItem(mainLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, dialogs.DialogFormHeight, 0, true)
+
+	dialog.Hide()
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *ContainerWaitDialog) Display() {
+	d.display = true
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *ContainerWaitDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *ContainerWaitDialog) Hide() {
+	d.display = false
+	d.focusElement = cntWaitConditionFocus
+
+	d.cntInfo.SetText("")
+	d.condition.SetCurrentOption(0)
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *ContainerWaitDialog) HasFocus() bool {
+	if d.condition.HasFocus() || d.form.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *ContainerWaitDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case cntWaitConditionFocus:
+		delegate(d.condition)
+	case cntWaitFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = cntWaitConditionFocus
+				d.Focus(delegate)
+				d.form.SetFocus(0)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *ContainerWaitDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("container wait dialog: event %v received", event)
+
+		if event.Key() == utils.SwitchFocusKey.Key {
+			d.setFocusElement()
+		}
+
+		// dropdown widgets shall handle events before "Esc" key handler
+		if d.condition.HasFocus() {
+			event = utils.ParseKeyEventKey(event)
+			if conditionHandler := d.condition.InputHandler(); conditionHandler != nil {
+				conditionHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if event.Key() == tcell.KeyEsc {
+			d.cancelHandler()
+
+			return
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+func (d *ContainerWaitDialog) setFocusElement() {
+	if d.focusElement == cntWaitConditionFocus {
+		d.focusElement = cntWaitFormFocus
+	}
+}
+
+// SetRect set rects for this primitive.
+func (d *ContainerWaitDialog) SetRect(x, y, width, height int) {
+	if width > cntWaitDialogMaxWidth {
+		emptySpace := (width - cntWaitDialogMaxWidth) / 2 //nolint:gomnd
+		x += emptySpace
+		width = cntWaitDialogMaxWidth
+	}
+
+	if height > cntWaitDialogHeight {
+		emptySpace := (height - cntWaitDialogHeight) / 2 //nolint:gomnd
+		y += emptySpace
+		height = cntWaitDialogHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *ContainerWaitDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.Box.DrawForSubclass(screen, d)
+
+	x, y, width, height := d.Box.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetWaitFunc sets form wait button selected function.
+func (d *ContainerWaitDialog) SetWaitFunc(handler func()) *ContainerWaitDialog {
+	d.waitHandler = handler
+	waitButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+	waitButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *ContainerWaitDialog) SetCancelFunc(handler func()) *ContainerWaitDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 2) //nolint:gomnd
+
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetContainerInfo sets selected container ID and name in wait dialog.
+func (d *ContainerWaitDialog) SetContainerInfo(id string, name string) {
+	containerInfo := fmt.Sprintf("%s (%s)", id, name)
+
+	d.cntInfo.SetText(containerInfo)
+}
+
+// GetWaitCondition returns the selected wait condition.
+func (d *ContainerWaitDialog) GetWaitCondition() string {
+	_, condition := d.condition.GetCurrentOption()
+
+	return condition
+}
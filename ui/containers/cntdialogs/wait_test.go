@@ -0,0 +1,118 @@
+package cntdialogs
+
+import (
+	"fmt"
+
+	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("container wait", Ordered, func() {
+	var containerWaitApp *tview.Application
+	var containerWaitScreen tcell.SimulationScreen
+	var waitDialog *ContainerWaitDialog
+	var runApp func()
+
+	BeforeAll(func() {
+		containerWaitApp = tview.NewApplication()
+		waitDialog = NewContainerWaitDialog()
+		containerWaitScreen = tcell.NewSimulationScreen("UTF-8")
+		err := containerWaitScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := containerWaitApp.SetScreen(containerWaitScreen).SetRoot(waitDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		waitDialog.Display()
+		Expect(waitDialog.IsDisplay()).To(Equal(true))
+	})
+
+	It("set focus", func() {
+		containerWaitApp.SetFocus(waitDialog)
+		Expect(waitDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("set container info", func() {
+		cntID := "cntID"
+		cntName := "cntName"
+		cntInfoWants := fmt.Sprintf("%s (%s)", cntID, cntName)
+		waitDialog.SetContainerInfo(cntID, cntName)
+		Expect(waitDialog.cntInfo.GetText()).To(Equal(cntInfoWants))
+	})
+
+	It("cancel button selected", func() {
+		cancelFunc := func() {
+			waitDialog.Hide()
+		}
+		waitDialog.Hide()
+		containerWaitApp.Draw()
+		waitDialog.SetCancelFunc(cancelFunc)
+		waitDialog.Display()
+		containerWaitApp.Draw()
+		containerWaitApp.SetFocus(waitDialog.form)
+		containerWaitApp.Draw()
+		containerWaitApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		containerWaitApp.Draw()
+		Expect(waitDialog.IsDisplay()).To(Equal(false))
+	})
+
+	It("wait button selected", func() {
+		waitButton := "initial"
+		waitButtonWants := "wait selected"
+		waitFunc := func() {
+			waitButton = waitButtonWants
+		}
+		waitDialog.Hide()
+		containerWaitApp.Draw()
+		waitDialog.SetWaitFunc(waitFunc)
+		waitDialog.Display()
+		containerWaitApp.Draw()
+		containerWaitApp.SetFocus(waitDialog.form)
+		containerWaitApp.Draw()
+		containerWaitApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		containerWaitApp.Draw()
+		containerWaitApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		containerWaitApp.Draw()
+		Expect(waitButton).To(Equal(waitButtonWants))
+	})
+
+	It("get wait condition", func() {
+		waitDialog.Hide()
+		containerWaitApp.Draw()
+		waitDialog.Display()
+		containerWaitApp.Draw()
+		Expect(waitDialog.GetWaitCondition()).To(Equal(containers.WaitConditions[0]))
+
+		containerWaitApp.SetFocus(waitDialog)
+		containerWaitApp.Draw()
+		containerWaitApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		containerWaitApp.Draw()
+		containerWaitApp.QueueEvent(tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone))
+		containerWaitApp.Draw()
+		containerWaitApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		containerWaitApp.Draw()
+		Expect(waitDialog.GetWaitCondition()).To(Equal(containers.WaitConditions[1]))
+	})
+
+	It("hide", func() {
+		waitDialog.Hide()
+		Expect(waitDialog.IsDisplay()).To(Equal(false))
+		Expect(waitDialog.GetWaitCondition()).To(Equal(containers.WaitConditions[0]))
+	})
+
+	AfterAll(func() {
+		containerWaitApp.Stop()
+	})
+})
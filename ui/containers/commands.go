@@ -1,33 +1,65 @@
 package containers
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/containers/podman-tui/pdcs/containers"
+	"github.com/containers/podman-tui/pdcs/kube"
 	"github.com/containers/podman-tui/pdcs/pods"
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/systemd"
+	putils "github.com/containers/podman-tui/pdcs/utils"
 	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
 	bcontainers "github.com/containers/podman/v5/pkg/bindings/containers"
 	"github.com/rs/zerolog/log"
 )
 
 func (cnt *Containers) runCommand(cmd string) { //nolint:cyclop
+	// the marked containers bulk commands are run on the containers service connection
+	bulkCmd := cnt.markedItems.Count() > 0 && slices.Contains(bulkCommands, cmd)
+
+	if cmd != "create" && cmd != "prune" && cmd != "unhealthy" && !bulkCmd && !cnt.selectedOnActiveHost() {
+		return
+	}
+
 	switch cmd {
 	case "attach":
 		cnt.attach()
 	case "checkpoint":
 		cnt.preCheckpoint()
+	case "clone":
+		cnt.cclone()
 	case "commit":
 		cnt.preCommit()
+	case "cp":
+		cnt.ccopy()
 	case "create":
 		cnt.createDialog.Display()
 	case "diff":
 		cnt.diff()
 	case "exec":
 		cnt.cexec()
+	case "export":
+		cnt.export()
+	case "files":
+		cnt.files()
+	case "generate kube":
+		cnt.generateKube()
+	case "generate systemd":
+		cnt.generateSystemd()
+	case "health history":
+		cnt.healthHistory()
 	case "healthcheck":
 		cnt.preHealthcheck()
+	case "init":
+		cnt.initContainer()
 	case "inspect":
 		cnt.inspect()
 	case "kill":
@@ -40,6 +72,8 @@ func (cnt *Containers) runCommand(cmd string) { //nolint:cyclop
 		cnt.cprune()
 	case "rename":
 		cnt.rename()
+	case "restart":
+		cnt.restart(false)
 	case "restore":
 		cnt.preRestore()
 	case "port":
@@ -54,8 +88,14 @@ func (cnt *Containers) runCommand(cmd string) { //nolint:cyclop
 		cnt.stop()
 	case "top":
 		cnt.top()
+	case "unhealthy":
+		cnt.filterBar.SetFilter(utils.UnhealthyFilter)
 	case "unpause":
 		cnt.unpause()
+	case "update":
+		cnt.cupdate()
+	case "wait":
+		cnt.cwait()
 	}
 }
 
@@ -142,6 +182,69 @@ func (cnt *Containers) preHealthcheck() {
 	go cntHealthCheck()
 }
 
+func (cnt *Containers) healthHistory() {
+	if cnt.selectedID == "" {
+		cnt.displayError("", errNoContainerHealth)
+
+		return
+	}
+
+	cntID, cntName := cnt.getSelectedItem()
+
+	cnt.progressDialog.SetTitle("container health history in progress")
+	cnt.progressDialog.Display()
+
+	cntHealth := func() {
+		health, err := containers.Health(cntID)
+
+		cnt.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("CONTAINER (%s) HEALTH HISTORY ERROR", cntID)
+			cnt.displayError(title, err)
+			cnt.fastRefreshChan <- true
+
+			return
+		}
+
+		cnt.healthDialog.SetContainerInfo(cntID, cntName)
+		cnt.healthDialog.SetHealth(health)
+		cnt.healthDialog.Display()
+		cnt.fastRefreshChan <- true
+	}
+
+	go cntHealth()
+}
+
+// runHealthCheck runs the health check of the health dialog container and reloads its health history.
+func (cnt *Containers) runHealthCheck() {
+	cntID := cnt.selectedID
+
+	cntHealthCheck := func() {
+		title := fmt.Sprintf("CONTAINER (%s) HEALTHCHECK ERROR", cntID)
+
+		if _, err := containers.HealthCheck(cntID); err != nil {
+			cnt.displayError(title, err)
+			cnt.fastRefreshChan <- true
+
+			return
+		}
+
+		health, err := containers.Health(cntID)
+		if err != nil {
+			cnt.displayError(title, err)
+			cnt.fastRefreshChan <- true
+
+			return
+		}
+
+		cnt.healthDialog.SetHealth(health)
+		cnt.fastRefreshChan <- true
+	}
+
+	go cntHealthCheck()
+}
+
 func (cnt *Containers) preRestore() {
 	var ( //nolint:prealloc
 		containersList [][]string
@@ -261,6 +364,54 @@ func (cnt *Containers) checkpoint() {
 	go checkpoint()
 }
 
+func (cnt *Containers) cclone() {
+	if cnt.selectedID == "" {
+		cnt.displayError("", errNoContainerClone)
+
+		return
+	}
+
+	cntID, cntName := cnt.getSelectedItem()
+
+	cloneOpts, err := containers.CloneDefaults(cntID)
+	if err != nil {
+		title := fmt.Sprintf("CONTAINER (%s) CLONE ERROR", cntID)
+		cnt.displayError(title, err)
+
+		return
+	}
+
+	cnt.cloneDialog.SetContainerInfo(cntID, cntName)
+	cnt.cloneDialog.SetCloneOptions(cloneOpts)
+	cnt.cloneDialog.Display()
+}
+
+func (cnt *Containers) clone() {
+	cloneOpts := cnt.cloneDialog.GetContainerCloneOptions()
+
+	cnt.cloneDialog.Hide()
+	cnt.progressDialog.SetTitle("container clone in progress")
+	cnt.progressDialog.Display()
+
+	clone := func(id string) {
+		_, err := containers.Clone(id, cloneOpts)
+
+		cnt.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("CONTAINER (%s) CLONE ERROR", id)
+			cnt.displayError(title, err)
+			cnt.fastRefreshChan <- true
+
+			return
+		}
+
+		cnt.fastRefreshChan <- true
+	}
+
+	go clone(cnt.selectedID)
+}
+
 func (cnt *Containers) preCommit() {
 	if cnt.selectedID == "" {
 		cnt.displayError("", errNoContainerCommit)
@@ -304,6 +455,245 @@ func (cnt *Containers) commit() {
 	go cntCommit()
 }
 
+func (cnt *Containers) ccopy() {
+	if cnt.selectedID == "" {
+		cnt.displayError("", errNoContainerCopy)
+
+		return
+	}
+
+	cntID, cntName := cnt.getSelectedItem()
+
+	cnt.copyDialog.SetContainerInfo(cntID, cntName)
+	cnt.copyDialog.Display()
+}
+
+func (cnt *Containers) copy() {
+	copyOpts, err := cnt.copyDialog.GetContainerCopyOptions()
+	if err != nil {
+		cnt.displayError("CONTAINER COPY ERROR", err)
+
+		return
+	}
+
+	cnt.copyDialog.Hide()
+
+	cntID, cntName := cnt.selectedID, cnt.selectedName
+
+	source := cntID + ":" + copyOpts.Source
+	destination := copyOpts.Destination
+
+	if copyOpts.ToContainer {
+		source = copyOpts.Source
+		destination = cntID + ":" + copyOpts.Destination
+	}
+
+	cnt.copyCancelChan = make(chan bool)
+	cnt.copyPrgDialog.SetCopyInfo(source, destination)
+	cnt.copyPrgDialog.Display()
+
+	cntCopy := func(cancelChan chan bool) {
+		err := containers.Copy(cntID, copyOpts, func(progress containers.CntCopyProgress) {
+			cnt.copyPrgDialog.UpdateProgress(progress)
+			cnt.fastRefreshChan <- true
+		}, cancelChan)
+
+		cnt.copyPrgDialog.Hide()
+
+		if err != nil {
+			if !errors.Is(err, containers.ErrCntCopyCanceled) {
+				title := fmt.Sprintf("CONTAINER (%s) COPY ERROR", cntID)
+				cnt.displayError(title, err)
+			}
+
+			cnt.fastRefreshChan <- true
+
+			return
+		}
+
+		headerLabel := fmt.Sprintf("%s (%s)", cntID, cntName)
+
+		cnt.messageDialog.SetTitle("podman container cp")
+		cnt.messageDialog.SetText(dialogs.MessageContainerInfo, headerLabel,
+			fmt.Sprintf("%s copied to %s", source, destination))
+		cnt.messageDialog.Display()
+		cnt.fastRefreshChan <- true
+	}
+
+	go cntCopy(cnt.copyCancelChan)
+}
+
+// cancelCopy cancels the running container copy request.
+func (cnt *Containers) cancelCopy() {
+	if cnt.copyCancelChan == nil {
+		return
+	}
+
+	close(cnt.copyCancelChan)
+	cnt.copyCancelChan = nil
+}
+
+func (cnt *Containers) export() {
+	if cnt.selectedID == "" {
+		cnt.displayError("", errNoContainerExport)
+
+		return
+	}
+
+	cnt.cmdInputDialog.SetTitle("podman container export")
+
+	fgColor := style.GetColorHex(style.DialogFgColor)
+	bgColor := fmt.Sprintf("#%x", style.DialogBorderColor.Hex())
+	containerInfo := fmt.Sprintf("%s (%s)", cnt.selectedID, cnt.selectedName)
+	description := fmt.Sprintf("[%s:%s:b]CONTAINER ID:[:-:-] %s",
+		fgColor, bgColor, containerInfo)
+
+	cnt.cmdInputDialog.SetDescription(description)
+	cnt.cmdInputDialog.SetSelectButtonLabel("export")
+	cnt.cmdInputDialog.SetLabel("output ")
+	cnt.cmdInputDialog.SetInputText(cnt.selectedName + ".tar")
+
+	cnt.cmdInputDialog.SetSelectedFunc(func() {
+		output, err := utils.ResolveHomeDir(strings.TrimSpace(cnt.cmdInputDialog.GetInputText()))
+		if err == nil {
+			err = utils.ValidateFileName(output)
+		}
+
+		if err != nil {
+			cnt.displayError("CONTAINER EXPORT ERROR", err)
+
+			return
+		}
+
+		cnt.cmdInputDialog.Hide()
+		cnt.exportContainer(cnt.selectedID, cnt.selectedName, output)
+	})
+
+	cnt.cmdInputDialog.Display()
+}
+
+func (cnt *Containers) exportContainer(id string, name string, output string) {
+	containerInfo := fmt.Sprintf("%s (%s)", id, name)
+
+	cnt.exportCancelChan = make(chan bool)
+	cnt.exportPrgDialog.SetExportInfo(containerInfo, output)
+	cnt.exportPrgDialog.Display()
+
+	cntExport := func(cancelChan chan bool) {
+		err := containers.Export(id, output, func(progress containers.CntCopyProgress) {
+			cnt.exportPrgDialog.UpdateProgress(progress)
+			cnt.fastRefreshChan <- true
+		}, cancelChan)
+
+		cnt.exportPrgDialog.Hide()
+
+		if err != nil {
+			if !errors.Is(err, containers.ErrCntExportCanceled) {
+				title := fmt.Sprintf("CONTAINER (%s) EXPORT ERROR", id)
+				cnt.displayError(title, err)
+			}
+
+			cnt.fastRefreshChan <- true
+
+			return
+		}
+
+		message := fmt.Sprintf("container filesystem exported to %s\n", output)
+		message += "the archive can be imported as image using the images import command"
+
+		cnt.messageDialog.SetTitle("podman container export")
+		cnt.messageDialog.SetText(dialogs.MessageContainerInfo, containerInfo, message)
+		cnt.messageDialog.Display()
+		cnt.fastRefreshChan <- true
+	}
+
+	go cntExport(cnt.exportCancelChan)
+}
+
+// cancelExport cancels the running container export request.
+func (cnt *Containers) cancelExport() {
+	if cnt.exportCancelChan == nil {
+		return
+	}
+
+	close(cnt.exportCancelChan)
+	cnt.exportCancelChan = nil
+}
+
+func (cnt *Containers) files() {
+	if cnt.selectedID == "" {
+		cnt.displayError("", errNoContainerFiles)
+
+		return
+	}
+
+	cntID, cntName := cnt.getSelectedItem()
+
+	cnt.progressDialog.SetTitle("container filesystem read in progress")
+	cnt.progressDialog.Display()
+
+	cntFiles := func() {
+		tree, err := containers.FileTree(cntID)
+
+		cnt.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("CONTAINER (%s) FILES ERROR", cntID)
+			cnt.displayError(title, err)
+			cnt.fastRefreshChan <- true
+
+			return
+		}
+
+		cnt.filesDialog.SetContainerInfo(cntID, cntName)
+		cnt.filesDialog.SetFileTree(tree)
+		cnt.filesDialog.Display()
+		cnt.fastRefreshChan <- true
+	}
+
+	go cntFiles()
+}
+
+func (cnt *Containers) viewFile() {
+	file, ok := cnt.filesDialog.GetSelectedFile()
+	if !ok || file.IsDir() {
+		cnt.displayError("", errNoContainerFileSelected)
+
+		return
+	}
+
+	content, truncated, err := containers.ReadTextFile(cnt.selectedID, file.Path, viewFileMaxSize)
+	if err != nil {
+		title := fmt.Sprintf("CONTAINER (%s) FILE VIEW ERROR", cnt.selectedID)
+		cnt.displayError(title, err)
+
+		return
+	}
+
+	if truncated {
+		content += fmt.Sprintf("\n\n(truncated to the first %s)", putils.SizeToStr(viewFileMaxSize))
+	}
+
+	headerLabel := fmt.Sprintf("%s (%s) %s", cnt.selectedID, cnt.selectedName, file.Path)
+
+	cnt.messageDialog.SetTitle("podman container file view")
+	cnt.messageDialog.SetText(dialogs.MessageContainerInfo, headerLabel, content)
+	cnt.messageDialog.Display()
+}
+
+func (cnt *Containers) downloadFile() {
+	file, ok := cnt.filesDialog.GetSelectedFile()
+	if !ok {
+		cnt.displayError("", errNoContainerFileSelected)
+
+		return
+	}
+
+	cnt.copyDialog.SetContainerInfo(cnt.selectedID, cnt.selectedName)
+	cnt.copyDialog.SetCopyFromContainer(file.Path)
+	cnt.copyDialog.Display()
+}
+
 func (cnt *Containers) stats() {
 	if cnt.selectedID == "" {
 		cnt.displayError("", errNoContainerStat)
@@ -449,6 +839,100 @@ func (cnt *Containers) diff() {
 	cnt.messageDialog.Display()
 }
 
+func (cnt *Containers) generateKube() {
+	if cnt.selectedID == "" {
+		cnt.displayError("", errNoContainerKube)
+
+		return
+	}
+
+	data, err := kube.Generate([]string{cnt.selectedID})
+	if err != nil {
+		title := fmt.Sprintf("CONTAINER (%s) GENERATE KUBE ERROR", cnt.selectedID)
+		cnt.displayError(title, err)
+
+		return
+	}
+
+	headerLabel := fmt.Sprintf("%s (%s)", cnt.selectedID, cnt.selectedName)
+
+	cnt.kubeDialog.SetKubeYAML(dialogs.MessageContainerInfo, headerLabel, data)
+	cnt.kubeDialog.Display()
+}
+
+func (cnt *Containers) saveKube() {
+	path, err := cnt.kubeDialog.GetOutputPath()
+	if err != nil {
+		cnt.displayError("GENERATE KUBE SAVE ERROR", err)
+
+		return
+	}
+
+	if err := os.WriteFile(path, []byte(cnt.kubeDialog.GetKubeYAML()), 0o644); err != nil { //nolint:gosec,gomnd
+		cnt.displayError("GENERATE KUBE SAVE ERROR", err)
+
+		return
+	}
+
+	cnt.kubeDialog.Hide()
+}
+
+func (cnt *Containers) generateSystemd() {
+	if cnt.selectedID == "" {
+		cnt.displayError("", errNoContainerSystemd)
+
+		return
+	}
+
+	headerLabel := fmt.Sprintf("%s (%s)", cnt.selectedID, cnt.selectedName)
+
+	cnt.systemdDialog.SetUnitInfo(dialogs.MessageContainerInfo, headerLabel)
+	cnt.systemdDialog.Display()
+	cnt.previewSystemd()
+}
+
+func (cnt *Containers) previewSystemd() {
+	units, err := systemd.ContainerUnits(cnt.selectedID, cnt.systemdDialog.GetUnitOptions())
+	if err != nil {
+		cnt.systemdDialog.SetPreview(fmt.Sprintf("%v", err))
+
+		return
+	}
+
+	cnt.systemdDialog.SetPreview(systemd.UnitsString(units))
+}
+
+func (cnt *Containers) writeSystemd() {
+	dir, err := cnt.systemdDialog.GetUnitDir()
+	if err != nil {
+		cnt.displayError("GENERATE SYSTEMD ERROR", err)
+
+		return
+	}
+
+	units, err := systemd.ContainerUnits(cnt.selectedID, cnt.systemdDialog.GetUnitOptions())
+	if err != nil {
+		title := fmt.Sprintf("CONTAINER (%s) GENERATE SYSTEMD ERROR", cnt.selectedID)
+		cnt.displayError(title, err)
+
+		return
+	}
+
+	written, err := systemd.WriteUnits(dir, units)
+	if err != nil {
+		cnt.displayError("GENERATE SYSTEMD ERROR", err)
+
+		return
+	}
+
+	headerLabel := fmt.Sprintf("%s (%s)", cnt.selectedID, cnt.selectedName)
+
+	cnt.systemdDialog.Hide()
+	cnt.messageDialog.SetTitle("podman generate systemd")
+	cnt.messageDialog.SetText(dialogs.MessageContainerInfo, headerLabel, systemd.WrittenString(written))
+	cnt.messageDialog.Display()
+}
+
 func (cnt *Containers) inspect() {
 	if cnt.selectedID == "" {
 		cnt.displayError("", errNoContainerInspect)
@@ -472,6 +956,12 @@ func (cnt *Containers) inspect() {
 }
 
 func (cnt *Containers) kill() {
+	if cnt.markedItems.Count() > 0 {
+		cnt.preBulkCommand("kill")
+
+		return
+	}
+
 	if cnt.selectedID == "" {
 		cnt.displayError("", errNoContainerKill)
 
@@ -505,38 +995,35 @@ func (cnt *Containers) logs() {
 		return
 	}
 
-	cnt.progressDialog.SetTitle("container logs in progress")
-	cnt.progressDialog.Display()
-
-	getLogs := func() {
-		logData, err := containers.Logs(cntID)
+	cnt.logsDialog.SetContainerInfo(cntID, cntName)
+	cnt.logsDialog.Display()
+	cnt.streamLogs()
+}
 
-		cnt.progressDialog.Hide()
+func (cnt *Containers) streamLogs() {
+	cntID := cnt.selectedID
+	logsOpts := cnt.logsDialog.GetContainerLogsOptions()
+	logChan, cancelChan := cnt.logsDialog.NewLogStream()
 
+	streamLogs := func() {
+		err := containers.Logs(cntID, logsOpts, logChan, cancelChan)
 		if err != nil {
 			title := fmt.Sprintf("CONTAINER (%s) DISPLAY LOG ERROR", cntID)
 
 			cnt.displayError(title, err)
-
-			return
 		}
-
-		headerLabel := fmt.Sprintf("%s (%s)", cntID, cntName)
-
-		cntLogs := strings.Join(logData, "\n")
-		cntLogs = strings.ReplaceAll(cntLogs, "[", "")
-		cntLogs = strings.ReplaceAll(cntLogs, "]", "")
-
-		cnt.messageDialog.SetTitle("podman container logs")
-		cnt.messageDialog.SetText(dialogs.MessageContainerInfo, headerLabel, cntLogs)
-		cnt.messageDialog.TextScrollToEnd()
-		cnt.messageDialog.Display()
 	}
 
-	go getLogs()
+	go streamLogs()
 }
 
 func (cnt *Containers) pause() {
+	if cnt.markedItems.Count() > 0 {
+		cnt.preBulkCommand("pause")
+
+		return
+	}
+
 	if cnt.selectedID == "" {
 		cnt.displayError("", errNoContainerPause)
 
@@ -668,6 +1155,12 @@ func (cnt *Containers) renameContainer(id string, newName string) {
 }
 
 func (cnt *Containers) rm() {
+	if cnt.markedItems.Count() > 0 {
+		cnt.preBulkCommand("rm")
+
+		return
+	}
+
 	cntID, cntName := cnt.getSelectedItem()
 	if cntID == "" {
 		cnt.displayError("", errNoContainerRemove)
@@ -715,6 +1208,12 @@ func (cnt *Containers) remove() {
 }
 
 func (cnt *Containers) start() {
+	if cnt.markedItems.Count() > 0 {
+		cnt.preBulkCommand("start")
+
+		return
+	}
+
 	if cnt.selectedID == "" {
 		cnt.displayError("", errNoContainerStart)
 
@@ -740,7 +1239,249 @@ func (cnt *Containers) start() {
 	go start(cnt.selectedID)
 }
 
+func (cnt *Containers) restart(now bool) {
+	if cnt.markedItems.Count() > 0 {
+		cnt.preBulkCommand("restart")
+
+		return
+	}
+
+	if cnt.selectedID == "" {
+		cnt.displayError("", errNoContainerRestart)
+
+		return
+	}
+
+	if now {
+		cnt.restartContainer(cnt.selectedID, containers.DefaultRestartTimeout)
+
+		return
+	}
+
+	cnt.cmdInputDialog.SetTitle("podman container restart")
+
+	fgColor := style.GetColorHex(style.DialogFgColor)
+	bgColor := fmt.Sprintf("#%x", style.DialogBorderColor.Hex())
+	containerInfo := fmt.Sprintf("%s (%s)", cnt.selectedID, cnt.selectedName)
+	description := fmt.Sprintf("[%s:%s:b]CONTAINER ID:[:-:-] %s",
+		fgColor, bgColor, containerInfo)
+
+	cnt.cmdInputDialog.SetDescription(description)
+	cnt.cmdInputDialog.SetSelectButtonLabel("restart")
+	cnt.cmdInputDialog.SetLabel("timeout (seconds) ")
+	cnt.cmdInputDialog.SetInputText(strconv.Itoa(containers.DefaultRestartTimeout))
+
+	cnt.cmdInputDialog.SetSelectedFunc(func() {
+		timeout, err := strconv.Atoi(strings.TrimSpace(cnt.cmdInputDialog.GetInputText()))
+		if err != nil || timeout < 0 {
+			cnt.displayError("CONTAINER RESTART ERROR", errInvalidRestartTimeout)
+
+			return
+		}
+
+		cnt.cmdInputDialog.Hide()
+		cnt.restartContainer(cnt.selectedID, timeout)
+	})
+
+	cnt.cmdInputDialog.Display()
+}
+
+func (cnt *Containers) restartContainer(id string, timeout int) {
+	cnt.progressDialog.SetTitle("container restart in progress")
+	cnt.progressDialog.Display()
+
+	restart := func() {
+		err := containers.Restart(id, timeout)
+
+		cnt.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("CONTAINER (%s) RESTART ERROR", id)
+			cnt.displayError(title, err)
+		}
+	}
+
+	go restart()
+}
+
+func (cnt *Containers) initContainer() {
+	if cnt.selectedID == "" {
+		cnt.displayError("", errNoContainerInit)
+
+		return
+	}
+
+	cnt.progressDialog.SetTitle("container init in progress")
+	cnt.progressDialog.Display()
+
+	initFunc := func(id string) {
+		err := containers.Init(id)
+
+		cnt.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("CONTAINER (%s) INIT ERROR", id)
+			cnt.displayError(title, err)
+		}
+	}
+
+	go initFunc(cnt.selectedID)
+}
+
+func (cnt *Containers) cwait() {
+	if cnt.selectedID == "" {
+		cnt.displayError("", errNoContainerWait)
+
+		return
+	}
+
+	cntID, cntName := cnt.getSelectedItem()
+
+	cnt.waitDialog.SetContainerInfo(cntID, cntName)
+	cnt.waitDialog.Display()
+}
+
+// wait waits in background for the container condition and displays the exit code once its reached,
+// a pending wait is canceled.
+func (cnt *Containers) wait() {
+	condition := cnt.waitDialog.GetWaitCondition()
+	cntID, cntName := cnt.selectedID, cnt.selectedName
+
+	cnt.waitDialog.Hide()
+
+	if cnt.waitCancelChan != nil {
+		close(cnt.waitCancelChan)
+	}
+
+	cnt.waitCancelChan = make(chan bool)
+
+	wait := func(cancelChan chan bool) {
+		exitCode, err := containers.Wait(cntID, condition, cancelChan)
+		if errors.Is(err, containers.ErrCntWaitCanceled) {
+			return
+		}
+
+		if err != nil {
+			title := fmt.Sprintf("CONTAINER (%s) WAIT ERROR", cntID)
+			cnt.displayError(title, err)
+			cnt.fastRefreshChan <- true
+
+			return
+		}
+
+		headerLabel := fmt.Sprintf("%s (%s)", cntID, cntName)
+
+		cnt.messageDialog.SetTitle("podman container wait")
+		cnt.messageDialog.SetText(dialogs.MessageContainerInfo, headerLabel,
+			fmt.Sprintf("condition: %s\nexit code: %d", condition, exitCode))
+		cnt.messageDialog.Display()
+		cnt.fastRefreshChan <- true
+	}
+
+	go wait(cnt.waitCancelChan)
+}
+
+func (cnt *Containers) cupdate() {
+	if cnt.selectedID == "" {
+		cnt.displayError("", errNoContainerUpdate)
+
+		return
+	}
+
+	cntID, cntName := cnt.getSelectedItem()
+
+	cnt.statsCancelChan = make(chan bool)
+	cnt.progressDialog.SetTitle("container resources read in progress")
+	cnt.progressDialog.Display()
+
+	cntResources := func(statsCancelChan chan bool) {
+		resources, err := containers.Resources(cntID)
+
+		cnt.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("CONTAINER (%s) UPDATE ERROR", cntID)
+			cnt.displayError(title, err)
+			cnt.fastRefreshChan <- true
+
+			return
+		}
+
+		cnt.updateDialog.SetContainerInfo(cntID, cntName)
+		cnt.updateDialog.SetUpdateOptions(resources)
+		cnt.updateDialog.Display()
+
+		// the live usage is only available for running containers
+		if cntStatus, err := containers.Status(cntID); err == nil && cntStatus == "running" {
+			statsChan, err := containers.StatsStream(cntID, statsCancelChan)
+			if err != nil {
+				log.Error().Msgf("view: containers update stats %s", err.Error())
+			} else {
+				cnt.updateDialog.SetStatsChannel(statsChan)
+			}
+		}
+
+		cnt.fastRefreshChan <- true
+	}
+
+	go cntResources(cnt.statsCancelChan)
+}
+
+func (cnt *Containers) update() {
+	cntID := cnt.selectedID
+	updateOpts := cnt.updateDialog.GetContainerUpdateOptions()
+
+	cnt.progressDialog.SetTitle("container update in progress")
+	cnt.progressDialog.Display()
+
+	update := func() {
+		err := containers.Update(cntID, updateOpts)
+		if err != nil {
+			cnt.progressDialog.Hide()
+
+			title := fmt.Sprintf("CONTAINER (%s) UPDATE ERROR", cntID)
+			cnt.displayError(title, err)
+			cnt.fastRefreshChan <- true
+
+			return
+		}
+
+		// the applied values are read back to display them next to the live usage
+		resources, err := containers.Resources(cntID)
+
+		cnt.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("CONTAINER (%s) UPDATE ERROR", cntID)
+			cnt.displayError(title, err)
+			cnt.fastRefreshChan <- true
+
+			return
+		}
+
+		cnt.updateDialog.SetUpdateOptions(resources)
+		cnt.fastRefreshChan <- true
+	}
+
+	go update()
+}
+
+func (cnt *Containers) closeUpdate() {
+	if cnt.statsCancelChan != nil {
+		close(cnt.statsCancelChan)
+		cnt.statsCancelChan = nil
+	}
+
+	cnt.updateDialog.Hide()
+}
+
 func (cnt *Containers) stop() {
+	if cnt.markedItems.Count() > 0 {
+		cnt.preBulkCommand("stop")
+
+		return
+	}
+
 	if cnt.selectedID == "" {
 		cnt.displayError("", errNoContainerStop)
 
@@ -790,6 +1531,12 @@ func (cnt *Containers) top() {
 }
 
 func (cnt *Containers) unpause() {
+	if cnt.markedItems.Count() > 0 {
+		cnt.preBulkCommand("unpause")
+
+		return
+	}
+
 	if cnt.selectedID == "" {
 		cnt.displayError("", errNoContainerUnpause)
 
@@ -815,3 +1562,81 @@ func (cnt *Containers) unpause() {
 
 	go unpause(cnt.selectedID)
 }
+
+func (cnt *Containers) markItem() {
+	if cnt.selectedID == "" {
+		return
+	}
+
+	row, _ := cnt.table.GetSelection()
+
+	cnt.markedItems.ToggleItem(utils.MarkedItem{
+		ID:   cnt.selectedID,
+		Name: cnt.selectedName,
+		Host: cnt.table.GetCell(row, viewContainersHostColIndex).Text,
+	})
+
+	if row+1 < cnt.table.GetRowCount() {
+		cnt.table.Select(row+1, 0)
+	}
+}
+
+func (cnt *Containers) markPattern() {
+	cnt.cmdInputDialog.SetTitle("mark containers")
+	cnt.cmdInputDialog.SetDescription("mark the containers which their ID or name matches the regular expression")
+	cnt.cmdInputDialog.SetSelectButtonLabel("mark")
+	cnt.cmdInputDialog.SetLabel("pattern ")
+
+	cnt.cmdInputDialog.SetSelectedFunc(func() {
+		pattern := cnt.cmdInputDialog.GetInputText()
+		cnt.cmdInputDialog.Hide()
+
+		if _, err := cnt.markedItems.Mark(cnt.getItems(), pattern); err != nil {
+			cnt.displayError("CONTAINER MARK ERROR", err)
+		}
+	})
+
+	cnt.cmdInputDialog.Display()
+}
+
+func (cnt *Containers) preBulkCommand(cmd string) {
+	cnt.confirmDialog.SetTitle("podman container " + cmd)
+	cnt.confirmData = "bulk"
+	cnt.bulkCmd = cmd
+
+	cnt.confirmDialog.SetText(utils.BulkCommandConfirmMessage("container", cmd, cnt.markedItems.Items()))
+	cnt.confirmDialog.Display()
+}
+
+func (cnt *Containers) bulkCommand() {
+	cmd := cnt.bulkCmd
+	if !slices.Contains(bulkCommands, cmd) {
+		return
+	}
+
+	// the marked containers are on their service connections (hosts)
+	cmdFunc := func(host string, id string) error {
+		if host == "" {
+			host = registry.ConnectionName()
+		}
+
+		return containers.BulkCommandByConnection(host, cmd, id)
+	}
+
+	cnt.progressDialog.SetTitle(fmt.Sprintf("container %s in progress", cmd))
+	cnt.progressDialog.Display()
+
+	bulk := func() {
+		results := cnt.markedItems.RunHostBulkCommand(cmdFunc)
+
+		cnt.progressDialog.Hide()
+
+		summary, report := utils.BulkCommandSummary(results)
+
+		cnt.messageDialog.SetTitle("podman container " + cmd)
+		cnt.messageDialog.SetText(dialogs.MessageBulkCommandInfo, summary, report)
+		cnt.messageDialog.Display()
+	}
+
+	go bulk()
+}
@@ -6,10 +6,13 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/containers/podman-tui/pdcs/registry"
 	"github.com/containers/podman-tui/ui/containers/cntdialogs"
 	"github.com/containers/podman-tui/ui/containers/cntdialogs/vterm"
 	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/rivo/tview"
 )
@@ -20,32 +23,54 @@ const (
 	viewContainersPodColIndex
 	viewContainersCreatedAtColIndex
 	viewContainersStatusColIndex
+	viewContainersHealthColIndex
 	viewContainersNamesColIndex
 	viewContainersPortsColIndex
+	viewContainersHostColIndex
 )
 
+// viewFileMaxSize is the maximum size of the file content displayed by the files dialog.
+const viewFileMaxSize = 1024 * 1024
+
 var (
 	errNoContainerAttach       = errors.New("there is no container to attach")
 	errNoContainerHealthCheck  = errors.New("there is no container to perform healthcheck")
+	errNoContainerHealth       = errors.New("there is no container to display health history")
+	errNoContainerClone        = errors.New("there is no container to clone")
 	errNoContainerCommit       = errors.New("there is no container to commit")
+	errNoContainerCopy         = errors.New("there is no container to copy files")
 	errNoContainerStat         = errors.New("there is no container to display stats")
 	errNoContainerCheckpoint   = errors.New("there is no container to perform checkpoint")
 	errNoContainerExec         = errors.New("there is no container to perform exec")
+	errNoContainerExport       = errors.New("there is no container to export")
+	errNoContainerFiles        = errors.New("there is no container to browse files")
+	errNoContainerFileSelected = errors.New("there is no file selected")
 	errNoContainerDiff         = errors.New("there is no container to display diff")
+	errNoContainerInit         = errors.New("there is no container to init")
 	errNoContainerInspect      = errors.New("there is no container to inspect")
 	errNoContainerKill         = errors.New("there is no container to kill")
+	errNoContainerKube         = errors.New("there is no container to generate kube")
+	errNoContainerSystemd      = errors.New("there is no container to generate systemd")
 	errNoContainerLogs         = errors.New("there is no container to display logs")
 	errNoContainerPause        = errors.New("there is no container to pause")
 	errNoContainerUnpause      = errors.New("there is no container to unpause")
 	errNoContainerPorts        = errors.New("there is no container to display ports")
 	errNoContainerRename       = errors.New("there is no container to rename")
 	errNoContainerRemove       = errors.New("there is no container to remove")
+	errNoContainerRestart      = errors.New("there is no container to restart")
 	errNoContainerStart        = errors.New("there is no container to start")
 	errNoContainerStop         = errors.New("there is no container to stop")
 	errNoContainerTop          = errors.New("there is no container to display top")
+	errNoContainerUpdate       = errors.New("there is no container to update")
+	errNoContainerWait         = errors.New("there is no container to wait")
+	errInvalidRestartTimeout   = errors.New("invalid container restart timeout value")
 	errEmptyContainerImageName = errors.New("empty container name or image name")
+	errContainerNotActiveHost  = errors.New("the container is not on the active service connection")
 )
 
+// bulkCommands list of the commands which are run for the marked containers (if any).
+var bulkCommands = []string{"kill", "pause", "restart", "rm", "start", "stop", "unpause"}
+
 // Containers implements the containers page primitive.
 type Containers struct {
 	*tview.Box
@@ -66,16 +91,43 @@ type Containers struct {
 	commitDialog     *cntdialogs.ContainerCommitDialog
 	checkpointDialog *cntdialogs.ContainerCheckpointDialog
 	restoreDialog    *cntdialogs.ContainerRestoreDialog
+	logsDialog       *cntdialogs.ContainerLogsDialog
+	kubeDialog       *dialogs.KubeGenerateDialog
+	systemdDialog    *dialogs.SystemdGenerateDialog
+	copyDialog       *cntdialogs.ContainerCopyDialog
+	copyPrgDialog    *cntdialogs.ContainerCopyProgressDialog
+	filesDialog      *cntdialogs.ContainerFilesDialog
+	waitDialog       *cntdialogs.ContainerWaitDialog
+	updateDialog     *cntdialogs.ContainerUpdateDialog
+	cloneDialog      *cntdialogs.ContainerCloneDialog
+	exportPrgDialog  *cntdialogs.ContainerCopyProgressDialog
+	healthDialog     *cntdialogs.ContainerHealthDialog
+	filterBar        *dialogs.FilterBar
 	containersList   containerListReport
+	markedItems      *utils.MarkedItems
+	tableSort        *utils.TableSort
 	selectedID       string
 	selectedName     string
 	confirmData      string
+	bulkCmd          string
 	fastRefreshChan  chan bool
+	copyCancelChan   chan bool
+	exportCancelChan chan bool
+	waitCancelChan   chan bool
+	statsCancelChan  chan bool
 }
 
 type containerListReport struct {
 	mu     sync.Mutex
-	report []entities.ListContainer
+	report []hostContainer
+}
+
+// hostContainer implements a container list item, its service connection name
+// and its healthcheck results (only read for the unhealthy containers, see UpdateItem).
+type hostContainer struct {
+	entities.ListContainer
+	host   string
+	health *define.HealthCheckResults
 }
 
 // NewContainers returns containers page view.
@@ -83,7 +135,7 @@ func NewContainers() *Containers {
 	containers := &Containers{
 		Box:              tview.NewBox(),
 		title:            "containers",
-		headers:          []string{"container id", "image", "pod", "created", "status", "names", "ports"},
+		headers:          []string{"container id", "image", "pod", "created", "status", "health", "names", "ports", "host"},
 		errorDialog:      dialogs.NewErrorDialog(),
 		cmdInputDialog:   dialogs.NewSimpleInputDialog(""),
 		messageDialog:    dialogs.NewMessageDialog(""),
@@ -97,17 +149,41 @@ func NewContainers() *Containers {
 		commitDialog:     cntdialogs.NewContainerCommitDialog(),
 		checkpointDialog: cntdialogs.NewContainerCheckpointDialog(),
 		restoreDialog:    cntdialogs.NewContainerRestoreDialog(),
-	}
+		logsDialog:       cntdialogs.NewContainerLogsDialog(),
+		kubeDialog:       dialogs.NewKubeGenerateDialog(),
+		systemdDialog:    dialogs.NewSystemdGenerateDialog(),
+		copyDialog:       cntdialogs.NewContainerCopyDialog(),
+		copyPrgDialog:    cntdialogs.NewContainerCopyProgressDialog(),
+		filesDialog:      cntdialogs.NewContainerFilesDialog(),
+		waitDialog:       cntdialogs.NewContainerWaitDialog(),
+		updateDialog:     cntdialogs.NewContainerUpdateDialog(),
+		cloneDialog:      cntdialogs.NewContainerCloneDialog(),
+		exportPrgDialog:  cntdialogs.NewContainerCopyProgressDialog(),
+		healthDialog:     cntdialogs.NewContainerHealthDialog(),
+		filterBar:        dialogs.NewFilterBar(),
+		markedItems:      utils.NewMarkedItems(),
+	}
+
+	containers.tableSort = utils.NewTableSort(len(containers.headers), viewContainersNamesColIndex)
+
 	containers.topDialog.SetTitle("podman container top")
 
 	containers.cmdDialog = dialogs.NewCommandDialog([][]string{
 		{"attach", "attach to a running container"},
 		{"checkpoint", "checkpoints a running container"},
+		{"clone", "create a copy of the selected container with optional overrides"},
 		{"commit", "create an image from a container's changes"},
+		{"cp", "copy files/folders between a container and the local filesystem"},
 		{"create", "create a new container but do not start"},
 		{"diff", "inspect changes to the selected container's file systems"},
 		{"exec", "execute the specified command inside a running container"},
+		{"export", "export the container filesystem to a local tar archive"},
+		{"files", "browse the filesystem of the selected container"},
+		{"generate kube", "generate kubernetes YAML of the selected container"},
+		{"generate systemd", "generate quadlet or systemd unit files of the selected container"},
+		{"health history", "display the healthcheck log history of the selected container"},
 		{"healthcheck", "run the health check of a container"},
+		{"init", "initialize the selected container without starting it"},
 		{"inspect", "display the configuration of a container"},
 		{"kill", "kill the selected running container with a SIGKILL signal"},
 		{"logs", "fetch the logs of the selected container"},
@@ -115,13 +191,17 @@ func NewContainers() *Containers {
 		{"port", "list port mappings for the selected container"},
 		{"prune", "remove all non running containers"},
 		{"rename", "rename the selected container"},
+		{"restart", "restart the selected containers"},
 		{"restore", "restores a container from a checkpoint"},
 		{"rm", "remove the selected container"},
 		{"start", "start the selected containers"},
 		{"stats", "display container resource usage statistics"},
 		{"stop", "stop the selected containers"},
 		{"top", "display the running processes of the selected container"},
+		{"unhealthy", "list the unhealthy containers (health:unhealthy filter)"},
 		{"unpause", "unpause the selected container that was paused before"},
+		{"update", "update the resource limits of the selected container and watch their live usage"},
+		{"wait", "wait for the selected container condition and display its exit code"},
 	})
 
 	containers.table = tview.NewTable()
@@ -133,12 +213,12 @@ func NewContainers() *Containers {
 
 	for i := 0; i < len(containers.headers); i++ {
 		containers.table.SetCell(0, i,
-			tview.NewTableCell(fmt.Sprintf("[black::b]%s", strings.ToUpper(containers.headers[i]))). //nolint:perfsprint
-															SetExpansion(1).
-															SetBackgroundColor(style.PageHeaderBgColor).
-															SetTextColor(style.PageHeaderFgColor).
-															SetAlign(tview.AlignLeft).
-															SetSelectable(false))
+			tview.NewTableCell("[::b]"+strings.ToUpper(containers.headers[i])).
+				SetExpansion(1).
+				SetBackgroundColor(style.PageHeaderBgColor).
+				SetTextColor(style.PageHeaderFgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
 	}
 
 	containers.table.SetFixed(1, 1)
@@ -170,6 +250,8 @@ func NewContainers() *Containers {
 			containers.prune()
 		case "rm":
 			containers.remove()
+		case "bulk":
+			containers.bulkCommand()
 		}
 	})
 
@@ -210,6 +292,51 @@ func NewContainers() *Containers {
 	containers.restoreDialog.SetRestoreFunc(containers.restore)
 	containers.restoreDialog.SetCancelFunc(containers.restoreDialog.Hide)
 
+	// set logs dialog functions
+	containers.logsDialog.SetCancelFunc(containers.logsDialog.Hide)
+	containers.logsDialog.SetReloadFunc(containers.streamLogs)
+	containers.logsDialog.SetFastRefreshHandler(func() {
+		containers.fastRefreshChan <- true
+	})
+
+	// set kube generate dialog functions
+	containers.kubeDialog.SetCancelFunc(containers.kubeDialog.Hide)
+	containers.kubeDialog.SetSaveFunc(containers.saveKube)
+
+	// set systemd generate dialog functions
+	containers.systemdDialog.SetCancelFunc(containers.systemdDialog.Hide)
+	containers.systemdDialog.SetPreviewFunc(containers.previewSystemd)
+	containers.systemdDialog.SetWriteFunc(containers.writeSystemd)
+
+	// set copy dialogs functions
+	containers.copyDialog.SetCopyFunc(containers.copy)
+	containers.copyDialog.SetCancelFunc(containers.copyDialog.Hide)
+	containers.copyPrgDialog.SetCancelFunc(containers.cancelCopy)
+
+	// set files dialog functions
+	containers.filesDialog.SetCancelFunc(containers.filesDialog.Hide)
+	containers.filesDialog.SetViewFunc(containers.viewFile)
+	containers.filesDialog.SetDownloadFunc(containers.downloadFile)
+
+	// set wait dialog functions
+	containers.waitDialog.SetCancelFunc(containers.waitDialog.Hide)
+	containers.waitDialog.SetWaitFunc(containers.wait)
+
+	// set update dialog functions
+	containers.updateDialog.SetCancelFunc(containers.closeUpdate)
+	containers.updateDialog.SetUpdateFunc(containers.update)
+
+	// set clone dialog functions
+	containers.cloneDialog.SetCancelFunc(containers.cloneDialog.Hide)
+	containers.cloneDialog.SetCloneFunc(containers.clone)
+
+	// set export progress dialog functions
+	containers.exportPrgDialog.SetCancelFunc(containers.cancelExport)
+
+	// set health dialog functions
+	containers.healthDialog.SetCancelFunc(containers.healthDialog.Hide)
+	containers.healthDialog.SetHealthCheckFunc(containers.runHealthCheck)
+
 	return containers
 }
 
@@ -252,6 +379,30 @@ func (cnt *Containers) HasFocus() bool { //nolint:cyclop
 		return true
 	}
 
+	if cnt.logsDialog.HasFocus() || cnt.filterBar.HasFocus() {
+		return true
+	}
+
+	if cnt.kubeDialog.HasFocus() || cnt.systemdDialog.HasFocus() {
+		return true
+	}
+
+	if cnt.copyDialog.HasFocus() || cnt.copyPrgDialog.HasFocus() {
+		return true
+	}
+
+	if cnt.filesDialog.HasFocus() || cnt.waitDialog.HasFocus() {
+		return true
+	}
+
+	if cnt.updateDialog.HasFocus() || cnt.cloneDialog.HasFocus() {
+		return true
+	}
+
+	if cnt.exportPrgDialog.HasFocus() || cnt.healthDialog.HasFocus() {
+		return true
+	}
+
 	return false
 }
 
@@ -285,6 +436,30 @@ func (cnt *Containers) SubDialogHasFocus() bool { //nolint:cyclop
 		return true
 	}
 
+	if cnt.logsDialog.HasFocus() || cnt.filterBar.HasFocus() {
+		return true
+	}
+
+	if cnt.kubeDialog.HasFocus() || cnt.systemdDialog.HasFocus() {
+		return true
+	}
+
+	if cnt.copyDialog.HasFocus() || cnt.copyPrgDialog.HasFocus() {
+		return true
+	}
+
+	if cnt.filesDialog.HasFocus() || cnt.waitDialog.HasFocus() {
+		return true
+	}
+
+	if cnt.updateDialog.HasFocus() || cnt.cloneDialog.HasFocus() {
+		return true
+	}
+
+	if cnt.exportPrgDialog.HasFocus() || cnt.healthDialog.HasFocus() {
+		return true
+	}
+
 	return false
 }
 
@@ -381,6 +556,90 @@ func (cnt *Containers) Focus(delegate func(p tview.Primitive)) { //nolint:cyclop
 		return
 	}
 
+	// logs dialog
+	if cnt.logsDialog.IsDisplay() {
+		delegate(cnt.logsDialog)
+
+		return
+	}
+
+	// kube generate dialog
+	if cnt.kubeDialog.IsDisplay() {
+		delegate(cnt.kubeDialog)
+
+		return
+	}
+
+	// systemd generate dialog
+	if cnt.systemdDialog.IsDisplay() {
+		delegate(cnt.systemdDialog)
+
+		return
+	}
+
+	// copy dialog
+	if cnt.copyDialog.IsDisplay() {
+		delegate(cnt.copyDialog)
+
+		return
+	}
+
+	// copy progress dialog
+	if cnt.copyPrgDialog.IsDisplay() {
+		delegate(cnt.copyPrgDialog)
+
+		return
+	}
+
+	// files dialog
+	if cnt.filesDialog.IsDisplay() {
+		delegate(cnt.filesDialog)
+
+		return
+	}
+
+	// wait dialog
+	if cnt.waitDialog.IsDisplay() {
+		delegate(cnt.waitDialog)
+
+		return
+	}
+
+	// update dialog
+	if cnt.updateDialog.IsDisplay() {
+		delegate(cnt.updateDialog)
+
+		return
+	}
+
+	// clone dialog
+	if cnt.cloneDialog.IsDisplay() {
+		delegate(cnt.cloneDialog)
+
+		return
+	}
+
+	// export progress dialog
+	if cnt.exportPrgDialog.IsDisplay() {
+		delegate(cnt.exportPrgDialog)
+
+		return
+	}
+
+	// health dialog
+	if cnt.healthDialog.IsDisplay() {
+		delegate(cnt.healthDialog)
+
+		return
+	}
+
+	// filter bar
+	if cnt.filterBar.IsDisplay() {
+		delegate(cnt.filterBar)
+
+		return
+	}
+
 	delegate(cnt.table)
 }
 
@@ -401,11 +660,51 @@ func (cnt *Containers) getSelectedItem() (string, string) {
 	return cntID, cntName
 }
 
+// selectedOnActiveHost returns true if the selected container is on the
+// active service connection otherwise displays an error.
+func (cnt *Containers) selectedOnActiveHost() bool {
+	if cnt.table.GetRowCount() <= 1 {
+		return true
+	}
+
+	row, _ := cnt.table.GetSelection()
+
+	host := cnt.table.GetCell(row, viewContainersHostColIndex).Text
+	if host != "" && host != registry.ConnectionName() {
+		cnt.displayError("", fmt.Errorf("%w (%s), connect to %s to run the command",
+			errContainerNotActiveHost, host, host))
+
+		return false
+	}
+
+	return true
+}
+
+func (cnt *Containers) getItems() []utils.MarkedItem {
+	items := []utils.MarkedItem{}
+
+	for row := 1; row < cnt.table.GetRowCount(); row++ {
+		items = append(items, utils.MarkedItem{
+			ID:   cnt.table.GetCell(row, viewContainersIDColIndex).Text,
+			Name: cnt.table.GetCell(row, viewContainersNamesColIndex).Text,
+			Host: cnt.table.GetCell(row, viewContainersHostColIndex).Text,
+		})
+	}
+
+	return items
+}
+
 // SetFastRefreshChannel sets channel for fastRefresh func.
 func (cnt *Containers) SetFastRefreshChannel(refresh chan bool) {
 	cnt.fastRefreshChan = refresh
 }
 
+// SetQueueUpdateDrawFunc sets the function which runs an update on the application
+// UI goroutine and draws the screen (it's required for the logs dialog stream).
+func (cnt *Containers) SetQueueUpdateDrawFunc(queueUpdateDraw func(f func())) {
+	cnt.logsDialog.SetQueueUpdateDrawHandler(queueUpdateDraw)
+}
+
 // HideAllDialogs hides all sub dialogs.
 func (cnt *Containers) HideAllDialogs() { //nolint:cyclop
 	if cnt.errorDialog.IsDisplay() {
@@ -463,4 +762,44 @@ func (cnt *Containers) HideAllDialogs() { //nolint:cyclop
 	if cnt.terminalDialog.IsDisplay() {
 		cnt.terminalDialog.Hide()
 	}
+
+	if cnt.logsDialog.IsDisplay() {
+		cnt.logsDialog.Hide()
+	}
+
+	if cnt.kubeDialog.IsDisplay() {
+		cnt.kubeDialog.Hide()
+	}
+
+	if cnt.systemdDialog.IsDisplay() {
+		cnt.systemdDialog.Hide()
+	}
+
+	if cnt.copyDialog.IsDisplay() {
+		cnt.copyDialog.Hide()
+	}
+
+	if cnt.filesDialog.IsDisplay() {
+		cnt.filesDialog.Hide()
+	}
+
+	if cnt.waitDialog.IsDisplay() {
+		cnt.waitDialog.Hide()
+	}
+
+	if cnt.updateDialog.IsDisplay() {
+		cnt.closeUpdate()
+	}
+
+	if cnt.cloneDialog.IsDisplay() {
+		cnt.cloneDialog.Hide()
+	}
+
+	if cnt.healthDialog.IsDisplay() {
+		cnt.healthDialog.Hide()
+	}
+
+	if cnt.filterBar.IsDisplay() {
+		cnt.filterBar.Hide()
+	}
 }
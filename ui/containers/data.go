@@ -2,21 +2,25 @@ package containers
 
 import (
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/containers/podman-tui/pdcs/containers"
 	putils "github.com/containers/podman-tui/pdcs/utils"
 	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/docker/go-units"
 	"github.com/rivo/tview"
 	"github.com/rs/zerolog/log"
 )
 
-// UpdateData retrieves containers list data.
+// UpdateData retrieves containers list data of the active connection and the other connected services.
 func (cnt *Containers) UpdateData() {
-	cntList, err := containers.List()
+	hostsList, err := utils.ListConnectedServices(containers.ListByConnection)
 	if err != nil {
 		log.Error().Msgf("view: containers update %v", err)
 		cnt.errorDialog.SetText(fmt.Sprintf("%v", err))
@@ -25,19 +29,125 @@ func (cnt *Containers) UpdateData() {
 		return
 	}
 
+	cntList := []hostContainer{}
+
+	for _, host := range utils.SortedKeys(hostsList) {
+		for _, item := range hostsList[host] {
+			cntList = append(cntList, hostContainer{ListContainer: item, host: host})
+		}
+	}
+
 	cnt.containersList.mu.Lock()
+	keepHealthResults(cntList, cnt.containersList.report)
 	cnt.containersList.report = cntList
 	cnt.containersList.mu.Unlock()
 }
 
-func (cnt *Containers) getData() []entities.ListContainer {
+// UpdateItem updates the container list item of the service connection after a container
+// event instead of listing all the containers again (the removed container is deleted).
+// The healthcheck results (i.e. failing streak) of an unhealthy container are read after
+// its healthcheck runs.
+func (cnt *Containers) UpdateItem(host string, id string, action string) {
+	var items []hostContainer
+
+	if action != utils.EventActionRemove {
+		listItems, err := containers.ListByID(host, id)
+		if err != nil {
+			log.Error().Msgf("view: containers update %s %s: %v", host, id, err)
+
+			return
+		}
+
+		for _, item := range listItems {
+			hostItem := hostContainer{ListContainer: item, host: host}
+
+			if action == utils.EventActionHealthStatus && item.Status == define.HealthCheckUnhealthy {
+				health, err := containers.HealthByConnection(host, item.ID)
+				if err != nil {
+					log.Error().Msgf("view: containers health %s %s: %v", host, item.ID, err)
+				}
+
+				hostItem.health = health
+			}
+
+			items = append(items, hostItem)
+		}
+	}
+
 	cnt.containersList.mu.Lock()
-	data := cnt.containersList.report
+	defer cnt.containersList.mu.Unlock()
+
+	keepHealthResults(items, cnt.containersList.report)
+
+	report := slices.DeleteFunc(cnt.containersList.report, func(item hostContainer) bool {
+		return item.host == host && item.ID == id
+	})
+
+	cnt.containersList.report = append(report, items...)
+}
+
+// keepHealthResults sets the healthcheck results of the previous list items to the new list
+// items (without results) if their health status didn't change.
+func keepHealthResults(items []hostContainer, previous []hostContainer) {
+	results := make(map[string]*define.HealthCheckResults)
+
+	for _, item := range previous {
+		if item.health != nil {
+			results[item.host+"/"+item.ID] = item.health
+		}
+	}
+
+	for i := range items {
+		health, ok := results[items[i].host+"/"+items[i].ID]
+		if ok && items[i].health == nil && health.Status == items[i].Status {
+			items[i].health = health
+		}
+	}
+}
+
+// getData returns a copy of the list data sorted by the table sort column and order.
+func (cnt *Containers) getData() []hostContainer {
+	cnt.containersList.mu.Lock()
+	data := make([]hostContainer, len(cnt.containersList.report))
+	copy(data, cnt.containersList.report)
 	cnt.containersList.mu.Unlock()
 
+	column := cnt.tableSort.Column()
+
+	sort.SliceStable(data, func(i, j int) bool {
+		return cnt.tableSort.Less(compareContainers(column, data[i], data[j]))
+	})
+
 	return data
 }
 
+func compareContainers(column int, a, b hostContainer) int {
+	switch column {
+	case viewContainersIDColIndex:
+		return strings.Compare(a.ID, b.ID)
+	case viewContainersImageColIndex:
+		return strings.Compare(a.Image, b.Image)
+	case viewContainersPodColIndex:
+		return strings.Compare(a.PodName, b.PodName)
+	case viewContainersCreatedAtColIndex:
+		return a.Created.Compare(b.Created)
+	case viewContainersStatusColIndex:
+		return strings.Compare(a.State, b.State)
+	case viewContainersHealthColIndex:
+		return strings.Compare(a.healthStatus(), b.healthStatus())
+	case viewContainersPortsColIndex:
+		return strings.Compare(conReporter{a.ListContainer}.ports(), conReporter{b.ListContainer}.ports())
+	case viewContainersHostColIndex:
+		return strings.Compare(a.host, b.host)
+	}
+
+	if result := strings.Compare(conReporter{a.ListContainer}.names(), conReporter{b.ListContainer}.names()); result != 0 {
+		return result
+	}
+
+	return strings.Compare(a.host, b.host)
+}
+
 // ClearData clears table data.
 func (cnt *Containers) ClearData() {
 	cnt.containersList.mu.Lock()
@@ -51,12 +161,12 @@ func (cnt *Containers) ClearData() {
 
 	for i := 0; i < len(cnt.headers); i++ {
 		cnt.table.SetCell(0, i,
-			tview.NewTableCell(fmt.Sprintf("[::b]%s", strings.ToUpper(cnt.headers[i]))). //nolint:perfsprint
-													SetExpansion(expand).
-													SetBackgroundColor(bgColor).
-													SetTextColor(fgColor).
-													SetAlign(tview.AlignLeft).
-													SetSelectable(false))
+			tview.NewTableCell(cnt.tableSort.HeaderLabel(i, cnt.headers[i])).
+				SetExpansion(expand).
+				SetBackgroundColor(bgColor).
+				SetTextColor(fgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
 	}
 
 	cnt.table.SetTitle(fmt.Sprintf("[::b]%s[0]", strings.ToUpper(cnt.title)))
@@ -89,13 +199,31 @@ func (con conReporter) state() string {
 	return state
 }
 
-func (con conReporter) status() string {
-	hc := con.ListContainer.Status
-	if hc != "" {
-		return con.state() + " (" + hc + ")"
+// healthStatus returns the container health status (healthy, unhealthy or starting),
+// it's empty if the container does not have a healthcheck.
+func (item hostContainer) healthStatus() string {
+	return item.ListContainer.Status
+}
+
+// healthText returns the health column text, the failing streak of the unhealthy containers is displayed.
+func (item hostContainer) healthText() string {
+	status := item.healthStatus()
+
+	switch status {
+	case define.HealthCheckHealthy:
+		return fmt.Sprintf("[%s::]%s", style.GetColorHex(style.RunningStatusFgColor), status)
+	case define.HealthCheckUnhealthy:
+		if item.health != nil && item.health.FailingStreak > 0 {
+			return fmt.Sprintf("[%s::]%s (%d)", style.GetColorHex(style.StoppedStatusFgColor),
+				status, item.health.FailingStreak)
+		}
+
+		return fmt.Sprintf("[%s::]%s", style.GetColorHex(style.StoppedStatusFgColor), status)
+	case define.HealthCheckStarting:
+		return fmt.Sprintf("[%s::]%s", style.GetColorHex(style.PausedStatusFgColor), status)
 	}
 
-	return con.state()
+	return status
 }
 
 func (con conReporter) ports() string {
@@ -1,6 +1,7 @@
 package containers
 
 import (
+	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/gdamore/tcell/v2"
 )
 
@@ -12,6 +13,12 @@ func (cnt *Containers) Draw(screen tcell.Screen) { //nolint:cyclop
 
 	x, y, width, height := cnt.GetInnerRect()
 
+	if cnt.filterBar.IsVisible() {
+		height -= dialogs.FilterBarHeight
+		cnt.filterBar.SetRect(x, y+height, width, dialogs.FilterBarHeight)
+		cnt.filterBar.Draw(screen)
+	}
+
 	cnt.table.SetRect(x, y, width, height)
 	cnt.table.SetBorder(true)
 	cnt.table.Draw(screen)
@@ -126,4 +133,92 @@ func (cnt *Containers) Draw(screen tcell.Screen) { //nolint:cyclop
 
 		return
 	}
+
+	// logs dialog
+	if cnt.logsDialog.IsDisplay() {
+		cnt.logsDialog.SetRect(x, y, width, height)
+		cnt.logsDialog.Draw(screen)
+
+		return
+	}
+
+	// kube generate dialog
+	if cnt.kubeDialog.IsDisplay() {
+		cnt.kubeDialog.SetRect(x, y, width, height)
+		cnt.kubeDialog.Draw(screen)
+
+		return
+	}
+
+	// systemd generate dialog
+	if cnt.systemdDialog.IsDisplay() {
+		cnt.systemdDialog.SetRect(x, y, width, height)
+		cnt.systemdDialog.Draw(screen)
+
+		return
+	}
+
+	// copy dialog
+	if cnt.copyDialog.IsDisplay() {
+		cnt.copyDialog.SetRect(x, y, width, height)
+		cnt.copyDialog.Draw(screen)
+
+		return
+	}
+
+	// copy progress dialog
+	if cnt.copyPrgDialog.IsDisplay() {
+		cnt.copyPrgDialog.SetRect(x, y, width, height)
+		cnt.copyPrgDialog.Draw(screen)
+
+		return
+	}
+
+	// files dialog
+	if cnt.filesDialog.IsDisplay() {
+		cnt.filesDialog.SetRect(x, y, width, height)
+		cnt.filesDialog.Draw(screen)
+
+		return
+	}
+
+	// wait dialog
+	if cnt.waitDialog.IsDisplay() {
+		cnt.waitDialog.SetRect(x, y, width, height)
+		cnt.waitDialog.Draw(screen)
+
+		return
+	}
+
+	// update dialog
+	if cnt.updateDialog.IsDisplay() {
+		cnt.updateDialog.SetRect(x, y, width, height)
+		cnt.updateDialog.Draw(screen)
+
+		return
+	}
+
+	// clone dialog
+	if cnt.cloneDialog.IsDisplay() {
+		cnt.cloneDialog.SetRect(x, y, width, height)
+		cnt.cloneDialog.Draw(screen)
+
+		return
+	}
+
+	// export progress dialog
+	if cnt.exportPrgDialog.IsDisplay() {
+		cnt.exportPrgDialog.SetRect(x, y, width, height)
+		cnt.exportPrgDialog.Draw(screen)
+
+		return
+	}
+
+	// health dialog
+	if cnt.healthDialog.IsDisplay() {
+		cnt.healthDialog.SetRect(x, y, width, height)
+		cnt.healthDialog.Draw(screen)
+
+		return
+	}
 }
@@ -107,6 +107,90 @@ func (cnt *Containers) InputHandler() func(event *tcell.EventKey, setFocus func(
 			}
 		}
 
+		// container logs dialog handler
+		if cnt.logsDialog.HasFocus() {
+			if cntLogsDialogHandler := cnt.logsDialog.InputHandler(); cntLogsDialogHandler != nil {
+				cntLogsDialogHandler(event, setFocus)
+			}
+		}
+
+		// kube generate dialog handler
+		if cnt.kubeDialog.HasFocus() {
+			if kubeDialogHandler := cnt.kubeDialog.InputHandler(); kubeDialogHandler != nil {
+				kubeDialogHandler(event, setFocus)
+			}
+		}
+
+		// systemd generate dialog handler
+		if cnt.systemdDialog.HasFocus() {
+			if systemdDialogHandler := cnt.systemdDialog.InputHandler(); systemdDialogHandler != nil {
+				systemdDialogHandler(event, setFocus)
+			}
+		}
+
+		// container copy dialog handler
+		if cnt.copyDialog.HasFocus() {
+			if copyDialogHandler := cnt.copyDialog.InputHandler(); copyDialogHandler != nil {
+				copyDialogHandler(event, setFocus)
+			}
+		}
+
+		// container copy progress dialog handler
+		if cnt.copyPrgDialog.HasFocus() {
+			if copyPrgDialogHandler := cnt.copyPrgDialog.InputHandler(); copyPrgDialogHandler != nil {
+				copyPrgDialogHandler(event, setFocus)
+			}
+		}
+
+		// container files dialog handler
+		if cnt.filesDialog.HasFocus() {
+			if filesDialogHandler := cnt.filesDialog.InputHandler(); filesDialogHandler != nil {
+				filesDialogHandler(event, setFocus)
+			}
+		}
+
+		// container wait dialog handler
+		if cnt.waitDialog.HasFocus() {
+			if waitDialogHandler := cnt.waitDialog.InputHandler(); waitDialogHandler != nil {
+				waitDialogHandler(event, setFocus)
+			}
+		}
+
+		// container update dialog handler
+		if cnt.updateDialog.HasFocus() {
+			if updateDialogHandler := cnt.updateDialog.InputHandler(); updateDialogHandler != nil {
+				updateDialogHandler(event, setFocus)
+			}
+		}
+
+		// container clone dialog handler
+		if cnt.cloneDialog.HasFocus() {
+			if cloneDialogHandler := cnt.cloneDialog.InputHandler(); cloneDialogHandler != nil {
+				cloneDialogHandler(event, setFocus)
+			}
+		}
+
+		// container export progress dialog handler
+		if cnt.exportPrgDialog.HasFocus() {
+			if exportPrgDialogHandler := cnt.exportPrgDialog.InputHandler(); exportPrgDialogHandler != nil {
+				exportPrgDialogHandler(event, setFocus)
+			}
+		}
+
+		// container health dialog handler
+		if cnt.healthDialog.HasFocus() {
+			if healthDialogHandler := cnt.healthDialog.InputHandler(); healthDialogHandler != nil {
+				healthDialogHandler(event, setFocus)
+			}
+		}
+
+		// filter bar handler
+		if cnt.filterBar.HasFocus() {
+			if filterBarHandler := cnt.filterBar.InputHandler(); filterBarHandler != nil {
+				filterBarHandler(event, setFocus)
+			}
+		}
+
 		// table handlers
 		if cnt.table.HasFocus() { //nolint:nestif
 			cnt.selectedID, cnt.selectedName = cnt.getSelectedItem()
@@ -122,7 +206,58 @@ func (cnt *Containers) InputHandler() func(event *tcell.EventKey, setFocus func(
 			}
 
 			if event.Key() == utils.DeleteKey.EventKey() {
-				cnt.rm()
+				if cnt.selectedOnActiveHost() {
+					cnt.rm()
+				}
+
+				setFocus(cnt)
+
+				return
+			}
+
+			if event.Rune() == utils.MarkItemKey.Rune() {
+				cnt.markItem()
+
+				return
+			}
+
+			if event.Key() == utils.MarkAllKey.EventKey() {
+				cnt.markedItems.ToggleAll(cnt.getItems())
+
+				return
+			}
+
+			if event.Rune() == utils.MarkPatternKey.Rune() {
+				cnt.markPattern()
+				setFocus(cnt)
+
+				return
+			}
+
+			if event.Rune() == utils.FilterKey.Rune() {
+				cnt.filterBar.Display()
+				setFocus(cnt)
+
+				return
+			}
+
+			if event.Rune() == utils.SortColumnKey.Rune() {
+				cnt.tableSort.Next()
+
+				return
+			}
+
+			if event.Rune() == utils.SortOrderKey.Rune() {
+				cnt.tableSort.Reverse()
+
+				return
+			}
+
+			if event.Rune() == utils.RestartKey.Rune() {
+				if cnt.selectedOnActiveHost() {
+					cnt.restart(true)
+				}
+
 				setFocus(cnt)
 
 				return
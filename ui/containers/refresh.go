@@ -20,18 +20,19 @@ func (cnt *Containers) refresh() {
 
 	for i := 0; i < len(cnt.headers); i++ {
 		cnt.table.SetCell(0, i,
-			tview.NewTableCell(fmt.Sprintf("[::b]%s", strings.ToUpper(cnt.headers[i]))). //nolint:perfsprint
-													SetExpansion(expand).
-													SetBackgroundColor(style.PageHeaderBgColor).
-													SetTextColor(style.PageHeaderFgColor).
-													SetAlign(tview.AlignLeft).
-													SetSelectable(false))
+			tview.NewTableCell(cnt.tableSort.HeaderLabel(i, cnt.headers[i])).
+				SetExpansion(expand).
+				SetBackgroundColor(style.PageHeaderBgColor).
+				SetTextColor(style.PageHeaderFgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
 	}
 
 	rowIndex := 1
 	cntList := cnt.getData()
+	filter := cnt.filterBar.GetFilter()
 
-	cnt.table.SetTitle(fmt.Sprintf("[::b]%s[%d]", strings.ToUpper(cnt.title), len(cntList)))
+	markIDs := make([]string, 0, len(cntList))
 
 	for i := 0; i < len(cntList); i++ {
 		cntID := cntList[i].ID
@@ -42,9 +43,26 @@ func (cnt *Containers) refresh() {
 		cntImage := cntList[i].Image
 		cntPodName := cntList[i].PodName
 		cntCreated := units.HumanDuration(time.Since(cntList[i].Created)) + " ago"
-		cntStatus := conReporter{cntList[i]}.status()
-		cntPorts := conReporter{cntList[i]}.ports()
-		cntNames := conReporter{cntList[i]}.names()
+		cntStatus := conReporter{cntList[i].ListContainer}.state()
+		cntHealth := cntList[i].healthStatus()
+		cntPorts := conReporter{cntList[i].ListContainer}.ports()
+		cntNames := conReporter{cntList[i].ListContainer}.names()
+		cntHost := cntList[i].host
+
+		markIDs = append(markIDs, utils.MarkedItemKey(cntList[i].host, cntID))
+
+		if !utils.MatchHostFilter(filter, cntHost) {
+			continue
+		}
+
+		if !utils.MatchHealthFilter(filter, cntHealth) {
+			continue
+		}
+
+		if !utils.MatchFilter(filter, cntList[i].Labels,
+			cntList[i].ID, cntNames, cntImage, cntPodName, cntStatus, cntList[i].State, cntHealth, cntHost) {
+			continue
+		}
 
 		var cellTextColor tcell.Color
 
@@ -52,13 +70,13 @@ func (cnt *Containers) refresh() {
 
 		switch cntShortStatus {
 		case "up":
-			cntStatus = fmt.Sprintf("[green::]%s[-::] %s", "\u25B2", cntStatus)
+			cntStatus = fmt.Sprintf("[%s::]%s[-::] %s", style.GetColorHex(style.RunningStatusFgColor), "\u25B2", cntStatus)
 			cellTextColor = style.RunningStatusFgColor
 		case "paused":
-			cntStatus = fmt.Sprintf("[red::]%s[-::] %s", "\u25BC", cntStatus)
+			cntStatus = fmt.Sprintf("[%s::]%s[-::] %s", style.GetColorHex(style.StoppedStatusFgColor), "\u25BC", cntStatus)
 			cellTextColor = style.PausedStatusFgColor
 		default:
-			cntStatus = fmt.Sprintf("[red::]%s[-::] %s", "\u25BC", cntStatus)
+			cntStatus = fmt.Sprintf("[%s::]%s[-::] %s", style.GetColorHex(style.StoppedStatusFgColor), "\u25BC", cntStatus)
 			cellTextColor = style.FgColor
 		}
 
@@ -97,6 +115,13 @@ func (cnt *Containers) refresh() {
 				SetExpansion(expand).
 				SetAlign(alignment))
 
+		// health column
+		cnt.table.SetCell(rowIndex, viewContainersHealthColIndex,
+			tview.NewTableCell(cntList[i].healthText()).
+				SetTextColor(cellTextColor).
+				SetExpansion(expand).
+				SetAlign(alignment))
+
 		// names column
 		cnt.table.SetCell(rowIndex, viewContainersNamesColIndex,
 			tview.NewTableCell(cntNames).
@@ -111,6 +136,17 @@ func (cnt *Containers) refresh() {
 				SetExpansion(expand).
 				SetAlign(alignment))
 
+		// host column
+		cnt.table.SetCell(rowIndex, viewContainersHostColIndex,
+			tview.NewTableCell(cntHost).
+				SetTextColor(cellTextColor).
+				SetExpansion(expand).
+				SetAlign(alignment))
+
 		rowIndex++
 	}
+
+	cnt.markedItems.Retain(markIDs)
+	cnt.markedItems.HighlightHostMarkedRows(cnt.table, viewContainersIDColIndex, viewContainersHostColIndex)
+	cnt.table.SetTitle(utils.TableTitle(cnt.title, len(cntList), rowIndex-1, filter, cnt.markedItems.Count()))
 }
@@ -0,0 +1,121 @@
+package dialogs
+
+import (
+	"strings"
+
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// FilterBarHeight filter bar height.
+	FilterBarHeight = 1
+)
+
+// FilterBar is a table filter input bar primitive.
+type FilterBar struct {
+	*tview.Box
+	input   *tview.InputField
+	display bool
+}
+
+// NewFilterBar returns new filter bar primitive.
+func NewFilterBar() *FilterBar {
+	bar := &FilterBar{
+		Box:   tview.NewBox(),
+		input: tview.NewInputField(),
+	}
+
+	bar.input.SetLabel("[::b]FILTER:[::-] ")
+	bar.input.SetLabelColor(style.FgColor)
+	bar.input.SetBackgroundColor(style.BgColor)
+	bar.input.SetFieldBackgroundColor(style.InputFieldBgColor)
+	bar.input.SetPlaceholder("name, id, image, status or label key=value")
+	bar.input.SetPlaceholderTextColor(style.InfoBarItemFgColor)
+
+	return bar
+}
+
+// Display displays this primitive and starts editing the filter.
+func (f *FilterBar) Display() {
+	f.display = true
+}
+
+// IsDisplay returns true if the filter is being edited.
+func (f *FilterBar) IsDisplay() bool {
+	return f.display
+}
+
+// Hide stops editing the filter, the filter text is kept.
+func (f *FilterBar) Hide() {
+	f.display = false
+}
+
+// IsVisible returns true if the filter is being edited or is set.
+func (f *FilterBar) IsVisible() bool {
+	return f.display || f.GetFilter() != ""
+}
+
+// GetFilter returns the filter text.
+func (f *FilterBar) GetFilter() string {
+	return strings.TrimSpace(f.input.GetText())
+}
+
+// SetFilter sets the filter text.
+func (f *FilterBar) SetFilter(filter string) {
+	f.input.SetText(filter)
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (f *FilterBar) HasFocus() bool {
+	return f.input.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (f *FilterBar) Focus(delegate func(p tview.Primitive)) {
+	delegate(f.input)
+}
+
+// InputHandler returns input handler function for this primitive.
+func (f *FilterBar) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return f.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("filter bar: event %v received", event)
+
+		switch event.Key() { //nolint:exhaustive
+		case tcell.KeyEsc:
+			f.input.SetText("")
+			f.Hide()
+
+			return
+		case tcell.KeyEnter, tcell.KeyTab, tcell.KeyUp, tcell.KeyDown:
+			f.Hide()
+
+			return
+		}
+
+		if inputHandler := f.input.InputHandler(); inputHandler != nil {
+			inputHandler(event, setFocus)
+		}
+	})
+}
+
+// SetRect set rects for this primitive.
+func (f *FilterBar) SetRect(x, y, width, height int) {
+	f.Box.SetRect(x, y, width, height)
+}
+
+// Draw draws this primitive onto the screen.
+func (f *FilterBar) Draw(screen tcell.Screen) {
+	if !f.IsVisible() {
+		return
+	}
+
+	f.Box.DrawForSubclass(screen, f)
+
+	x, y, width, _ := f.Box.GetInnerRect()
+
+	f.input.SetRect(x, y, width, FilterBarHeight)
+	f.input.Draw(screen)
+}
@@ -0,0 +1,54 @@
+package dialogs
+
+import (
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("filter bar", Ordered, func() {
+	var filterBar *FilterBar
+	var setFocus func(p tview.Primitive)
+
+	BeforeAll(func() {
+		filterBar = NewFilterBar()
+		setFocus = func(p tview.Primitive) {}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+	})
+
+	It("display", func() {
+		Expect(filterBar.IsVisible()).To(Equal(false))
+		filterBar.Display()
+		Expect(filterBar.IsDisplay()).To(Equal(true))
+		Expect(filterBar.IsVisible()).To(Equal(true))
+	})
+
+	It("set and get filter", func() {
+		filterBar.SetFilter(" web ")
+		Expect(filterBar.GetFilter()).To(Equal("web"))
+	})
+
+	It("enter keeps the filter", func() {
+		filterBar.InputHandler()(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone), setFocus)
+		Expect(filterBar.IsDisplay()).To(Equal(false))
+		Expect(filterBar.IsVisible()).To(Equal(true))
+		Expect(filterBar.GetFilter()).To(Equal("web"))
+	})
+
+	It("input handler", func() {
+		filterBar.Display()
+		filterBar.SetFilter("")
+		filterBar.InputHandler()(tcell.NewEventKey(tcell.KeyRune, 'd', tcell.ModNone), setFocus)
+		filterBar.InputHandler()(tcell.NewEventKey(tcell.KeyRune, 'b', tcell.ModNone), setFocus)
+		Expect(filterBar.GetFilter()).To(Equal("db"))
+	})
+
+	It("esc clears the filter", func() {
+		filterBar.InputHandler()(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone), setFocus)
+		Expect(filterBar.IsDisplay()).To(Equal(false))
+		Expect(filterBar.IsVisible()).To(Equal(false))
+		Expect(filterBar.GetFilter()).To(Equal(""))
+	})
+})
@@ -0,0 +1,300 @@
+package dialogs
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	kubeDialogMaxWidth  = 120
+	kubeDialogMaxHeight = 40
+)
+
+const (
+	kubeYAMLFocus = 0 + iota
+	kubeOutputFocus
+	kubeFormFocus
+)
+
+var errEmptyKubeOutputPath = errors.New("empty output file path")
+
+// KubeGenerateDialog is a dialog primitive to display and save the generated kubernetes YAML.
+type KubeGenerateDialog struct {
+	*tview.Box
+	layout        *tview.Flex
+	infoType      *tview.InputField
+	textview      *tview.TextView
+	output        *tview.InputField
+	form          *tview.Form
+	display       bool
+	kubeYAML      string
+	focusElement  int
+	saveHandler   func()
+	cancelHandler func()
+}
+
+// NewKubeGenerateDialog returns new kube generate dialog primitive.
+func NewKubeGenerateDialog() *KubeGenerateDialog {
+	dialog := &KubeGenerateDialog{
+		Box:      tview.NewBox(),
+		infoType: tview.NewInputField(),
+		output:   tview.NewInputField(),
+		display:  false,
+	}
+
+	bgColor := style.DialogBgColor
+
+	dialog.infoType.SetBackgroundColor(bgColor)
+	dialog.infoType.SetFieldBackgroundColor(bgColor)
+	dialog.infoType.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(style.DialogFgColor))
+
+	dialog.textview = tview.NewTextView().
+		SetDynamicColors(false).
+		SetWrap(false).
+		SetTextAlign(tview.AlignLeft)
+
+	dialog.textview.SetTextColor(style.FgColor)
+	dialog.textview.SetBackgroundColor(style.BgColor)
+	dialog.textview.SetBorder(true)
+	dialog.textview.SetBorderColor(style.DialogSubBoxBorderColor)
+
+	// output input field
+	outputLabel := "save to:"
+
+	dialog.output.SetBackgroundColor(bgColor)
+	dialog.output.SetLabelColor(style.DialogFgColor)
+	dialog.output.SetLabel(outputLabel)
+	dialog.output.SetLabelWidth(len(outputLabel) + 1)
+	dialog.output.SetFieldBackgroundColor(style.InputFieldBgColor)
+
+	// textview layout
+	tlayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	tlayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	tlayout.AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(dialog.infoType, 1, 0, false).
+		AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false).
+		AddItem(dialog.textview, 0, 1, true).
+		AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false).
+		AddItem(dialog.output, 1, 0, false),
+		0, 1, true)
+	tlayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.form = tview.NewForm().
+		AddButton("Cancel", nil).
+		AddButton("Save", nil).
+		SetButtonsAlign(tview.AlignRight)
+
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	dialog.layout = tview.NewFlex().SetDirection(tview.FlexRow)
+	dialog.layout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, true)
+	dialog.layout.AddItem(tlayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, DialogFormHeight, 0, true)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetTitle("PODMAN GENERATE KUBE")
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *KubeGenerateDialog) Display() {
+	d.focusElement = kubeYAMLFocus
+	d.display = true
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *KubeGenerateDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *KubeGenerateDialog) Hide() {
+	d.kubeYAML = ""
+	d.textview.SetText("")
+	d.output.SetText("")
+	d.focusElement = kubeYAMLFocus
+	d.display = false
+}
+
+// SetKubeYAML sets the generated kubernetes YAML and its resource (header) info.
+func (d *KubeGenerateDialog) SetKubeYAML(headerType messageInfo, headerMessage string, kubeYAML string) {
+	msgTypeLabel := ""
+
+	switch headerType {
+	case MessagePodInfo:
+		msgTypeLabel = "POD ID:"
+	case MessageContainerInfo:
+		msgTypeLabel = "CONTAINER ID:"
+	case MessageVolumeInfo:
+		msgTypeLabel = "VOLUME NAME:"
+	}
+
+	d.infoType.SetLabel("[::b]" + msgTypeLabel)
+	d.infoType.SetLabelWidth(len(msgTypeLabel) + 1)
+	d.infoType.SetText(headerMessage)
+
+	d.kubeYAML = kubeYAML
+	d.textview.SetText(kubeYAML)
+	d.textview.ScrollToBeginning()
+}
+
+// GetKubeYAML returns the generated kubernetes YAML.
+func (d *KubeGenerateDialog) GetKubeYAML() string {
+	return d.kubeYAML
+}
+
+// GetOutputPath returns the output file path to save the kubernetes YAML.
+func (d *KubeGenerateDialog) GetOutputPath() (string, error) {
+	path := strings.TrimSpace(d.output.GetText())
+	if path == "" {
+		return "", errEmptyKubeOutputPath
+	}
+
+	return utils.ResolveHomeDir(path)
+}
+
+// Focus is called when this primitive receives focus.
+func (d *KubeGenerateDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case kubeYAMLFocus:
+		delegate(d.textview)
+	case kubeOutputFocus:
+		delegate(d.output)
+	case kubeFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = kubeYAMLFocus
+				d.Focus(delegate)
+				d.form.SetFocus(0)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *KubeGenerateDialog) HasFocus() bool {
+	if d.textview.HasFocus() || d.output.HasFocus() {
+		return true
+	}
+
+	return d.form.HasFocus() || d.Box.HasFocus()
+}
+
+// SetRect set rects for this primitive.
+func (d *KubeGenerateDialog) SetRect(x, y, width, height int) {
+	dX := x + DialogPadding
+	dY := y + DialogPadding - 1
+	dWidth := width - (2 * DialogPadding)   //nolint:gomnd
+	dHeight := height - (2 * DialogPadding) //nolint:gomnd
+
+	if dWidth > kubeDialogMaxWidth {
+		dX = x + ((width - kubeDialogMaxWidth) / 2) //nolint:gomnd
+		dWidth = kubeDialogMaxWidth
+	}
+
+	if dHeight > kubeDialogMaxHeight {
+		dY = y + ((height - kubeDialogMaxHeight) / 2) //nolint:gomnd
+		dHeight = kubeDialogMaxHeight
+	}
+
+	d.Box.SetRect(dX, dY, dWidth, dHeight)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *KubeGenerateDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.Box.DrawForSubclass(screen, d)
+	x, y, width, height := d.Box.GetInnerRect()
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *KubeGenerateDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("kube generate dialog: event %v received", event)
+
+		if event.Key() == tcell.KeyEsc {
+			d.cancelHandler()
+
+			return
+		}
+
+		if event.Key() == utils.SwitchFocusKey.Key {
+			d.setFocusElement()
+		}
+
+		// scroll between kube YAML textview
+		if d.textview.HasFocus() {
+			if textHandler := d.textview.InputHandler(); textHandler != nil {
+				textHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.output.HasFocus() {
+			if outputHandler := d.output.InputHandler(); outputHandler != nil {
+				outputHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+func (d *KubeGenerateDialog) setFocusElement() {
+	switch d.focusElement {
+	case kubeYAMLFocus:
+		d.focusElement = kubeOutputFocus
+	case kubeOutputFocus:
+		d.focusElement = kubeFormFocus
+	}
+}
+
+// SetSaveFunc sets form save button selected function.
+func (d *KubeGenerateDialog) SetSaveFunc(handler func()) *KubeGenerateDialog {
+	d.saveHandler = handler
+	saveButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+	saveButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *KubeGenerateDialog) SetCancelFunc(handler func()) *KubeGenerateDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 2) //nolint:gomnd
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
@@ -0,0 +1,111 @@
+package dialogs
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("kube generate dialog", Ordered, func() {
+	var kubeDialogApp *tview.Application
+	var kubeDialogScreen tcell.SimulationScreen
+	var kubeDialog *KubeGenerateDialog
+	var kubeYAML string = "apiVersion: v1\nkind: Pod\nmetadata:\n  name: test\n"
+	var runApp func()
+
+	BeforeAll(func() {
+		kubeDialogApp = tview.NewApplication()
+		kubeDialog = NewKubeGenerateDialog()
+		kubeDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := kubeDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := kubeDialogApp.SetScreen(kubeDialogScreen).SetRoot(kubeDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		kubeDialog.Display()
+		Expect(kubeDialog.IsDisplay()).To(Equal(true))
+	})
+
+	It("set focus", func() {
+		kubeDialogApp.SetFocus(kubeDialog)
+		Expect(kubeDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("set kube yaml", func() {
+		kubeDialog.SetKubeYAML(MessagePodInfo, "ID (NAME)", kubeYAML)
+		Expect(kubeDialog.GetKubeYAML()).To(Equal(kubeYAML))
+		Expect(kubeDialog.textview.GetText(true)).To(ContainSubstring("kind: Pod"))
+		Expect(kubeDialog.infoType.GetText()).To(Equal("ID (NAME)"))
+	})
+
+	It("empty output path", func() {
+		_, err := kubeDialog.GetOutputPath()
+		Expect(err).To(Equal(errEmptyKubeOutputPath))
+	})
+
+	It("set output path", func() {
+		output := filepath.Join(os.TempDir(), "pod.yaml")
+		kubeDialog.output.SetText(output)
+
+		path, err := kubeDialog.GetOutputPath()
+		Expect(err).To(BeNil())
+		Expect(path).To(Equal(output))
+	})
+
+	It("save button selected", func() {
+		saveButton := "initial"
+		saveButtonWants := "save selected"
+		saveFunc := func() {
+			saveButton = saveButtonWants
+		}
+		kubeDialog.Hide()
+		kubeDialogApp.Draw()
+		kubeDialog.SetSaveFunc(saveFunc)
+		kubeDialog.Display()
+		kubeDialogApp.Draw()
+		kubeDialogApp.SetFocus(kubeDialog.form)
+		kubeDialogApp.Draw()
+		kubeDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		kubeDialogApp.Draw()
+		kubeDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		kubeDialogApp.Draw()
+		Expect(saveButton).To(Equal(saveButtonWants))
+	})
+
+	It("cancel button selected", func() {
+		cancelButton := "initial"
+		cancelButtonWants := "cancel selected"
+		cancelFunc := func() {
+			cancelButton = cancelButtonWants
+		}
+		kubeDialog.SetCancelFunc(cancelFunc)
+		kubeDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone))
+		kubeDialogApp.Draw()
+		Expect(cancelButton).To(Equal(cancelButtonWants))
+	})
+
+	It("hide", func() {
+		kubeDialog.Hide()
+		Expect(kubeDialog.IsDisplay()).To(Equal(false))
+		Expect(kubeDialog.GetKubeYAML()).To(Equal(""))
+		Expect(kubeDialog.textview.GetText(true)).To(Equal(""))
+	})
+
+	AfterAll(func() {
+		kubeDialogApp.Stop()
+	})
+})
@@ -33,6 +33,8 @@ const (
 	MessageImageInfo
 	MessageNetworkInfo
 	MessageSecretInfo
+	MessageBulkCommandInfo
+	MessageKubeInfo
 )
 
 // NewMessageDialog returns new message dialog primitive.
@@ -129,6 +131,10 @@ func (d *MessageDialog) SetText(headerType messageInfo, headerMessage string, me
 		msgTypeLabel = "NETWORK ID:"
 	case MessageSecretInfo:
 		msgTypeLabel = "SECRET ID:"
+	case MessageBulkCommandInfo:
+		msgTypeLabel = "BULK COMMAND:"
+	case MessageKubeInfo:
+		msgTypeLabel = "YAML FILE:"
 	}
 
 	if msgTypeLabel != "" {
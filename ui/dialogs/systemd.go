@@ -0,0 +1,498 @@
+package dialogs
+
+import (
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/systemd"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	systemdDialogMaxWidth  = 120
+	systemdDialogMaxHeight = 40
+)
+
+const (
+	systemdFormatFocus = 0 + iota
+	systemdRestartFocus
+	systemdWantsFocus
+	systemdAfterFocus
+	systemdRequiresFocus
+	systemdDirFocus
+	systemdPreviewFocus
+	systemdFormFocus
+)
+
+// SystemdGenerateDialog is a dialog primitive to generate, preview and write
+// the quadlet or systemd unit files of a container or pod.
+type SystemdGenerateDialog struct {
+	*tview.Box
+	layout         *tview.Flex
+	infoType       *tview.InputField
+	format         *tview.DropDown
+	restartPolicy  *tview.DropDown
+	wants          *tview.InputField
+	after          *tview.InputField
+	requires       *tview.InputField
+	dir            *tview.InputField
+	preview        *tview.TextView
+	form           *tview.Form
+	display        bool
+	focusElement   int
+	previewHandler func()
+	writeHandler   func()
+	cancelHandler  func()
+}
+
+// NewSystemdGenerateDialog returns new systemd generate dialog primitive.
+func NewSystemdGenerateDialog() *SystemdGenerateDialog {
+	dialog := &SystemdGenerateDialog{
+		Box:           tview.NewBox(),
+		layout:        tview.NewFlex().SetDirection(tview.FlexRow),
+		infoType:      tview.NewInputField(),
+		format:        tview.NewDropDown(),
+		restartPolicy: tview.NewDropDown(),
+		wants:         tview.NewInputField(),
+		after:         tview.NewInputField(),
+		requires:      tview.NewInputField(),
+		dir:           tview.NewInputField(),
+		preview:       tview.NewTextView(),
+		form:          tview.NewForm(),
+	}
+
+	bgColor := style.DialogBgColor
+	fgColor := style.DialogFgColor
+	inputFieldBgColor := style.InputFieldBgColor
+	ddUnselectedStyle := style.DropDownUnselected
+	ddselectedStyle := style.DropDownSelected
+	labelWidth := 10
+
+	// info type field
+	dialog.infoType.SetBackgroundColor(bgColor)
+	dialog.infoType.SetFieldBackgroundColor(bgColor)
+	dialog.infoType.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(fgColor))
+
+	// format dropdown
+	dialog.format.SetLabel("format:")
+	dialog.format.SetLabelColor(fgColor)
+	dialog.format.SetLabelWidth(labelWidth)
+	dialog.format.SetBackgroundColor(bgColor)
+	dialog.format.SetOptions(systemd.Formats(), func(text string, index int) { //nolint:revive
+		dialog.dir.SetText(systemd.DefaultDir(text))
+		dialog.updatePreview()
+	})
+	dialog.format.SetListStyles(ddUnselectedStyle, ddselectedStyle)
+	dialog.format.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// restart policy dropdown
+	restartLabel := "restart policy:"
+
+	dialog.restartPolicy.SetLabel(restartLabel)
+	dialog.restartPolicy.SetLabelColor(fgColor)
+	dialog.restartPolicy.SetLabelWidth(len(restartLabel) + 1)
+	dialog.restartPolicy.SetBackgroundColor(bgColor)
+	dialog.restartPolicy.SetOptions(systemd.RestartPolicies(), func(text string, index int) { //nolint:revive
+		dialog.updatePreview()
+	})
+	dialog.restartPolicy.SetListStyles(ddUnselectedStyle, ddselectedStyle)
+	dialog.restartPolicy.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// dependencies input fields
+	for _, field := range []struct {
+		input *tview.InputField
+		label string
+	}{
+		{dialog.wants, "wants:"},
+		{dialog.after, "after:"},
+		{dialog.requires, "requires:"},
+	} {
+		field.input.SetBackgroundColor(bgColor)
+		field.input.SetLabelColor(fgColor)
+		field.input.SetLabel(field.label)
+		field.input.SetLabelWidth(labelWidth)
+		field.input.SetFieldBackgroundColor(inputFieldBgColor)
+		field.input.SetPlaceholder("space separated unit names")
+		field.input.SetPlaceholderTextColor(style.InfoBarItemFgColor)
+		field.input.SetDoneFunc(func(key tcell.Key) { //nolint:revive
+			dialog.updatePreview()
+		})
+	}
+
+	// directory input field
+	dialog.dir.SetBackgroundColor(bgColor)
+	dialog.dir.SetLabelColor(fgColor)
+	dialog.dir.SetLabel("directory:")
+	dialog.dir.SetLabelWidth(labelWidth + 1)
+	dialog.dir.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// preview textview
+	dialog.preview.SetDynamicColors(false)
+	dialog.preview.SetWrap(false)
+	dialog.preview.SetTextColor(style.FgColor)
+	dialog.preview.SetBackgroundColor(style.BgColor)
+	dialog.preview.SetBorder(true)
+	dialog.preview.SetBorderColor(style.DialogSubBoxBorderColor)
+	dialog.preview.SetTitle("preview")
+
+	// form
+	dialog.form.AddButton("Cancel", nil)
+	dialog.form.AddButton("Write", nil)
+	dialog.form.SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	ddLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	ddLayout.AddItem(dialog.format, labelWidth+10, 0, true)               //nolint:gomnd
+	ddLayout.AddItem(utils.EmptyBoxSpace(bgColor), 2, 0, false)           //nolint:gomnd
+	ddLayout.AddItem(dialog.restartPolicy, len(restartLabel)+14, 0, true) //nolint:gomnd
+	ddLayout.AddItem(utils.EmptyBoxSpace(bgColor), 0, 1, false)
+
+	optionsLayout := tview.NewFlex().SetDirection(tview.FlexRow)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(dialog.infoType, 1, 0, false)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(ddLayout, 1, 0, true)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(dialog.wants, 1, 0, true)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(dialog.after, 1, 0, true)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(dialog.requires, 1, 0, true)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(dialog.dir, 1, 0, true)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(dialog.preview, 0, 1, true)
+
+	mainLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainLayout.AddItem(optionsLayout, 0, 1, true)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetTitle("PODMAN GENERATE SYSTEMD")
+	dialog.layout.AddItem(mainLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, DialogFormHeight, 0, true)
+
+	dialog.Hide()
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *SystemdGenerateDialog) Display() {
+	d.focusElement = systemdFormatFocus
+	d.display = true
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *SystemdGenerateDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *SystemdGenerateDialog) Hide() {
+	d.display = false
+	d.focusElement = systemdFormatFocus
+
+	d.infoType.SetText("")
+	d.format.SetCurrentOption(0)
+	d.restartPolicy.SetCurrentOption(restartPolicyIndex(systemd.DefaultRestartPolicy))
+	d.wants.SetText("")
+	d.after.SetText("")
+	d.requires.SetText("")
+	d.dir.SetText(systemd.DefaultDir(systemd.FormatQuadlet))
+	d.preview.SetText("")
+}
+
+// SetUnitInfo sets the container or pod (header) info.
+func (d *SystemdGenerateDialog) SetUnitInfo(headerType messageInfo, headerMessage string) {
+	msgTypeLabel := ""
+
+	switch headerType {
+	case MessagePodInfo:
+		msgTypeLabel = "POD ID:"
+	case MessageContainerInfo:
+		msgTypeLabel = "CONTAINER ID:"
+	}
+
+	d.infoType.SetLabel("[::b]" + msgTypeLabel)
+	d.infoType.SetLabelWidth(len(msgTypeLabel) + 1)
+	d.infoType.SetText(headerMessage)
+}
+
+// SetPreview sets the generated unit files preview.
+func (d *SystemdGenerateDialog) SetPreview(preview string) {
+	d.preview.SetText(preview)
+	d.preview.ScrollToBeginning()
+}
+
+// GetUnitOptions returns the unit files generate options.
+func (d *SystemdGenerateDialog) GetUnitOptions() systemd.UnitOptions {
+	_, format := d.format.GetCurrentOption()
+	_, restartPolicy := d.restartPolicy.GetCurrentOption()
+
+	return systemd.UnitOptions{
+		Format:        format,
+		RestartPolicy: restartPolicy,
+		Wants:         strings.Fields(d.wants.GetText()),
+		After:         strings.Fields(d.after.GetText()),
+		Requires:      strings.Fields(d.requires.GetText()),
+	}
+}
+
+// GetUnitDir returns the unit files directory.
+func (d *SystemdGenerateDialog) GetUnitDir() (string, error) {
+	dir := strings.TrimSpace(d.dir.GetText())
+	if dir == "" {
+		return "", systemd.ErrEmptyUnitDir
+	}
+
+	return utils.ResolveHomeDir(dir)
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *SystemdGenerateDialog) HasFocus() bool {
+	if d.format.HasFocus() || d.restartPolicy.HasFocus() {
+		return true
+	}
+
+	if d.wants.HasFocus() || d.after.HasFocus() {
+		return true
+	}
+
+	if d.requires.HasFocus() || d.dir.HasFocus() {
+		return true
+	}
+
+	if d.preview.HasFocus() || d.form.HasFocus() {
+		return true
+	}
+
+	return d.layout.HasFocus() || d.Box.HasFocus()
+}
+
+// dropdownHasFocus returns true if systemd generate dialog dropdown primitives.
+// has focus.
+func (d *SystemdGenerateDialog) dropdownHasFocus() bool {
+	return d.format.HasFocus() || d.restartPolicy.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *SystemdGenerateDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case systemdFormatFocus:
+		delegate(d.format)
+	case systemdRestartFocus:
+		delegate(d.restartPolicy)
+	case systemdWantsFocus:
+		delegate(d.wants)
+	case systemdAfterFocus:
+		delegate(d.after)
+	case systemdRequiresFocus:
+		delegate(d.requires)
+	case systemdDirFocus:
+		delegate(d.dir)
+	case systemdPreviewFocus:
+		delegate(d.preview)
+	case systemdFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = systemdFormatFocus
+				d.Focus(delegate)
+				d.form.SetFocus(0)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *SystemdGenerateDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) { //nolint:gocognit,lll,cyclop
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("systemd generate dialog: event %v received", event)
+
+		if event.Key() == tcell.KeyEsc && !d.dropdownHasFocus() {
+			d.cancelHandler()
+
+			return
+		}
+
+		if event.Key() == utils.SwitchFocusKey.Key {
+			d.setFocusElement()
+		}
+
+		if d.format.HasFocus() {
+			if formatHandler := d.format.InputHandler(); formatHandler != nil {
+				event = utils.ParseKeyEventKey(event)
+				formatHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.restartPolicy.HasFocus() {
+			if restartPolicyHandler := d.restartPolicy.InputHandler(); restartPolicyHandler != nil {
+				event = utils.ParseKeyEventKey(event)
+				restartPolicyHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.wants.HasFocus() {
+			if wantsHandler := d.wants.InputHandler(); wantsHandler != nil {
+				wantsHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.after.HasFocus() {
+			if afterHandler := d.after.InputHandler(); afterHandler != nil {
+				afterHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.requires.HasFocus() {
+			if requiresHandler := d.requires.InputHandler(); requiresHandler != nil {
+				requiresHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.dir.HasFocus() {
+			if dirHandler := d.dir.InputHandler(); dirHandler != nil {
+				dirHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		// scroll between unit files preview
+		if d.preview.HasFocus() {
+			if previewHandler := d.preview.InputHandler(); previewHandler != nil {
+				previewHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+func (d *SystemdGenerateDialog) setFocusElement() {
+	switch d.focusElement {
+	case systemdFormatFocus:
+		d.focusElement = systemdRestartFocus
+	case systemdRestartFocus:
+		d.focusElement = systemdWantsFocus
+	case systemdWantsFocus:
+		d.focusElement = systemdAfterFocus
+	case systemdAfterFocus:
+		d.focusElement = systemdRequiresFocus
+	case systemdRequiresFocus:
+		d.focusElement = systemdDirFocus
+	case systemdDirFocus:
+		d.focusElement = systemdPreviewFocus
+	case systemdPreviewFocus:
+		d.focusElement = systemdFormFocus
+	}
+}
+
+// SetRect set rects for this primitive.
+func (d *SystemdGenerateDialog) SetRect(x, y, width, height int) {
+	dX := x + DialogPadding
+	dY := y + DialogPadding - 1
+	dWidth := width - (2 * DialogPadding)   //nolint:gomnd
+	dHeight := height - (2 * DialogPadding) //nolint:gomnd
+
+	if dWidth > systemdDialogMaxWidth {
+		dX = x + ((width - systemdDialogMaxWidth) / 2) //nolint:gomnd
+		dWidth = systemdDialogMaxWidth
+	}
+
+	if dHeight > systemdDialogMaxHeight {
+		dY = y + ((height - systemdDialogMaxHeight) / 2) //nolint:gomnd
+		dHeight = systemdDialogMaxHeight
+	}
+
+	d.Box.SetRect(dX, dY, dWidth, dHeight)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *SystemdGenerateDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.Box.DrawForSubclass(screen, d)
+	x, y, width, height := d.Box.GetInnerRect()
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetPreviewFunc sets the unit files preview function, it's called when the options are changed.
+func (d *SystemdGenerateDialog) SetPreviewFunc(handler func()) *SystemdGenerateDialog {
+	d.previewHandler = handler
+
+	return d
+}
+
+// SetWriteFunc sets form write button selected function.
+func (d *SystemdGenerateDialog) SetWriteFunc(handler func()) *SystemdGenerateDialog {
+	d.writeHandler = handler
+	writeButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+	writeButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *SystemdGenerateDialog) SetCancelFunc(handler func()) *SystemdGenerateDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 2) //nolint:gomnd
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+func (d *SystemdGenerateDialog) updatePreview() {
+	if d.display && d.previewHandler != nil {
+		d.previewHandler()
+	}
+}
+
+func restartPolicyIndex(policy string) int {
+	for index, item := range systemd.RestartPolicies() {
+		if item == policy {
+			return index
+		}
+	}
+
+	return 0
+}
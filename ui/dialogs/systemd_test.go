@@ -0,0 +1,143 @@
+package dialogs
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/containers/podman-tui/pdcs/systemd"
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("systemd generate dialog", Ordered, func() {
+	var systemdDialogApp *tview.Application
+	var systemdDialogScreen tcell.SimulationScreen
+	var systemdDialog *SystemdGenerateDialog
+	var runApp func()
+
+	BeforeAll(func() {
+		systemdDialogApp = tview.NewApplication()
+		systemdDialog = NewSystemdGenerateDialog()
+		systemdDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := systemdDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := systemdDialogApp.SetScreen(systemdDialogScreen).SetRoot(systemdDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		systemdDialog.Display()
+		Expect(systemdDialog.IsDisplay()).To(Equal(true))
+	})
+
+	It("set focus", func() {
+		systemdDialogApp.SetFocus(systemdDialog)
+		Expect(systemdDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("set unit info", func() {
+		systemdDialog.SetUnitInfo(MessageContainerInfo, "ID (NAME)")
+		Expect(systemdDialog.infoType.GetText()).To(Equal("ID (NAME)"))
+	})
+
+	It("default unit options", func() {
+		opts := systemdDialog.GetUnitOptions()
+		Expect(opts.Format).To(Equal(systemd.FormatQuadlet))
+		Expect(opts.RestartPolicy).To(Equal(systemd.DefaultRestartPolicy))
+		Expect(opts.Wants).To(BeEmpty())
+		Expect(opts.After).To(BeEmpty())
+		Expect(opts.Requires).To(BeEmpty())
+	})
+
+	It("get unit options", func() {
+		systemdDialog.restartPolicy.SetCurrentOption(len(systemd.RestartPolicies()) - 1)
+		systemdDialog.wants.SetText("network-online.target")
+		systemdDialog.after.SetText("network-online.target  db.service")
+		systemdDialog.requires.SetText("db.service")
+
+		opts := systemdDialog.GetUnitOptions()
+		Expect(opts.RestartPolicy).To(Equal("always"))
+		Expect(opts.Wants).To(Equal([]string{"network-online.target"}))
+		Expect(opts.After).To(Equal([]string{"network-online.target", "db.service"}))
+		Expect(opts.Requires).To(Equal([]string{"db.service"}))
+	})
+
+	It("format changes unit directory", func() {
+		systemdDialog.format.SetCurrentOption(1)
+		Expect(systemdDialog.GetUnitOptions().Format).To(Equal(systemd.FormatSystemd))
+		Expect(systemdDialog.dir.GetText()).To(Equal(systemd.DefaultDir(systemd.FormatSystemd)))
+	})
+
+	It("get unit directory", func() {
+		dir := filepath.Join(os.TempDir(), "systemd")
+		systemdDialog.dir.SetText(dir)
+
+		unitDir, err := systemdDialog.GetUnitDir()
+		Expect(err).To(BeNil())
+		Expect(unitDir).To(Equal(dir))
+
+		systemdDialog.dir.SetText("")
+		_, err = systemdDialog.GetUnitDir()
+		Expect(err).To(Equal(systemd.ErrEmptyUnitDir))
+	})
+
+	It("set preview", func() {
+		preview := "# test.container\n[Container]\nImage=test"
+		systemdDialog.SetPreview(preview)
+		Expect(systemdDialog.preview.GetText(true)).To(Equal(preview))
+	})
+
+	It("write button selected", func() {
+		writeButton := "initial"
+		writeButtonWants := "write selected"
+		writeFunc := func() {
+			writeButton = writeButtonWants
+		}
+		systemdDialog.Hide()
+		systemdDialogApp.Draw()
+		systemdDialog.SetWriteFunc(writeFunc)
+		systemdDialog.Display()
+		systemdDialogApp.Draw()
+		systemdDialogApp.SetFocus(systemdDialog.form)
+		systemdDialogApp.Draw()
+		systemdDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		systemdDialogApp.Draw()
+		systemdDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		systemdDialogApp.Draw()
+		Expect(writeButton).To(Equal(writeButtonWants))
+	})
+
+	It("cancel button selected", func() {
+		cancelButton := "initial"
+		cancelButtonWants := "cancel selected"
+		cancelFunc := func() {
+			cancelButton = cancelButtonWants
+		}
+		systemdDialog.SetCancelFunc(cancelFunc)
+		systemdDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone))
+		systemdDialogApp.Draw()
+		Expect(cancelButton).To(Equal(cancelButtonWants))
+	})
+
+	It("hide", func() {
+		systemdDialog.Hide()
+		Expect(systemdDialog.IsDisplay()).To(Equal(false))
+		Expect(systemdDialog.preview.GetText(true)).To(Equal(""))
+		Expect(systemdDialog.GetUnitOptions().Format).To(Equal(systemd.FormatQuadlet))
+		Expect(systemdDialog.dir.GetText()).To(Equal(systemd.DefaultDir(systemd.FormatQuadlet)))
+	})
+
+	AfterAll(func() {
+		systemdDialogApp.Stop()
+	})
+})
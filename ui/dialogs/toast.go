@@ -0,0 +1,168 @@
+package dialogs
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// toastMaxMessages is the number of the most recent notifications displayed by the toast.
+	toastMaxMessages = 5
+	toastMaxWidth    = 70
+)
+
+// ToastDialog is a notification toast primitive displayed at the bottom right corner
+// over any screen, it doesn't get the focus and it's hidden after its timeout.
+type ToastDialog struct {
+	*tview.Box
+	mu       sync.Mutex
+	messages []string
+	display  bool
+	timeout  time.Duration
+	timer    *time.Timer
+}
+
+// NewToastDialog returns new notification toast primitive.
+func NewToastDialog(timeout time.Duration) *ToastDialog {
+	dialog := &ToastDialog{
+		Box:     tview.NewBox(),
+		timeout: timeout,
+	}
+
+	dialog.Box.SetBorder(true)
+	dialog.Box.SetBorderColor(style.DialogBorderColor)
+	dialog.Box.SetBackgroundColor(style.DialogBgColor)
+	dialog.Box.SetTitle("NOTIFICATIONS")
+
+	return dialog
+}
+
+// Notify adds the notification to the toast and displays it until its timeout
+// (the timeout is restarted on each notification).
+func (d *ToastDialog) Notify(title string, message string) {
+	log.Debug().Msgf("toast dialog: notification %s: %s", title, message)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.messages = append(d.messages,
+		fmt.Sprintf("[::b]%s[::-] %s", tview.Escape(title), tview.Escape(message)))
+
+	if len(d.messages) > toastMaxMessages {
+		d.messages = d.messages[len(d.messages)-toastMaxMessages:]
+	}
+
+	d.display = true
+
+	if d.timer != nil {
+		d.timer.Stop()
+	}
+
+	d.timer = time.AfterFunc(d.timeout, d.Hide)
+}
+
+// GetMessages returns the displayed notifications (oldest first).
+func (d *ToastDialog) GetMessages() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	messages := make([]string, len(d.messages))
+	copy(messages, d.messages)
+
+	return messages
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *ToastDialog) IsDisplay() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.display
+}
+
+// Hide stops displaying this primitive and clears its notifications.
+func (d *ToastDialog) Hide() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.timer != nil {
+		d.timer.Stop()
+		d.timer = nil
+	}
+
+	d.messages = nil
+	d.display = false
+}
+
+// Focus is called when this primitive receives focus.
+func (d *ToastDialog) Focus(delegate func(p tview.Primitive)) {} //nolint:revive
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *ToastDialog) HasFocus() bool {
+	return false
+}
+
+// SetRect set rects for this primitive.
+func (d *ToastDialog) SetRect(x, y, width, height int) {
+	d.mu.Lock()
+	messageWidth := 0
+
+	for _, msg := range d.messages {
+		if msgWidth := tview.TaggedStringWidth(msg); msgWidth > messageWidth {
+			messageWidth = msgWidth
+		}
+	}
+
+	dHeight := len(d.messages) + 2 //nolint:gomnd
+	d.mu.Unlock()
+
+	dWidth := messageWidth + 4 //nolint:gomnd
+	if dWidth > toastMaxWidth {
+		dWidth = toastMaxWidth
+	}
+
+	if dWidth > width {
+		dWidth = width
+	}
+
+	if dHeight > height {
+		dHeight = height
+	}
+
+	// bottom right corner above the last (menu) row
+	dX := x + width - dWidth
+	dY := y + height - dHeight - 1
+
+	if dY < y {
+		dY = y
+	}
+
+	d.Box.SetRect(dX, dY, dWidth, dHeight)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *ToastDialog) Draw(screen tcell.Screen) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !d.display || len(d.messages) == 0 {
+		return
+	}
+
+	d.Box.DrawForSubclass(screen, d)
+	x, y, width, height := d.Box.GetInnerRect()
+
+	for i, msg := range d.messages {
+		if i >= height {
+			break
+		}
+
+		tview.Print(screen, msg, x+1, y+i, width-2, tview.AlignLeft, style.DialogFgColor) //nolint:gomnd
+	}
+}
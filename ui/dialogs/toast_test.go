@@ -0,0 +1,82 @@
+package dialogs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("toast dialog", Ordered, func() {
+	var toastDialogApp *tview.Application
+	var toastDialogScreen tcell.SimulationScreen
+	var toastDialog *ToastDialog
+	var runApp func()
+
+	BeforeAll(func() {
+		toastDialogApp = tview.NewApplication()
+		toastDialog = NewToastDialog(time.Hour)
+		toastDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := toastDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := toastDialogApp.SetScreen(toastDialogScreen).SetRoot(toastDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("notify", func() {
+		toastDialog.Notify("container died", "localhost: web exit code 1")
+		toastDialogApp.Draw()
+		Expect(toastDialog.IsDisplay()).To(Equal(true))
+		Expect(toastDialog.GetMessages()).To(Equal([]string{
+			"[::b]container died[::-] localhost: web exit code 1",
+		}))
+	})
+
+	It("does not get focus", func() {
+		toastDialogApp.SetFocus(toastDialog)
+		Expect(toastDialog.HasFocus()).To(Equal(false))
+	})
+
+	It("keeps the most recent notifications", func() {
+		for i := 0; i < toastMaxMessages+2; i++ {
+			toastDialog.Notify("container OOM killed", fmt.Sprintf("cnt%d", i))
+		}
+
+		messages := toastDialog.GetMessages()
+		Expect(len(messages)).To(Equal(toastMaxMessages))
+		Expect(messages[toastMaxMessages-1]).To(Equal(
+			fmt.Sprintf("[::b]container OOM killed[::-] cnt%d", toastMaxMessages+1)))
+	})
+
+	It("set rect", func() {
+		toastDialog.SetRect(0, 0, 100, 30)
+		x, y, width, height := toastDialog.Box.GetRect()
+		Expect(height).To(Equal(toastMaxMessages + 2))
+		Expect(x + width).To(Equal(100))
+		Expect(y + height).To(Equal(29))
+	})
+
+	It("hide after timeout", func() {
+		toastDialog.Hide()
+		toastDialog.timeout = 10 * time.Millisecond
+		toastDialog.Notify("container unhealthy", "web")
+		Expect(toastDialog.IsDisplay()).To(Equal(true))
+		Eventually(toastDialog.IsDisplay).Should(Equal(false))
+		Expect(toastDialog.GetMessages()).To(BeEmpty())
+	})
+
+	AfterAll(func() {
+		toastDialogApp.Stop()
+	})
+})
@@ -1,12 +1,15 @@
 package images
 
 import (
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/containers/podman-tui/pdcs/images"
 	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
 	"github.com/rs/zerolog/log"
 )
 
@@ -22,8 +25,12 @@ func (img *Images) runCommand(cmd string) { //nolint:cyclop
 		img.importDialog.Display()
 	case "inspect":
 		img.inspect()
+	case "manifests":
+		img.manifests()
 	case "prune": //nolint:goconst
 		img.cprune()
+	case "pull":
+		img.pullDialog.Display()
 	case "push":
 		img.cpush()
 	case "rm":
@@ -255,6 +262,12 @@ func (img *Images) push() {
 }
 
 func (img *Images) rm() {
+	if img.markedItems.Count() > 0 {
+		img.preBulkCommand("rm")
+
+		return
+	}
+
 	imageID, imageName := img.getSelectedItem()
 	if imageID == "" {
 		img.displayError("", errNoImageToRemove)
@@ -450,20 +463,126 @@ func (img *Images) untag(id string) {
 	}
 }
 
-func (img *Images) pull(image string) {
-	img.progressDialog.SetTitle("image pull in progress")
+func (img *Images) pull() {
+	name := img.pullDialog.GetImageName()
+	if name == "" {
+		img.displayError("IMAGE PULL ERROR", errEmptyPullImageName)
 
-	img.progressDialog.Display()
+		return
+	}
 
-	pull := func(name string) {
-		err := images.Pull(name)
-		if err != nil {
-			title := fmt.Sprintf("IMAGE (%s) PULL ERROR", img.selectedID)
+	opts := img.pullDialog.GetImagePullOptions()
+	img.pullDialog.Hide()
+
+	img.pullCancelChan = make(chan bool)
+	img.pullPrgDialog.SetImageName(name)
+	img.pullPrgDialog.Display()
+
+	pull := func(cancelChan chan bool) {
+		var lastRefresh time.Time
+
+		err := images.Pull(name, opts, func(progress images.ImagePullProgress) {
+			img.pullPrgDialog.UpdateProgress(progress)
+
+			if time.Since(lastRefresh) >= pullRefreshInterval {
+				lastRefresh = time.Now()
+				img.fastRefreshChan <- true
+			}
+		}, cancelChan)
+
+		img.pullPrgDialog.Hide()
+
+		if err != nil && !errors.Is(err, images.ErrImagePullCanceled) {
+			title := fmt.Sprintf("IMAGE (%s) PULL ERROR", name)
 			img.displayError(title, err)
 		}
 
+		img.fastRefreshChan <- true
+	}
+
+	go pull(img.pullCancelChan)
+}
+
+// cancelPull cancels the running image pull request.
+func (img *Images) cancelPull() {
+	if img.pullCancelChan == nil {
+		return
+	}
+
+	close(img.pullCancelChan)
+	img.pullCancelChan = nil
+}
+
+func (img *Images) markItem() {
+	if img.selectedID == "" {
+		return
+	}
+
+	img.markedItems.Toggle(img.selectedID, img.selectedName)
+
+	row, _ := img.table.GetSelection()
+	if row+1 < img.table.GetRowCount() {
+		img.table.Select(row+1, 0)
+	}
+}
+
+func (img *Images) markPattern() {
+	img.cmdInputDialog.SetTitle("mark images")
+	img.cmdInputDialog.SetDescription("mark the images which their ID or name matches the regular expression")
+	img.cmdInputDialog.SetSelectButtonLabel("mark")
+	img.cmdInputDialog.SetLabel("pattern ")
+
+	img.cmdInputDialog.SetSelectedFunc(func() {
+		pattern := img.cmdInputDialog.GetInputText()
+		img.cmdInputDialog.Hide()
+
+		if _, err := img.markedItems.Mark(img.getItems(), pattern); err != nil {
+			img.displayError("IMAGE MARK ERROR", err)
+		}
+	})
+
+	img.cmdInputDialog.Display()
+}
+
+func (img *Images) preBulkCommand(cmd string) {
+	img.confirmDialog.SetTitle("podman image " + cmd)
+	img.confirmData = "bulk"
+	img.bulkCmd = cmd
+
+	img.confirmDialog.SetText(utils.BulkCommandConfirmMessage("image", cmd, img.markedItems.Items()))
+	img.confirmDialog.Display()
+}
+
+func (img *Images) bulkCommand() {
+	var cmdFunc func(id string) error
+
+	cmd := img.bulkCmd
+
+	switch cmd {
+	case "rm":
+		cmdFunc = func(id string) error {
+			_, err := images.Remove(id)
+
+			return err
+		}
+	default:
+		return
+	}
+
+	img.progressDialog.SetTitle(fmt.Sprintf("image %s in progress", cmd))
+	img.progressDialog.Display()
+
+	bulk := func() {
+		results := img.markedItems.RunBulkCommand(cmdFunc)
+
 		img.progressDialog.Hide()
+
+		summary, report := utils.BulkCommandSummary(results)
+
+		img.messageDialog.SetTitle("podman image " + cmd)
+		img.messageDialog.SetText(dialogs.MessageBulkCommandInfo, summary, report)
+		img.messageDialog.Display()
 	}
 
-	go pull(image)
+	go bulk()
 }
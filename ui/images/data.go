@@ -1,7 +1,9 @@
 package images
 
 import (
+	"cmp"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/containers/podman-tui/pdcs/images"
@@ -26,14 +28,37 @@ func (img *Images) UpdateData() {
 	img.imagesList.mu.Unlock()
 }
 
+// getData returns a copy of the list data sorted by the table sort column and order.
 func (img *Images) getData() []images.ImageListReporter {
 	img.imagesList.mu.Lock()
-	data := img.imagesList.report
+	data := make([]images.ImageListReporter, len(img.imagesList.report))
+	copy(data, img.imagesList.report)
 	img.imagesList.mu.Unlock()
 
+	column := img.tableSort.Column()
+
+	sort.SliceStable(data, func(i, j int) bool {
+		return img.tableSort.Less(compareImages(column, data[i], data[j]))
+	})
+
 	return data
 }
 
+func compareImages(column int, a, b images.ImageListReporter) int {
+	switch column {
+	case viewImageTagColIndex:
+		return strings.Compare(a.Tag, b.Tag)
+	case viewImageIDColIndex:
+		return strings.Compare(a.ID, b.ID)
+	case viewImageCreatedAtColIndex:
+		return cmp.Compare(a.Created, b.Created)
+	case viewImageSizeColIndex:
+		return cmp.Compare(a.Size, b.Size)
+	default:
+		return strings.Compare(a.Repository, b.Repository)
+	}
+}
+
 // ClearData clears table data.
 func (img *Images) ClearData() {
 	img.imagesList.mu.Lock()
@@ -47,12 +72,12 @@ func (img *Images) ClearData() {
 
 	for i := 0; i < len(img.headers); i++ {
 		img.table.SetCell(0, i,
-			tview.NewTableCell(fmt.Sprintf("[::b]%s", strings.ToUpper(img.headers[i]))). //nolint:perfsprint
-													SetExpansion(expand).
-													SetBackgroundColor(bgColor).
-													SetTextColor(fgColor).
-													SetAlign(tview.AlignLeft).
-													SetSelectable(false))
+			tview.NewTableCell(img.tableSort.HeaderLabel(i, img.headers[i])).
+				SetExpansion(expand).
+				SetBackgroundColor(bgColor).
+				SetTextColor(fgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
 	}
 
 	img.table.SetTitle(fmt.Sprintf("[::b]%s[0]", strings.ToUpper(img.title)))
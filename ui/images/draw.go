@@ -1,6 +1,7 @@
 package images
 
 import (
+	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/gdamore/tcell/v2"
 )
 
@@ -12,12 +13,24 @@ func (img *Images) Draw(screen tcell.Screen) { //nolint:cyclop
 
 	x, y, width, height := img.GetInnerRect()
 
+	if img.filterBar.IsVisible() {
+		height -= dialogs.FilterBarHeight
+		img.filterBar.SetRect(x, y+height, width, dialogs.FilterBarHeight)
+		img.filterBar.Draw(screen)
+	}
+
 	img.table.SetRect(x, y, width, height)
 	img.table.SetBorder(true)
 
 	img.table.Draw(screen)
 	x, y, width, height = img.table.GetInnerRect()
 
+	// manifest dialog (sub dialogs are drawn over it)
+	if img.manifestDialog.IsDisplay() {
+		img.manifestDialog.SetRect(x, y, width, height)
+		img.manifestDialog.Draw(screen)
+	}
+
 	// error dialog
 	if img.errorDialog.IsDisplay() {
 		img.errorDialog.SetRect(x, y, width, height)
@@ -70,6 +83,22 @@ func (img *Images) Draw(screen tcell.Screen) { //nolint:cyclop
 		img.progressDialog.Draw(screen)
 	}
 
+	// pull dialog
+	if img.pullDialog.IsDisplay() {
+		img.pullDialog.SetRect(x, y, width, height)
+		img.pullDialog.Draw(screen)
+
+		return
+	}
+
+	// pull progress dialog
+	if img.pullPrgDialog.IsDisplay() {
+		img.pullPrgDialog.SetRect(x, y, width, height)
+		img.pullPrgDialog.Draw(screen)
+
+		return
+	}
+
 	// history dialog
 	if img.historyDialog.IsDisplay() {
 		img.historyDialog.SetRect(x, y, width, height)
@@ -117,4 +146,28 @@ func (img *Images) Draw(screen tcell.Screen) { //nolint:cyclop
 
 		return
 	}
+
+	// manifest create dialog
+	if img.mCreateDialog.IsDisplay() {
+		img.mCreateDialog.SetRect(x, y, width, height)
+		img.mCreateDialog.Draw(screen)
+
+		return
+	}
+
+	// manifest add dialog
+	if img.mAddDialog.IsDisplay() {
+		img.mAddDialog.SetRect(x, y, width, height)
+		img.mAddDialog.Draw(screen)
+
+		return
+	}
+
+	// manifest push dialog
+	if img.mPushDialog.IsDisplay() {
+		img.mPushDialog.SetRect(x, y, width, height)
+		img.mPushDialog.Draw(screen)
+
+		return
+	}
 }
@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/containers/podman-tui/pdcs/images"
 	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/containers/podman-tui/ui/images/imgdialogs"
 	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
 	"github.com/rivo/tview"
 )
 
@@ -21,6 +23,9 @@ const (
 	viewImageSizeColIndex
 )
 
+// pullRefreshInterval is the minimum screen refresh interval while the pull progress is received.
+const pullRefreshInterval = 200 * time.Millisecond
+
 var (
 	errNoImageToTree       = errors.New("here is no image to display tree")
 	errNoImageToUntag      = errors.New("here is no image to untag")
@@ -32,6 +37,11 @@ var (
 	errNoImageToRemove     = errors.New("there is no image to remove")
 	errNoImageToInspect    = errors.New("there is no image to display inspect")
 	errNoBuildDirOrCntFile = errors.New("both context directory path and container files fields are empty")
+	errEmptyPullImageName  = errors.New("empty image name to pull")
+	errNoManifestToAdd     = errors.New("there is no manifest list to add image")
+	errNoManifestToPush    = errors.New("there is no manifest list to push")
+	errNoManifestToDelete  = errors.New("there is no manifest list to delete")
+	errNoManifestToRemove  = errors.New("there is no manifest list entry to remove")
 )
 
 // Images implements the images primitive.
@@ -53,10 +63,21 @@ type Images struct {
 	progressDialog  *dialogs.ProgressDialog
 	saveDialog      *imgdialogs.ImageSaveDialog
 	pushDialog      *imgdialogs.ImagePushDialog
+	pullDialog      *imgdialogs.ImagePullDialog
+	pullPrgDialog   *imgdialogs.ImagePullProgressDialog
+	pullCancelChan  chan bool
+	manifestDialog  *imgdialogs.ImageManifestDialog
+	mCreateDialog   *imgdialogs.ImageManifestCreateDialog
+	mAddDialog      *imgdialogs.ImageManifestAddDialog
+	mPushDialog     *imgdialogs.ImagePushDialog
+	filterBar       *dialogs.FilterBar
 	imagesList      imageListReport
+	markedItems     *utils.MarkedItems
+	tableSort       *utils.TableSort
 	selectedID      string
 	selectedName    string
 	confirmData     string
+	bulkCmd         string
 	fastRefreshChan chan bool
 }
 
@@ -73,6 +94,8 @@ func NewImages() *Images {
 		headers:        []string{"repository", "tag", "image id", "created at", "size"},
 		errorDialog:    dialogs.NewErrorDialog(),
 		cmdInputDialog: dialogs.NewSimpleInputDialog(""),
+		filterBar:      dialogs.NewFilterBar(),
+		markedItems:    utils.NewMarkedItems(),
 		messageDialog:  dialogs.NewMessageDialog(""),
 		confirmDialog:  dialogs.NewConfirmDialog(),
 		searchDialog:   imgdialogs.NewImageSearchDialog(),
@@ -82,16 +105,26 @@ func NewImages() *Images {
 		buildPrgDialog: imgdialogs.NewImageBuildProgressDialog(),
 		saveDialog:     imgdialogs.NewImageSaveDialog(),
 		pushDialog:     imgdialogs.NewImagePushDialog(),
+		pullDialog:     imgdialogs.NewImagePullDialog(),
+		pullPrgDialog:  imgdialogs.NewImagePullProgressDialog(),
 		progressDialog: dialogs.NewProgressDialog(),
+		manifestDialog: imgdialogs.NewImageManifestDialog(),
+		mCreateDialog:  imgdialogs.NewImageManifestCreateDialog(),
+		mAddDialog:     imgdialogs.NewImageManifestAddDialog(),
+		mPushDialog:    imgdialogs.NewManifestPushDialog(),
 	}
 
+	images.tableSort = utils.NewTableSort(len(images.headers), viewImageRepoNameColIndex)
+
 	images.cmdDialog = dialogs.NewCommandDialog([][]string{
 		{"build", "build an image from Containerfile"},
 		{"diff", "inspect changes to the image's file systems"},
 		{"history", "show history of the selected image"},
 		{"import", "create a container image from a tarball"},
 		{"inspect", "display the configuration of the selected image"},
+		{"manifests", "create, inspect, modify and push manifest lists"},
 		{"prune", "remove all unused images"},
+		{"pull", "pull an image from registry"},
 		{"push", "push a source image to a specified destination"},
 		{"rm", "removes the selected  image from local storage"},
 		{"save", "save an image to docker-archive or oci-archive"},
@@ -110,12 +143,12 @@ func NewImages() *Images {
 
 	for i := 0; i < len(images.headers); i++ {
 		imgTable.SetCell(0, i,
-			tview.NewTableCell(fmt.Sprintf("[black::b]%s", strings.ToUpper(images.headers[i]))). //nolint:perfsprint
-														SetExpansion(1).
-														SetBackgroundColor(style.PageHeaderBgColor).
-														SetTextColor(style.PageHeaderFgColor).
-														SetAlign(tview.AlignLeft).
-														SetSelectable(false))
+			tview.NewTableCell("[::b]"+strings.ToUpper(images.headers[i])).
+				SetExpansion(1).
+				SetBackgroundColor(style.PageHeaderBgColor).
+				SetTextColor(style.PageHeaderFgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
 	}
 
 	imgTable.SetFixed(1, 1)
@@ -154,6 +187,12 @@ func NewImages() *Images {
 			images.prune()
 		case "rm":
 			images.remove()
+		case "bulk":
+			images.bulkCommand()
+		case "manifest rm":
+			images.manifestRemove()
+		case "manifest delete":
+			images.manifestDelete()
 		}
 	})
 
@@ -182,9 +221,15 @@ func NewImages() *Images {
 
 	images.searchDialog.SetPullFunc(func() {
 		name := images.searchDialog.GetSelectedItem()
-		images.pull(name)
+		images.pullDialog.SetImageName(name)
+		images.pullDialog.Display()
 	})
 
+	// set pull dialogs functions
+	images.pullDialog.SetPullFunc(images.pull)
+	images.pullDialog.SetCancelFunc(images.pullDialog.Hide)
+	images.pullPrgDialog.SetCancelFunc(images.cancelPull)
+
 	// set build dialogs functions
 	images.buildDialog.SetCancelFunc(images.buildDialog.Hide)
 	images.buildDialog.SetBuildFunc(images.build)
@@ -204,6 +249,21 @@ func NewImages() *Images {
 	images.pushDialog.SetPushFunc(images.push)
 	images.pushDialog.SetCancelFunc(images.pushDialog.Hide)
 
+	// set manifest dialogs functions
+	images.manifestDialog.SetSelectionChangedFunc(images.manifestInspect)
+	images.manifestDialog.SetCreateFunc(images.mCreateDialog.Display)
+	images.manifestDialog.SetAddFunc(images.cmanifestAdd)
+	images.manifestDialog.SetRemoveFunc(images.cmanifestRemove)
+	images.manifestDialog.SetPushFunc(images.cmanifestPush)
+	images.manifestDialog.SetDeleteFunc(images.cmanifestDelete)
+	images.manifestDialog.SetCancelFunc(images.manifestDialog.Hide)
+	images.mCreateDialog.SetCreateFunc(images.manifestCreate)
+	images.mCreateDialog.SetCancelFunc(images.mCreateDialog.Hide)
+	images.mAddDialog.SetAddFunc(images.manifestAdd)
+	images.mAddDialog.SetCancelFunc(images.mAddDialog.Hide)
+	images.mPushDialog.SetPushFunc(images.manifestPush)
+	images.mPushDialog.SetCancelFunc(images.mPushDialog.Hide)
+
 	return images
 }
 
@@ -242,6 +302,22 @@ func (img *Images) HasFocus() bool { //nolint:cyclop
 		return true
 	}
 
+	if img.pullDialog.HasFocus() || img.pullPrgDialog.HasFocus() {
+		return true
+	}
+
+	if img.manifestDialog.HasFocus() || img.mCreateDialog.HasFocus() {
+		return true
+	}
+
+	if img.mAddDialog.HasFocus() || img.mPushDialog.HasFocus() {
+		return true
+	}
+
+	if img.filterBar.HasFocus() {
+		return true
+	}
+
 	return img.Box.HasFocus()
 }
 
@@ -271,7 +347,19 @@ func (img *Images) SubDialogHasFocus() bool { //nolint:cyclop
 		return true
 	}
 
-	return img.pushDialog.HasFocus()
+	if img.pullDialog.HasFocus() || img.pullPrgDialog.HasFocus() {
+		return true
+	}
+
+	if img.manifestDialog.HasFocus() || img.mCreateDialog.HasFocus() {
+		return true
+	}
+
+	if img.mAddDialog.HasFocus() || img.mPushDialog.HasFocus() {
+		return true
+	}
+
+	return img.pushDialog.HasFocus() || img.filterBar.HasFocus()
 }
 
 // Focus is called when this primitive receives focus.
@@ -311,6 +399,20 @@ func (img *Images) Focus(delegate func(p tview.Primitive)) { //nolint:cyclop
 		return
 	}
 
+	// pull progress dialog
+	if img.pullPrgDialog.IsDisplay() {
+		delegate(img.pullPrgDialog)
+
+		return
+	}
+
+	// pull dialog (it is displayed over the search dialog)
+	if img.pullDialog.IsDisplay() {
+		delegate(img.pullDialog)
+
+		return
+	}
+
 	// search dialog
 	if img.searchDialog.IsDisplay() {
 		delegate(img.searchDialog)
@@ -359,6 +461,41 @@ func (img *Images) Focus(delegate func(p tview.Primitive)) { //nolint:cyclop
 		return
 	}
 
+	// manifest create dialog
+	if img.mCreateDialog.IsDisplay() {
+		delegate(img.mCreateDialog)
+
+		return
+	}
+
+	// manifest add dialog
+	if img.mAddDialog.IsDisplay() {
+		delegate(img.mAddDialog)
+
+		return
+	}
+
+	// manifest push dialog
+	if img.mPushDialog.IsDisplay() {
+		delegate(img.mPushDialog)
+
+		return
+	}
+
+	// manifest dialog (manifest create, add and push dialogs are displayed over it)
+	if img.manifestDialog.IsDisplay() {
+		delegate(img.manifestDialog)
+
+		return
+	}
+
+	// filter bar
+	if img.filterBar.IsDisplay() {
+		delegate(img.filterBar)
+
+		return
+	}
+
 	delegate(img.table)
 }
 
@@ -376,6 +513,22 @@ func (img *Images) getSelectedItem() (string, string) {
 	return imageID, imageName
 }
 
+func (img *Images) getItems() []utils.MarkedItem {
+	items := []utils.MarkedItem{}
+
+	for row := 1; row < img.table.GetRowCount(); row++ {
+		imageRepo := img.table.GetCell(row, viewImageRepoNameColIndex).Text
+		imageTag := img.table.GetCell(row, viewImageTagColIndex).Text
+
+		items = append(items, utils.MarkedItem{
+			ID:   img.table.GetCell(row, viewImageIDColIndex).Text,
+			Name: imageRepo + ":" + imageTag,
+		})
+	}
+
+	return items
+}
+
 // HideAllDialogs hides all sub dialogs.
 func (img *Images) HideAllDialogs() { //nolint:cyclop
 	if img.errorDialog.IsDisplay() {
@@ -429,6 +582,35 @@ func (img *Images) HideAllDialogs() { //nolint:cyclop
 	if img.pushDialog.IsDisplay() {
 		img.pushDialog.Hide()
 	}
+
+	if img.pullDialog.IsDisplay() {
+		img.pullDialog.Hide()
+	}
+
+	if img.pullPrgDialog.IsDisplay() {
+		img.cancelPull()
+		img.pullPrgDialog.Hide()
+	}
+
+	if img.mCreateDialog.IsDisplay() {
+		img.mCreateDialog.Hide()
+	}
+
+	if img.mAddDialog.IsDisplay() {
+		img.mAddDialog.Hide()
+	}
+
+	if img.mPushDialog.IsDisplay() {
+		img.mPushDialog.Hide()
+	}
+
+	if img.manifestDialog.IsDisplay() {
+		img.manifestDialog.Hide()
+	}
+
+	if img.filterBar.IsDisplay() {
+		img.filterBar.Hide()
+	}
 }
 
 // SetFastRefreshChannel sets channel for fastRefresh func.
@@ -324,19 +324,19 @@ func NewImageBuildDialog() *ImageBuildDialog { //nolint:maintidx
 	buildDialog.selinuxLabelField.SetLabel("label:")
 	buildDialog.selinuxLabelField.SetLabelWidth(securityOptionsPAgeLabelWidth)
 	buildDialog.selinuxLabelField.SetBackgroundColor(bgColor)
-	buildDialog.selinuxLabelField.SetLabelColor(tcell.ColorWhite)
+	buildDialog.selinuxLabelField.SetLabelColor(fgColor)
 	buildDialog.selinuxLabelField.SetFieldBackgroundColor(inputFieldBgColor)
 	// apparmor profile
 	buildDialog.apparmorProfileField.SetLabel("apparmor:")
 	buildDialog.apparmorProfileField.SetLabelWidth(securityOptionsPAgeLabelWidth)
 	buildDialog.apparmorProfileField.SetBackgroundColor(bgColor)
-	buildDialog.apparmorProfileField.SetLabelColor(tcell.ColorWhite)
+	buildDialog.apparmorProfileField.SetLabelColor(fgColor)
 	buildDialog.apparmorProfileField.SetFieldBackgroundColor(inputFieldBgColor)
 	// seccomp profile
 	buildDialog.seccompProfilePathField.SetLabel("seccomp:")
 	buildDialog.seccompProfilePathField.SetLabelWidth(securityOptionsPAgeLabelWidth)
 	buildDialog.seccompProfilePathField.SetBackgroundColor(bgColor)
-	buildDialog.seccompProfilePathField.SetLabelColor(tcell.ColorWhite)
+	buildDialog.seccompProfilePathField.SetLabelColor(fgColor)
 	buildDialog.seccompProfilePathField.SetFieldBackgroundColor(inputFieldBgColor)
 
 	// networking setup page
@@ -368,28 +368,28 @@ func NewImageBuildDialog() *ImageBuildDialog { //nolint:maintidx
 	buildDialog.addHostField.SetLabel("add host:")
 	buildDialog.addHostField.SetLabelWidth(networkingPageLabelWidth)
 	buildDialog.addHostField.SetBackgroundColor(bgColor)
-	buildDialog.addHostField.SetLabelColor(tcell.ColorWhite)
+	buildDialog.addHostField.SetLabelColor(fgColor)
 	buildDialog.addHostField.SetFieldBackgroundColor(inputFieldBgColor)
 
 	// DNS servers field
 	buildDialog.dnsServersField.SetLabel("dns servers:")
 	buildDialog.dnsServersField.SetLabelWidth(networkingPageLabelWidth)
 	buildDialog.dnsServersField.SetBackgroundColor(bgColor)
-	buildDialog.dnsServersField.SetLabelColor(tcell.ColorWhite)
+	buildDialog.dnsServersField.SetLabelColor(fgColor)
 	buildDialog.dnsServersField.SetFieldBackgroundColor(inputFieldBgColor)
 
 	// DNS options field
 	buildDialog.dnsOptionsField.SetLabel("dns options:")
 	buildDialog.dnsOptionsField.SetLabelWidth(networkingPageLabelWidth)
 	buildDialog.dnsOptionsField.SetBackgroundColor(bgColor)
-	buildDialog.dnsOptionsField.SetLabelColor(tcell.ColorWhite)
+	buildDialog.dnsOptionsField.SetLabelColor(fgColor)
 	buildDialog.dnsOptionsField.SetFieldBackgroundColor(inputFieldBgColor)
 
 	// DNS search field
 	buildDialog.dnsSearchField.SetLabel("dns search:")
 	buildDialog.dnsSearchField.SetLabelWidth(networkingPageLabelWidth)
 	buildDialog.dnsSearchField.SetBackgroundColor(bgColor)
-	buildDialog.dnsSearchField.SetLabelColor(tcell.ColorWhite)
+	buildDialog.dnsSearchField.SetLabelColor(fgColor)
 	buildDialog.dnsSearchField.SetFieldBackgroundColor(inputFieldBgColor)
 
 	// capability page
@@ -399,14 +399,14 @@ func NewImageBuildDialog() *ImageBuildDialog { //nolint:maintidx
 	buildDialog.addCapabilityField.SetLabel("add cap:")
 	buildDialog.addCapabilityField.SetLabelWidth(capabilityPageLabelWidth)
 	buildDialog.addCapabilityField.SetBackgroundColor(bgColor)
-	buildDialog.addCapabilityField.SetLabelColor(tcell.ColorWhite)
+	buildDialog.addCapabilityField.SetLabelColor(fgColor)
 	buildDialog.addCapabilityField.SetFieldBackgroundColor(inputFieldBgColor)
 
 	// remove capability field
 	buildDialog.removeCapabilityField.SetLabel("remove cap:")
 	buildDialog.removeCapabilityField.SetLabelWidth(capabilityPageLabelWidth)
 	buildDialog.removeCapabilityField.SetBackgroundColor(bgColor)
-	buildDialog.removeCapabilityField.SetLabelColor(tcell.ColorWhite)
+	buildDialog.removeCapabilityField.SetLabelColor(fgColor)
 	buildDialog.removeCapabilityField.SetFieldBackgroundColor(inputFieldBgColor)
 
 	// cpu and memory page
@@ -418,7 +418,7 @@ func NewImageBuildDialog() *ImageBuildDialog { //nolint:maintidx
 	buildDialog.cpuPeriodField.SetLabelWidth(cpuMemoryLabelWidth)
 	buildDialog.cpuPeriodField.SetFieldWidth(cpuMemoryFieldWidth)
 	buildDialog.cpuPeriodField.SetBackgroundColor(bgColor)
-	buildDialog.cpuPeriodField.SetLabelColor(tcell.ColorWhite)
+	buildDialog.cpuPeriodField.SetLabelColor(fgColor)
 	buildDialog.cpuPeriodField.SetFieldBackgroundColor(inputFieldBgColor)
 
 	// cpu quota field
@@ -426,7 +426,7 @@ func NewImageBuildDialog() *ImageBuildDialog { //nolint:maintidx
 	buildDialog.cpuQuataField.SetLabelWidth(cpuMemoryLabelWidth)
 	buildDialog.cpuQuataField.SetFieldWidth(cpuMemoryFieldWidth)
 	buildDialog.cpuQuataField.SetBackgroundColor(bgColor)
-	buildDialog.cpuQuataField.SetLabelColor(tcell.ColorWhite)
+	buildDialog.cpuQuataField.SetLabelColor(fgColor)
 	buildDialog.cpuQuataField.SetFieldBackgroundColor(inputFieldBgColor)
 
 	// cpu shares field
@@ -434,7 +434,7 @@ func NewImageBuildDialog() *ImageBuildDialog { //nolint:maintidx
 	buildDialog.cpuSharesField.SetLabelWidth(cpuMemoryLabelWidth)
 	buildDialog.cpuSharesField.SetFieldWidth(cpuMemoryFieldWidth)
 	buildDialog.cpuSharesField.SetBackgroundColor(bgColor)
-	buildDialog.cpuSharesField.SetLabelColor(tcell.ColorWhite)
+	buildDialog.cpuSharesField.SetLabelColor(fgColor)
 	buildDialog.cpuSharesField.SetFieldBackgroundColor(inputFieldBgColor)
 
 	// cpuset cpus field
@@ -442,14 +442,14 @@ func NewImageBuildDialog() *ImageBuildDialog { //nolint:maintidx
 	buildDialog.cpuSetCpusField.SetLabelWidth(cpuMemoryLabelWidth)
 	buildDialog.cpuSetCpusField.SetFieldWidth(cpuMemoryFieldWidth)
 	buildDialog.cpuSetCpusField.SetBackgroundColor(bgColor)
-	buildDialog.cpuSetCpusField.SetLabelColor(tcell.ColorWhite)
+	buildDialog.cpuSetCpusField.SetLabelColor(fgColor)
 	buildDialog.cpuSetCpusField.SetFieldBackgroundColor(inputFieldBgColor)
 
 	// cpuset mems field
 	buildDialog.cpuSetMemsField.SetLabel(" cpu set mems:")
 	buildDialog.cpuSetMemsField.SetLabelWidth(cpuMemoryLabelWidth + 1)
 	buildDialog.cpuSetMemsField.SetBackgroundColor(bgColor)
-	buildDialog.cpuSetMemsField.SetLabelColor(tcell.ColorWhite)
+	buildDialog.cpuSetMemsField.SetLabelColor(fgColor)
 	buildDialog.cpuSetMemsField.SetFieldBackgroundColor(inputFieldBgColor)
 
 	// memory field
@@ -457,14 +457,14 @@ func NewImageBuildDialog() *ImageBuildDialog { //nolint:maintidx
 	buildDialog.memoryField.SetLabelWidth(cpuMemoryLabelWidth)
 	buildDialog.memoryField.SetFieldWidth(cpuMemoryFieldWidth)
 	buildDialog.memoryField.SetBackgroundColor(bgColor)
-	buildDialog.memoryField.SetLabelColor(tcell.ColorWhite)
+	buildDialog.memoryField.SetLabelColor(fgColor)
 	buildDialog.memoryField.SetFieldBackgroundColor(inputFieldBgColor)
 
 	// memory swap field
 	buildDialog.memorySwapField.SetLabel(" memory swap:")
 	buildDialog.memorySwapField.SetLabelWidth(cpuMemoryLabelWidth + 1)
 	buildDialog.memorySwapField.SetBackgroundColor(bgColor)
-	buildDialog.memorySwapField.SetLabelColor(tcell.ColorWhite)
+	buildDialog.memorySwapField.SetLabelColor(fgColor)
 	buildDialog.memorySwapField.SetFieldBackgroundColor(inputFieldBgColor)
 
 	// category pages
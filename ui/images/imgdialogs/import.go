@@ -74,6 +74,8 @@ func NewImageImportDialog() *ImageImportDialog {
 	dialog.change.SetLabel("change:")
 	dialog.change.SetLabelWidth(labelWidth)
 	dialog.change.SetFieldBackgroundColor(inputFieldBgColor)
+	dialog.change.SetPlaceholder("CMD /bin/sh; ENV KEY=VALUE; LABEL key=value")
+	dialog.change.SetPlaceholderTextColor(style.InfoBarItemFgColor)
 
 	// commit field
 	dialog.commitMessage.SetBackgroundColor(bgColor)
@@ -312,27 +314,31 @@ func (d *ImageImportDialog) SetCancelFunc(handler func()) *ImageImportDialog {
 func (d *ImageImportDialog) ImageImportOptions() (images.ImageImportOptions, error) {
 	var (
 		path      string
-		change    []string
 		commit    string
 		reference string
 	)
 
 	commit = strings.TrimSpace(d.commitMessage.GetText())
 	reference = strings.TrimSpace(d.reference.GetText())
-	change = strings.Split(d.change.GetText(), " ")
 
 	opts := images.ImageImportOptions{
-		Change:    change,
 		Message:   commit,
 		Reference: reference,
 	}
 
+	change, err := utils.ParseChangeInstructions(d.change.GetText())
+	if err != nil {
+		return opts, err
+	}
+
+	opts.Change = change
+
 	path = strings.TrimSpace(d.path.GetText())
 	if path == "" {
 		return opts, errImportEmptySource
 	}
 
-	path, err := utils.ResolveHomeDir(path)
+	path, err = utils.ResolveHomeDir(path)
 	if err != nil {
 		return opts, err
 	}
@@ -88,6 +88,20 @@ var _ = Describe("image import", Ordered, func() {
 		opts, err := importDialog.ImageImportOptions()
 		Expect(err).To(BeNil())
 		Expect(opts.Source).To(Equal("c"))
+		Expect(opts.Change).To(BeNil())
+	})
+
+	It("import change instructions", func() {
+		importDialog.change.SetText("CMD /bin/sh -c 'sleep 60'; env KEY=VALUE; LABEL debug=true")
+
+		opts, err := importDialog.ImageImportOptions()
+		Expect(err).To(BeNil())
+		Expect(opts.Change).To(Equal([]string{"CMD /bin/sh -c 'sleep 60'", "ENV KEY=VALUE", "LABEL debug=true"}))
+
+		importDialog.change.SetText("RUN apt update")
+
+		_, err = importDialog.ImageImportOptions()
+		Expect(err).NotTo(BeNil())
 	})
 
 	It("hide", func() {
@@ -0,0 +1,420 @@
+package imgdialogs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/images"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	manifestListsFocus = 0 + iota
+	manifestPlatformsFocus
+	manifestFormFocus
+)
+
+const (
+	viewManifestNameColIndex = 0 + iota
+	viewManifestIDColIndex
+	viewManifestCreatedColIndex
+)
+
+const (
+	viewManifestPlatformOSColIndex = 0 + iota
+	viewManifestPlatformArchColIndex
+	viewManifestPlatformVariantColIndex
+	viewManifestPlatformDigestColIndex
+	viewManifestPlatformSizeColIndex
+	viewManifestPlatformAnnotationsColIndex
+)
+
+const (
+	manifestCreateButton = "Create"
+	manifestAddButton    = "Add"
+	manifestRemoveButton = "Remove"
+	manifestPushButton   = "Push"
+	manifestDeleteButton = "Delete"
+	manifestCancelButton = "Cancel"
+)
+
+// ImageManifestDialog represents image manifest lists dialog primitive.
+type ImageManifestDialog struct {
+	*tview.Box
+	layout                  *tview.Flex
+	manifestsTable          *tview.Table
+	platformsTable          *tview.Table
+	form                    *tview.Form
+	manifestsHeaders        []string
+	platformsHeaders        []string
+	manifests               []images.ManifestListReporter
+	platforms               []images.ManifestPlatformReporter
+	display                 bool
+	focusElement            int
+	selectionChangedHandler func()
+	cancelHandler           func()
+}
+
+// NewImageManifestDialog returns new image manifest lists dialog.
+func NewImageManifestDialog() *ImageManifestDialog {
+	dialog := &ImageManifestDialog{
+		Box:              tview.NewBox(),
+		manifestsTable:   tview.NewTable(),
+		platformsTable:   tview.NewTable(),
+		manifestsHeaders: []string{"name", "id", "created"},
+		platformsHeaders: []string{"os", "arch", "variant", "digest", "size", "annotations"},
+	}
+
+	bgColor := style.DialogBgColor
+
+	dialog.manifestsTable.SetBackgroundColor(bgColor)
+	dialog.manifestsTable.SetBorder(true)
+	dialog.manifestsTable.SetBorderColor(style.DialogSubBoxBorderColor)
+	dialog.manifestsTable.SetTitle("MANIFEST LISTS")
+	dialog.manifestsTable.SetSelectionChangedFunc(func(_, _ int) {
+		if dialog.selectionChangedHandler != nil {
+			dialog.selectionChangedHandler()
+		}
+	})
+	initManifestTable(dialog.manifestsTable, dialog.manifestsHeaders)
+
+	dialog.platformsTable.SetBackgroundColor(bgColor)
+	dialog.platformsTable.SetBorder(true)
+	dialog.platformsTable.SetBorderColor(style.DialogSubBoxBorderColor)
+	dialog.platformsTable.SetTitle("PLATFORMS")
+	initManifestTable(dialog.platformsTable, dialog.platformsHeaders)
+
+	dialog.form = tview.NewForm().
+		AddButton(manifestCreateButton, nil).
+		AddButton(manifestAddButton, nil).
+		AddButton(manifestRemoveButton, nil).
+		AddButton(manifestPushButton, nil).
+		AddButton(manifestDeleteButton, nil).
+		AddButton(manifestCancelButton, nil).
+		SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// tables layout
+	tablesLayout := tview.NewFlex().SetDirection(tview.FlexRow)
+	tablesLayout.AddItem(dialog.manifestsTable, 0, 1, true)
+	tablesLayout.AddItem(dialog.platformsTable, 0, 1, true)
+
+	mainLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	mainLayout.SetBackgroundColor(bgColor)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainLayout.AddItem(tablesLayout, 0, 1, true)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout = tview.NewFlex().SetDirection(tview.FlexRow)
+	dialog.layout.SetTitle("PODMAN IMAGE MANIFESTS")
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.AddItem(mainLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, dialogs.DialogFormHeight, 0, true)
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *ImageManifestDialog) Display() {
+	d.display = true
+	d.focusElement = manifestListsFocus
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *ImageManifestDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *ImageManifestDialog) Hide() {
+	d.display = false
+	d.focusElement = manifestListsFocus
+	d.SetManifests(nil)
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *ImageManifestDialog) HasFocus() bool {
+	if d.manifestsTable.HasFocus() || d.platformsTable.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus() || d.form.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *ImageManifestDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case manifestListsFocus:
+		delegate(d.manifestsTable)
+	case manifestPlatformsFocus:
+		delegate(d.platformsTable)
+	case manifestFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = manifestListsFocus
+				d.Focus(delegate)
+				d.form.SetFocus(0)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *ImageManifestDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("image manifest dialog: event %v received", event)
+
+		if event.Key() == tcell.KeyEsc {
+			d.cancelHandler()
+
+			return
+		}
+
+		if event.Key() == utils.SwitchFocusKey.Key {
+			d.setFocusElement()
+		}
+
+		if d.manifestsTable.HasFocus() {
+			if manifestsTableHandler := d.manifestsTable.InputHandler(); manifestsTableHandler != nil {
+				event = utils.ParseKeyEventKey(event)
+				manifestsTableHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.platformsTable.HasFocus() {
+			if platformsTableHandler := d.platformsTable.InputHandler(); platformsTableHandler != nil {
+				event = utils.ParseKeyEventKey(event)
+				platformsTableHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+func (d *ImageManifestDialog) setFocusElement() {
+	switch d.focusElement {
+	case manifestListsFocus:
+		d.focusElement = manifestPlatformsFocus
+	case manifestPlatformsFocus:
+		d.focusElement = manifestFormFocus
+	}
+}
+
+// SetRect set rects for this primitive.
+func (d *ImageManifestDialog) SetRect(x, y, width, height int) {
+	dX := x + dialogs.DialogPadding
+	dY := y + 1
+	dWidth := width - (2 * dialogs.DialogPadding) //nolint:gomnd
+	dHeight := height - 2                         //nolint:gomnd
+
+	d.Box.SetRect(dX, dY, dWidth, dHeight)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *ImageManifestDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.Box.DrawForSubclass(screen, d)
+	x, y, width, height := d.Box.GetInnerRect()
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetSelectionChangedFunc sets handler function for manifest lists table selection change.
+func (d *ImageManifestDialog) SetSelectionChangedFunc(handler func()) *ImageManifestDialog {
+	d.selectionChangedHandler = handler
+
+	return d
+}
+
+// SetCreateFunc sets form create button selected function.
+func (d *ImageManifestDialog) SetCreateFunc(handler func()) *ImageManifestDialog {
+	d.setButtonFunc(manifestCreateButton, handler)
+
+	return d
+}
+
+// SetAddFunc sets form add button selected function.
+func (d *ImageManifestDialog) SetAddFunc(handler func()) *ImageManifestDialog {
+	d.setButtonFunc(manifestAddButton, handler)
+
+	return d
+}
+
+// SetRemoveFunc sets form remove button selected function.
+func (d *ImageManifestDialog) SetRemoveFunc(handler func()) *ImageManifestDialog {
+	d.setButtonFunc(manifestRemoveButton, handler)
+
+	return d
+}
+
+// SetPushFunc sets form push button selected function.
+func (d *ImageManifestDialog) SetPushFunc(handler func()) *ImageManifestDialog {
+	d.setButtonFunc(manifestPushButton, handler)
+
+	return d
+}
+
+// SetDeleteFunc sets form delete button selected function.
+func (d *ImageManifestDialog) SetDeleteFunc(handler func()) *ImageManifestDialog {
+	d.setButtonFunc(manifestDeleteButton, handler)
+
+	return d
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *ImageManifestDialog) SetCancelFunc(handler func()) *ImageManifestDialog {
+	d.cancelHandler = handler
+	d.setButtonFunc(manifestCancelButton, handler)
+
+	return d
+}
+
+func (d *ImageManifestDialog) setButtonFunc(label string, handler func()) {
+	button := d.form.GetButton(d.form.GetButtonIndex(label))
+	button.SetSelectedFunc(handler)
+}
+
+// SetManifests sets manifest lists table items and keeps the selected manifest list.
+func (d *ImageManifestDialog) SetManifests(manifests []images.ManifestListReporter) {
+	_, selectedName := d.GetSelectedManifest()
+	selectedRow := 1
+
+	d.manifests = manifests
+
+	handler := d.selectionChangedHandler
+	d.selectionChangedHandler = nil
+
+	initManifestTable(d.manifestsTable, d.manifestsHeaders)
+
+	for i, item := range manifests {
+		row := i + 1
+		id := item.ID
+
+		if len(id) > utils.IDLength {
+			id = id[0:utils.IDLength]
+		}
+
+		if item.Name == selectedName {
+			selectedRow = row
+		}
+
+		d.manifestsTable.SetCell(row, viewManifestNameColIndex,
+			tview.NewTableCell(item.Name).SetExpansion(1))
+		d.manifestsTable.SetCell(row, viewManifestIDColIndex,
+			tview.NewTableCell(id).SetExpansion(0))
+		d.manifestsTable.SetCell(row, viewManifestCreatedColIndex,
+			tview.NewTableCell(item.Created).SetExpansion(0))
+	}
+
+	if len(manifests) > 0 {
+		d.manifestsTable.Select(selectedRow, 0)
+	}
+
+	d.selectionChangedHandler = handler
+
+	d.SetPlatforms(nil)
+}
+
+// SetPlatforms sets per-platform entries table items of the selected manifest list.
+func (d *ImageManifestDialog) SetPlatforms(platforms []images.ManifestPlatformReporter) {
+	d.platforms = platforms
+
+	initManifestTable(d.platformsTable, d.platformsHeaders)
+
+	for i, item := range platforms {
+		row := i + 1
+
+		d.platformsTable.SetCell(row, viewManifestPlatformOSColIndex,
+			tview.NewTableCell(item.OS).SetExpansion(0))
+		d.platformsTable.SetCell(row, viewManifestPlatformArchColIndex,
+			tview.NewTableCell(item.Arch).SetExpansion(0))
+		d.platformsTable.SetCell(row, viewManifestPlatformVariantColIndex,
+			tview.NewTableCell(item.Variant).SetExpansion(0))
+		d.platformsTable.SetCell(row, viewManifestPlatformDigestColIndex,
+			tview.NewTableCell(item.Digest).SetExpansion(1))
+		d.platformsTable.SetCell(row, viewManifestPlatformSizeColIndex,
+			tview.NewTableCell(item.Size).SetExpansion(0))
+		d.platformsTable.SetCell(row, viewManifestPlatformAnnotationsColIndex,
+			tview.NewTableCell(item.Annotations).SetExpansion(0))
+	}
+
+	if len(platforms) > 0 {
+		d.platformsTable.Select(1, 0)
+		d.platformsTable.ScrollToBeginning()
+	}
+}
+
+// GetSelectedManifest returns selected manifest list ID and name.
+func (d *ImageManifestDialog) GetSelectedManifest() (string, string) {
+	row, _ := d.manifestsTable.GetSelection()
+	if row < 1 || row > len(d.manifests) {
+		return "", ""
+	}
+
+	return d.manifests[row-1].ID, d.manifests[row-1].Name
+}
+
+// GetSelectedPlatform returns selected manifest list platform entry digest and platform.
+func (d *ImageManifestDialog) GetSelectedPlatform() (string, string) {
+	row, _ := d.platformsTable.GetSelection()
+	if row < 1 || row > len(d.platforms) {
+		return "", ""
+	}
+
+	item := d.platforms[row-1]
+	platform := item.OS + "/" + item.Arch
+
+	if item.Variant != "" {
+		platform = platform + "/" + item.Variant
+	}
+
+	return item.Digest, platform
+}
+
+func initManifestTable(table *tview.Table, headers []string) {
+	bgColor := style.TableHeaderBgColor
+	fgColor := style.TableHeaderFgColor
+
+	table.Clear()
+	table.SetFixed(1, 1)
+	table.SetSelectable(true, false)
+
+	for i := 0; i < len(headers); i++ {
+		table.SetCell(0, i,
+			tview.NewTableCell(fmt.Sprintf("[%s::b]%s", style.GetColorHex(fgColor), strings.ToUpper(headers[i]))).
+				SetExpansion(1).
+				SetBackgroundColor(bgColor).
+				SetTextColor(fgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
+	}
+}
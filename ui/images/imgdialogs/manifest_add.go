@@ -0,0 +1,540 @@
+package imgdialogs
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/images"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	manifestAddDialogMaxWidth  = 90
+	manifestAddDialogMaxHeight = 19
+)
+
+const (
+	manifestAddImagesFocus = 0 + iota
+	manifestAddAllFocus
+	manifestAddOSFocus
+	manifestAddArchFocus
+	manifestAddVariantFocus
+	manifestAddAnnotationsFocus
+	manifestAddSkipTLSVerifyFocus
+	manifestAddUsernameFocus
+	manifestAddPasswordFocus
+	manifestAddAuthFileFocus
+	manifestAddFormFocus
+)
+
+var errInvalidManifestAnnotation = errors.New("invalid annotation, expected key=value")
+
+// ImageManifestAddDialog represents manifest list add dialog primitive.
+type ImageManifestAddDialog struct {
+	*tview.Box
+	layout        *tview.Flex
+	manifestInfo  *tview.InputField
+	images        *tview.InputField
+	all           *tview.Checkbox
+	os            *tview.InputField
+	arch          *tview.InputField
+	variant       *tview.InputField
+	annotations   *tview.InputField
+	skipTLSVerify *tview.Checkbox
+	authFile      *tview.InputField
+	username      *tview.InputField
+	password      *tview.InputField
+	form          *tview.Form
+	display       bool
+	focusElement  int
+	addHandler    func()
+	cancelHandler func()
+}
+
+// NewImageManifestAddDialog returns new manifest list add dialog primitive.
+func NewImageManifestAddDialog() *ImageManifestAddDialog { //nolint:funlen
+	dialog := &ImageManifestAddDialog{
+		Box:           tview.NewBox(),
+		layout:        tview.NewFlex().SetDirection(tview.FlexRow),
+		manifestInfo:  tview.NewInputField(),
+		images:        tview.NewInputField(),
+		all:           tview.NewCheckbox(),
+		os:            tview.NewInputField(),
+		arch:          tview.NewInputField(),
+		variant:       tview.NewInputField(),
+		annotations:   tview.NewInputField(),
+		skipTLSVerify: tview.NewCheckbox(),
+		authFile:      tview.NewInputField(),
+		username:      tview.NewInputField(),
+		password:      tview.NewInputField(),
+		form:          tview.NewForm(),
+	}
+
+	bgColor := style.DialogBgColor
+	fgColor := style.DialogFgColor
+	inputFieldBgColor := style.InputFieldBgColor
+	labelWidth := 13
+
+	// manifest info field
+	dialog.manifestInfo.SetBackgroundColor(style.DialogBgColor)
+	dialog.manifestInfo.SetLabel("[::b]MANIFEST:")
+	dialog.manifestInfo.SetLabelWidth(labelWidth)
+	dialog.manifestInfo.SetFieldBackgroundColor(style.DialogBgColor)
+	dialog.manifestInfo.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(style.DialogFgColor))
+
+	// images input field
+	dialog.images.SetBackgroundColor(bgColor)
+	dialog.images.SetLabelColor(fgColor)
+	dialog.images.SetLabel("images:")
+	dialog.images.SetLabelWidth(labelWidth)
+	dialog.images.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// all checkbox
+	allLabel := "all:"
+
+	dialog.all.SetBackgroundColor(bgColor)
+	dialog.all.SetLabelColor(fgColor)
+	dialog.all.SetLabel(allLabel)
+	dialog.all.SetLabelWidth(len(allLabel) + 1)
+	dialog.all.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// os input field
+	dialog.os.SetBackgroundColor(bgColor)
+	dialog.os.SetLabelColor(fgColor)
+	dialog.os.SetLabel("os:")
+	dialog.os.SetLabelWidth(labelWidth)
+	dialog.os.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// arch input field
+	archLabel := "arch:"
+
+	dialog.arch.SetBackgroundColor(bgColor)
+	dialog.arch.SetLabelColor(fgColor)
+	dialog.arch.SetLabel(archLabel)
+	dialog.arch.SetLabelWidth(len(archLabel) + 1)
+	dialog.arch.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// variant input field
+	variantLabel := "variant:"
+
+	dialog.variant.SetBackgroundColor(bgColor)
+	dialog.variant.SetLabelColor(fgColor)
+	dialog.variant.SetLabel(variantLabel)
+	dialog.variant.SetLabelWidth(len(variantLabel) + 1)
+	dialog.variant.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// annotations input field
+	dialog.annotations.SetBackgroundColor(bgColor)
+	dialog.annotations.SetLabelColor(fgColor)
+	dialog.annotations.SetLabel("annotations:")
+	dialog.annotations.SetLabelWidth(labelWidth)
+	dialog.annotations.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// skipTLSVerify checkbox
+	dialog.skipTLSVerify.SetBackgroundColor(bgColor)
+	dialog.skipTLSVerify.SetLabelColor(fgColor)
+	dialog.skipTLSVerify.SetLabel("skip tls:")
+	dialog.skipTLSVerify.SetLabelWidth(labelWidth)
+	dialog.skipTLSVerify.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// authfile input field
+	dialog.authFile.SetBackgroundColor(bgColor)
+	dialog.authFile.SetLabelColor(fgColor)
+	dialog.authFile.SetLabel("authfile:")
+	dialog.authFile.SetLabelWidth(labelWidth)
+	dialog.authFile.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// username input field
+	dialog.username.SetBackgroundColor(bgColor)
+	dialog.username.SetLabelColor(fgColor)
+	dialog.username.SetLabel("username:")
+	dialog.username.SetLabelWidth(labelWidth)
+	dialog.username.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// password input field
+	passwordLabel := "password:"
+
+	dialog.password.SetBackgroundColor(bgColor)
+	dialog.password.SetLabelColor(fgColor)
+	dialog.password.SetLabel(passwordLabel)
+	dialog.password.SetLabelWidth(len(passwordLabel) + 1)
+	dialog.password.SetFieldBackgroundColor(inputFieldBgColor)
+	dialog.password.SetMaskCharacter('*')
+
+	// form
+	dialog.form.AddButton("Cancel", nil)
+	dialog.form.AddButton("Add", nil)
+	dialog.form.SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	// images and all row layout
+	imagesLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	imagesLayout.AddItem(dialog.images, 0, 1, true)
+	imagesLayout.AddItem(utils.EmptyBoxSpace(bgColor), 2, 0, false) //nolint:gomnd
+	imagesLayout.AddItem(dialog.all, len(allLabel)+2, 0, true)      //nolint:gomnd
+
+	// os, arch and variant row layout
+	platformLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	platformLayout.AddItem(dialog.os, 0, 1, true)
+	platformLayout.AddItem(utils.EmptyBoxSpace(bgColor), 2, 0, false) //nolint:gomnd
+	platformLayout.AddItem(dialog.arch, 0, 1, true)
+	platformLayout.AddItem(utils.EmptyBoxSpace(bgColor), 2, 0, false) //nolint:gomnd
+	platformLayout.AddItem(dialog.variant, 0, 1, true)
+
+	// username and password row layout
+	userPassLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	userPassLayout.AddItem(dialog.username, 0, 1, true)
+	userPassLayout.AddItem(utils.EmptyBoxSpace(bgColor), 3, 0, false) //nolint:gomnd
+	userPassLayout.AddItem(dialog.password, 0, 1, true)
+
+	layout := tview.NewFlex().SetDirection(tview.FlexRow)
+	layout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	layout.AddItem(dialog.manifestInfo, 1, 0, false)
+	layout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	layout.AddItem(imagesLayout, 1, 0, true)
+	layout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	layout.AddItem(platformLayout, 1, 0, true)
+	layout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	layout.AddItem(dialog.annotations, 1, 0, true)
+	layout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	layout.AddItem(dialog.skipTLSVerify, 1, 0, true)
+	layout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	layout.AddItem(userPassLayout, 1, 0, true)
+	layout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	layout.AddItem(dialog.authFile, 1, 0, true)
+
+	inputLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	inputLayout.SetBackgroundColor(bgColor)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(layout, 0, 1, true)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetTitle("PODMAN MANIFEST ADD")
+	dialog.layout.AddItem(inputLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, dialogs.DialogFormHeight, 0, true)
+
+	dialog.Hide()
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *ImageManifestAddDialog) Display() {
+	d.display = true
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *ImageManifestAddDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *ImageManifestAddDialog) Hide() {
+	d.display = false
+	d.focusElement = manifestAddImagesFocus
+
+	d.manifestInfo.SetText("")
+	d.images.SetText("")
+	d.all.SetChecked(false)
+	d.os.SetText("")
+	d.arch.SetText("")
+	d.variant.SetText("")
+	d.annotations.SetText("")
+	d.skipTLSVerify.SetChecked(false)
+	d.authFile.SetText("")
+	d.username.SetText("")
+	d.password.SetText("")
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *ImageManifestAddDialog) HasFocus() bool { //nolint:cyclop
+	if d.images.HasFocus() || d.all.HasFocus() {
+		return true
+	}
+
+	if d.os.HasFocus() || d.arch.HasFocus() {
+		return true
+	}
+
+	if d.variant.HasFocus() || d.annotations.HasFocus() {
+		return true
+	}
+
+	if d.skipTLSVerify.HasFocus() || d.authFile.HasFocus() {
+		return true
+	}
+
+	if d.username.HasFocus() || d.password.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus() || d.form.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *ImageManifestAddDialog) Focus(delegate func(p tview.Primitive)) { //nolint:cyclop
+	switch d.focusElement {
+	case manifestAddImagesFocus:
+		delegate(d.images)
+	case manifestAddAllFocus:
+		delegate(d.all)
+	case manifestAddOSFocus:
+		delegate(d.os)
+	case manifestAddArchFocus:
+		delegate(d.arch)
+	case manifestAddVariantFocus:
+		delegate(d.variant)
+	case manifestAddAnnotationsFocus:
+		delegate(d.annotations)
+	case manifestAddSkipTLSVerifyFocus:
+		delegate(d.skipTLSVerify)
+	case manifestAddUsernameFocus:
+		delegate(d.username)
+	case manifestAddPasswordFocus:
+		delegate(d.password)
+	case manifestAddAuthFileFocus:
+		delegate(d.authFile)
+	case manifestAddFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = manifestAddImagesFocus
+				d.Focus(delegate)
+				d.form.SetFocus(0)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *ImageManifestAddDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) { //nolint:gocognit,lll,cyclop
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("image manifest add dialog: event %v received", event)
+
+		if event.Key() == utils.SwitchFocusKey.Key {
+			d.setFocusElement()
+		}
+
+		if event.Key() == tcell.KeyEsc {
+			d.cancelHandler()
+
+			return
+		}
+
+		if d.images.HasFocus() {
+			if imagesHandler := d.images.InputHandler(); imagesHandler != nil {
+				imagesHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.all.HasFocus() {
+			if allHandler := d.all.InputHandler(); allHandler != nil {
+				allHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.os.HasFocus() {
+			if osHandler := d.os.InputHandler(); osHandler != nil {
+				osHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.arch.HasFocus() {
+			if archHandler := d.arch.InputHandler(); archHandler != nil {
+				archHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.variant.HasFocus() {
+			if variantHandler := d.variant.InputHandler(); variantHandler != nil {
+				variantHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.annotations.HasFocus() {
+			if annotationsHandler := d.annotations.InputHandler(); annotationsHandler != nil {
+				annotationsHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.skipTLSVerify.HasFocus() {
+			if skipTLSVerifyHandler := d.skipTLSVerify.InputHandler(); skipTLSVerifyHandler != nil {
+				skipTLSVerifyHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.username.HasFocus() {
+			if usernameHandler := d.username.InputHandler(); usernameHandler != nil {
+				usernameHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.password.HasFocus() {
+			if passwordHandler := d.password.InputHandler(); passwordHandler != nil {
+				passwordHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.authFile.HasFocus() {
+			if authFileHandler := d.authFile.InputHandler(); authFileHandler != nil {
+				authFileHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+func (d *ImageManifestAddDialog) setFocusElement() { //nolint:cyclop
+	switch d.focusElement {
+	case manifestAddImagesFocus:
+		d.focusElement = manifestAddAllFocus
+	case manifestAddAllFocus:
+		d.focusElement = manifestAddOSFocus
+	case manifestAddOSFocus:
+		d.focusElement = manifestAddArchFocus
+	case manifestAddArchFocus:
+		d.focusElement = manifestAddVariantFocus
+	case manifestAddVariantFocus:
+		d.focusElement = manifestAddAnnotationsFocus
+	case manifestAddAnnotationsFocus:
+		d.focusElement = manifestAddSkipTLSVerifyFocus
+	case manifestAddSkipTLSVerifyFocus:
+		d.focusElement = manifestAddUsernameFocus
+	case manifestAddUsernameFocus:
+		d.focusElement = manifestAddPasswordFocus
+	case manifestAddPasswordFocus:
+		d.focusElement = manifestAddAuthFileFocus
+	case manifestAddAuthFileFocus:
+		d.focusElement = manifestAddFormFocus
+	}
+}
+
+// SetRect set rects for this primitive.
+func (d *ImageManifestAddDialog) SetRect(x, y, width, height int) {
+	if width > manifestAddDialogMaxWidth {
+		emptySpace := (width - manifestAddDialogMaxWidth) / 2 //nolint:gomnd
+		x += emptySpace
+		width = manifestAddDialogMaxWidth
+	}
+
+	if height > manifestAddDialogMaxHeight {
+		emptySpace := (height - manifestAddDialogMaxHeight) / 2 //nolint:gomnd
+		y += emptySpace
+		height = manifestAddDialogMaxHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *ImageManifestAddDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.Box.DrawForSubclass(screen, d)
+	x, y, width, height := d.Box.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetAddFunc sets form add button selected function.
+func (d *ImageManifestAddDialog) SetAddFunc(handler func()) *ImageManifestAddDialog {
+	d.addHandler = handler
+	addButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+	addButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *ImageManifestAddDialog) SetCancelFunc(handler func()) *ImageManifestAddDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 2) //nolint:gomnd
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetManifestInfo sets selected manifest list ID and name in add dialog.
+func (d *ImageManifestAddDialog) SetManifestInfo(id string, name string) {
+	manifestInfo := fmt.Sprintf("%12s (%s)", id, name)
+	d.manifestInfo.SetText(manifestInfo)
+}
+
+// GetManifestAddOptions returns manifest list add options based on user inputs.
+func (d *ImageManifestAddDialog) GetManifestAddOptions() (images.ManifestAddOptions, error) {
+	opts := images.ManifestAddOptions{
+		Images:        strings.Fields(d.images.GetText()),
+		All:           d.all.IsChecked(),
+		OS:            strings.TrimSpace(d.os.GetText()),
+		Arch:          strings.TrimSpace(d.arch.GetText()),
+		Variant:       strings.TrimSpace(d.variant.GetText()),
+		SkipTLSVerify: d.skipTLSVerify.IsChecked(),
+		AuthFile:      strings.TrimSpace(d.authFile.GetText()),
+		Username:      strings.TrimSpace(d.username.GetText()),
+		Password:      strings.TrimSpace(d.password.GetText()),
+	}
+
+	for _, annotation := range strings.Fields(d.annotations.GetText()) {
+		key, value, found := strings.Cut(annotation, "=")
+		if !found || key == "" {
+			return opts, fmt.Errorf("%w: %q", errInvalidManifestAnnotation, annotation)
+		}
+
+		if opts.Annotations == nil {
+			opts.Annotations = make(map[string]string)
+		}
+
+		opts.Annotations[key] = value
+	}
+
+	return opts, nil
+}
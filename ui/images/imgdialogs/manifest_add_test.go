@@ -0,0 +1,132 @@
+package imgdialogs
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("image manifest add", Ordered, func() {
+	var manifestAddDialogApp *tview.Application
+	var manifestAddDialogScreen tcell.SimulationScreen
+	var manifestAddDialog *ImageManifestAddDialog
+	var runApp func()
+
+	BeforeAll(func() {
+		manifestAddDialogApp = tview.NewApplication()
+		manifestAddDialog = NewImageManifestAddDialog()
+		manifestAddDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := manifestAddDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := manifestAddDialogApp.SetScreen(manifestAddDialogScreen).SetRoot(manifestAddDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		manifestAddDialog.Display()
+		Expect(manifestAddDialog.IsDisplay()).To(Equal(true))
+	})
+
+	It("set focus", func() {
+		manifestAddDialogApp.SetFocus(manifestAddDialog)
+		Expect(manifestAddDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("set manifest info", func() {
+		manifestID := "manifestID"
+		manifestName := "manifestName"
+		manifestInfoWants := fmt.Sprintf("%12s (%s)", manifestID, manifestName)
+		manifestAddDialog.SetManifestInfo(manifestID, manifestName)
+		Expect(manifestAddDialog.manifestInfo.GetText()).To(Equal(manifestInfoWants))
+	})
+
+	It("get manifest add options", func() {
+		manifestAddDialog.images.SetText("quay.io/app:arm64")
+		manifestAddDialog.os.SetText("linux")
+		manifestAddDialog.arch.SetText("arm64")
+		manifestAddDialog.variant.SetText("v8")
+		manifestAddDialog.annotations.SetText("org.test.a=1 org.test.b=")
+		manifestAddDialog.skipTLSVerify.SetChecked(true)
+		manifestAddDialog.username.SetText("user")
+		manifestAddDialog.password.SetText("pass")
+		manifestAddDialog.authFile.SetText("/tmp/auth.json")
+
+		opts, err := manifestAddDialog.GetManifestAddOptions()
+		Expect(err).To(BeNil())
+		Expect(opts.Images).To(Equal([]string{"quay.io/app:arm64"}))
+		Expect(opts.All).To(Equal(false))
+		Expect(opts.OS).To(Equal("linux"))
+		Expect(opts.Arch).To(Equal("arm64"))
+		Expect(opts.Variant).To(Equal("v8"))
+		Expect(opts.Annotations).To(Equal(map[string]string{"org.test.a": "1", "org.test.b": ""}))
+		Expect(opts.SkipTLSVerify).To(Equal(true))
+		Expect(opts.Username).To(Equal("user"))
+		Expect(opts.Password).To(Equal("pass"))
+		Expect(opts.AuthFile).To(Equal("/tmp/auth.json"))
+	})
+
+	It("invalid annotation", func() {
+		manifestAddDialog.annotations.SetText("org.test.a")
+
+		_, err := manifestAddDialog.GetManifestAddOptions()
+		Expect(err).To(MatchError(errInvalidManifestAnnotation))
+	})
+
+	It("add button selected", func() {
+		addButton := "initial"
+		addButtonWants := "add selected"
+		addFunc := func() {
+			addButton = addButtonWants
+		}
+		manifestAddDialog.Hide()
+		manifestAddDialogApp.Draw()
+		manifestAddDialog.SetAddFunc(addFunc)
+		manifestAddDialog.Display()
+		manifestAddDialogApp.Draw()
+		manifestAddDialogApp.SetFocus(manifestAddDialog.form)
+		manifestAddDialogApp.Draw()
+		manifestAddDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		manifestAddDialogApp.Draw()
+		manifestAddDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		manifestAddDialogApp.Draw()
+		Expect(addButton).To(Equal(addButtonWants))
+	})
+
+	It("cancel button selected", func() {
+		cancelButton := "initial"
+		cancelButtonWants := "cancel selected"
+		cancelFunc := func() {
+			cancelButton = cancelButtonWants
+		}
+		manifestAddDialog.SetCancelFunc(cancelFunc)
+		manifestAddDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone))
+		manifestAddDialogApp.Draw()
+		Expect(cancelButton).To(Equal(cancelButtonWants))
+	})
+
+	It("hide", func() {
+		manifestAddDialog.Hide()
+		Expect(manifestAddDialog.IsDisplay()).To(Equal(false))
+		Expect(manifestAddDialog.manifestInfo.GetText()).To(Equal(""))
+
+		opts, err := manifestAddDialog.GetManifestAddOptions()
+		Expect(err).To(BeNil())
+		Expect(opts.Images).To(BeEmpty())
+		Expect(opts.Annotations).To(BeNil())
+	})
+
+	AfterAll(func() {
+		manifestAddDialogApp.Stop()
+	})
+})
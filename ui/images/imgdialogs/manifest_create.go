@@ -0,0 +1,287 @@
+package imgdialogs
+
+import (
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/images"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	manifestCreateDialogMaxWidth = 80
+	manifestCreateDialogHeight   = 11
+)
+
+const (
+	manifestCreateNameFocus = 0 + iota
+	manifestCreateImagesFocus
+	manifestCreateAllFocus
+	manifestCreateFormFocus
+)
+
+// ImageManifestCreateDialog represents manifest list create dialog primitive.
+type ImageManifestCreateDialog struct {
+	*tview.Box
+	layout        *tview.Flex
+	name          *tview.InputField
+	images        *tview.InputField
+	all           *tview.Checkbox
+	form          *tview.Form
+	display       bool
+	focusElement  int
+	createHandler func()
+	cancelHandler func()
+}
+
+// NewImageManifestCreateDialog returns new manifest list create dialog primitive.
+func NewImageManifestCreateDialog() *ImageManifestCreateDialog {
+	dialog := &ImageManifestCreateDialog{
+		Box:    tview.NewBox(),
+		layout: tview.NewFlex().SetDirection(tview.FlexRow),
+		name:   tview.NewInputField(),
+		images: tview.NewInputField(),
+		all:    tview.NewCheckbox(),
+		form:   tview.NewForm(),
+	}
+
+	bgColor := style.DialogBgColor
+	fgColor := style.DialogFgColor
+	inputFieldBgColor := style.InputFieldBgColor
+	labelWidth := 8
+
+	// name input field
+	dialog.name.SetBackgroundColor(bgColor)
+	dialog.name.SetLabelColor(fgColor)
+	dialog.name.SetLabel("name:")
+	dialog.name.SetLabelWidth(labelWidth)
+	dialog.name.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// images input field
+	dialog.images.SetBackgroundColor(bgColor)
+	dialog.images.SetLabelColor(fgColor)
+	dialog.images.SetLabel("images:")
+	dialog.images.SetLabelWidth(labelWidth)
+	dialog.images.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// all checkbox
+	dialog.all.SetBackgroundColor(bgColor)
+	dialog.all.SetLabelColor(fgColor)
+	dialog.all.SetLabel("all:")
+	dialog.all.SetLabelWidth(labelWidth)
+	dialog.all.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// form
+	dialog.form.AddButton("Cancel", nil)
+	dialog.form.AddButton("Create", nil)
+	dialog.form.SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	inputLayout := tview.NewFlex().SetDirection(tview.FlexRow)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.name, 1, 0, true)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.images, 1, 0, true)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.all, 1, 0, true)
+
+	mainLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	mainLayout.SetBackgroundColor(bgColor)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainLayout.AddItem(inputLayout, 0, 1, true)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetTitle("PODMAN MANIFEST CREATE")
+	dialog.layout.AddItem(mainLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, dialogs.DialogFormHeight, 0, true)
+
+	dialog.Hide()
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *ImageManifestCreateDialog) Display() {
+	d.display = true
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *ImageManifestCreateDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *ImageManifestCreateDialog) Hide() {
+	d.display = false
+	d.focusElement = manifestCreateNameFocus
+
+	d.name.SetText("")
+	d.images.SetText("")
+	d.all.SetChecked(false)
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *ImageManifestCreateDialog) HasFocus() bool {
+	if d.name.HasFocus() || d.images.HasFocus() {
+		return true
+	}
+
+	if d.all.HasFocus() || d.form.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *ImageManifestCreateDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case manifestCreateNameFocus:
+		delegate(d.name)
+	case manifestCreateImagesFocus:
+		delegate(d.images)
+	case manifestCreateAllFocus:
+		delegate(d.all)
+	case manifestCreateFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = manifestCreateNameFocus
+				d.Focus(delegate)
+				d.form.SetFocus(0)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *ImageManifestCreateDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("image manifest create dialog: event %v received", event)
+
+		if event.Key() == utils.SwitchFocusKey.Key {
+			d.setFocusElement()
+		}
+
+		if event.Key() == tcell.KeyEsc {
+			d.cancelHandler()
+
+			return
+		}
+
+		if d.name.HasFocus() {
+			if nameHandler := d.name.InputHandler(); nameHandler != nil {
+				nameHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.images.HasFocus() {
+			if imagesHandler := d.images.InputHandler(); imagesHandler != nil {
+				imagesHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.all.HasFocus() {
+			if allHandler := d.all.InputHandler(); allHandler != nil {
+				allHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+func (d *ImageManifestCreateDialog) setFocusElement() {
+	switch d.focusElement {
+	case manifestCreateNameFocus:
+		d.focusElement = manifestCreateImagesFocus
+	case manifestCreateImagesFocus:
+		d.focusElement = manifestCreateAllFocus
+	case manifestCreateAllFocus:
+		d.focusElement = manifestCreateFormFocus
+	}
+}
+
+// SetRect set rects for this primitive.
+func (d *ImageManifestCreateDialog) SetRect(x, y, width, height int) {
+	if width > manifestCreateDialogMaxWidth {
+		emptySpace := (width - manifestCreateDialogMaxWidth) / 2 //nolint:gomnd
+		x += emptySpace
+		width = manifestCreateDialogMaxWidth
+	}
+
+	if height > manifestCreateDialogHeight {
+		emptySpace := (height - manifestCreateDialogHeight) / 2 //nolint:gomnd
+		y += emptySpace
+		height = manifestCreateDialogHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *ImageManifestCreateDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.Box.DrawForSubclass(screen, d)
+	x, y, width, height := d.Box.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetCreateFunc sets form create button selected function.
+func (d *ImageManifestCreateDialog) SetCreateFunc(handler func()) *ImageManifestCreateDialog {
+	d.createHandler = handler
+	createButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+	createButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *ImageManifestCreateDialog) SetCancelFunc(handler func()) *ImageManifestCreateDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 2) //nolint:gomnd
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// GetManifestCreateOptions returns manifest list create options based on user inputs.
+func (d *ImageManifestCreateDialog) GetManifestCreateOptions() images.ManifestCreateOptions {
+	return images.ManifestCreateOptions{
+		Name:   strings.TrimSpace(d.name.GetText()),
+		Images: strings.Fields(d.images.GetText()),
+		All:    d.all.IsChecked(),
+	}
+}
@@ -0,0 +1,100 @@
+package imgdialogs
+
+import (
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("image manifest create", Ordered, func() {
+	var manifestCreateDialogApp *tview.Application
+	var manifestCreateDialogScreen tcell.SimulationScreen
+	var manifestCreateDialog *ImageManifestCreateDialog
+	var runApp func()
+
+	BeforeAll(func() {
+		manifestCreateDialogApp = tview.NewApplication()
+		manifestCreateDialog = NewImageManifestCreateDialog()
+		manifestCreateDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := manifestCreateDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := manifestCreateDialogApp.SetScreen(manifestCreateDialogScreen).SetRoot(manifestCreateDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		manifestCreateDialog.Display()
+		Expect(manifestCreateDialog.IsDisplay()).To(Equal(true))
+	})
+
+	It("set focus", func() {
+		manifestCreateDialogApp.SetFocus(manifestCreateDialog)
+		Expect(manifestCreateDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("get manifest create options", func() {
+		manifestCreateDialog.name.SetText(" localhost/app:latest ")
+		manifestCreateDialog.images.SetText("quay.io/app:amd64  quay.io/app:arm64")
+		manifestCreateDialog.all.SetChecked(true)
+
+		opts := manifestCreateDialog.GetManifestCreateOptions()
+		Expect(opts.Name).To(Equal("localhost/app:latest"))
+		Expect(opts.Images).To(Equal([]string{"quay.io/app:amd64", "quay.io/app:arm64"}))
+		Expect(opts.All).To(Equal(true))
+	})
+
+	It("create button selected", func() {
+		createButton := "initial"
+		createButtonWants := "create selected"
+		createFunc := func() {
+			createButton = createButtonWants
+		}
+		manifestCreateDialog.Hide()
+		manifestCreateDialogApp.Draw()
+		manifestCreateDialog.SetCreateFunc(createFunc)
+		manifestCreateDialog.Display()
+		manifestCreateDialogApp.Draw()
+		manifestCreateDialogApp.SetFocus(manifestCreateDialog.form)
+		manifestCreateDialogApp.Draw()
+		manifestCreateDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		manifestCreateDialogApp.Draw()
+		manifestCreateDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		manifestCreateDialogApp.Draw()
+		Expect(createButton).To(Equal(createButtonWants))
+	})
+
+	It("cancel button selected", func() {
+		cancelButton := "initial"
+		cancelButtonWants := "cancel selected"
+		cancelFunc := func() {
+			cancelButton = cancelButtonWants
+		}
+		manifestCreateDialog.SetCancelFunc(cancelFunc)
+		manifestCreateDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone))
+		manifestCreateDialogApp.Draw()
+		Expect(cancelButton).To(Equal(cancelButtonWants))
+	})
+
+	It("hide", func() {
+		manifestCreateDialog.Hide()
+		Expect(manifestCreateDialog.IsDisplay()).To(Equal(false))
+
+		opts := manifestCreateDialog.GetManifestCreateOptions()
+		Expect(opts.Name).To(Equal(""))
+		Expect(opts.Images).To(BeEmpty())
+		Expect(opts.All).To(Equal(false))
+	})
+
+	AfterAll(func() {
+		manifestCreateDialogApp.Stop()
+	})
+})
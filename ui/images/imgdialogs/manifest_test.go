@@ -0,0 +1,134 @@
+package imgdialogs
+
+import (
+	"github.com/containers/podman-tui/pdcs/images"
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("image manifest", Ordered, func() {
+	var manifestDialogApp *tview.Application
+	var manifestDialogScreen tcell.SimulationScreen
+	var manifestDialog *ImageManifestDialog
+	var runApp func()
+
+	manifests := []images.ManifestListReporter{
+		{ID: "0123456789abcdef", Name: "localhost/app:latest", Created: "2 hours ago"},
+		{ID: "fedcba9876543210", Name: "localhost/web:latest", Created: "3 hours ago"},
+	}
+
+	platforms := []images.ManifestPlatformReporter{
+		{Digest: "sha256:aaaa", OS: "linux", Arch: "amd64", Size: "1.2kB"},
+		{Digest: "sha256:bbbb", OS: "linux", Arch: "arm64", Variant: "v8", Size: "1.2kB"},
+	}
+
+	BeforeAll(func() {
+		manifestDialogApp = tview.NewApplication()
+		manifestDialog = NewImageManifestDialog()
+		manifestDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := manifestDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := manifestDialogApp.SetScreen(manifestDialogScreen).SetRoot(manifestDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		manifestDialog.Display()
+		Expect(manifestDialog.IsDisplay()).To(Equal(true))
+	})
+
+	It("set focus", func() {
+		manifestDialogApp.SetFocus(manifestDialog)
+		Expect(manifestDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("set manifests", func() {
+		selectionChanged := 0
+		manifestDialog.SetSelectionChangedFunc(func() {
+			selectionChanged++
+		})
+		manifestDialog.SetManifests(manifests)
+		Expect(manifestDialog.manifestsTable.GetRowCount()).To(Equal(len(manifests) + 1))
+		Expect(selectionChanged).To(Equal(0))
+
+		id, name := manifestDialog.GetSelectedManifest()
+		Expect(id).To(Equal(manifests[0].ID))
+		Expect(name).To(Equal(manifests[0].Name))
+
+		manifestDialog.manifestsTable.Select(2, 0)
+		Expect(selectionChanged).To(Equal(1))
+
+		// keeps selected manifest list
+		manifestDialog.SetManifests(manifests)
+		_, name = manifestDialog.GetSelectedManifest()
+		Expect(name).To(Equal(manifests[1].Name))
+	})
+
+	It("set platforms", func() {
+		manifestDialog.SetPlatforms(platforms)
+		Expect(manifestDialog.platformsTable.GetRowCount()).To(Equal(len(platforms) + 1))
+
+		digest, platform := manifestDialog.GetSelectedPlatform()
+		Expect(digest).To(Equal(platforms[0].Digest))
+		Expect(platform).To(Equal("linux/amd64"))
+
+		manifestDialog.platformsTable.Select(2, 0)
+		digest, platform = manifestDialog.GetSelectedPlatform()
+		Expect(digest).To(Equal(platforms[1].Digest))
+		Expect(platform).To(Equal("linux/arm64/v8"))
+	})
+
+	It("cancel button selected", func() {
+		cancelButton := "initial"
+		cancelButtonWants := "cancel selected"
+		cancelFunc := func() {
+			cancelButton = cancelButtonWants
+		}
+		manifestDialog.SetCancelFunc(cancelFunc)
+		manifestDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone))
+		manifestDialogApp.Draw()
+		Expect(cancelButton).To(Equal(cancelButtonWants))
+	})
+
+	It("create button selected", func() {
+		createButton := "initial"
+		createButtonWants := "create selected"
+		createFunc := func() {
+			createButton = createButtonWants
+		}
+		manifestDialog.SetCreateFunc(createFunc)
+		manifestDialogApp.Draw()
+		manifestDialogApp.SetFocus(manifestDialog.form)
+		manifestDialogApp.Draw()
+		manifestDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		manifestDialogApp.Draw()
+		Expect(createButton).To(Equal(createButtonWants))
+	})
+
+	It("hide", func() {
+		manifestDialog.Hide()
+		Expect(manifestDialog.IsDisplay()).To(Equal(false))
+		Expect(manifestDialog.manifestsTable.GetRowCount()).To(Equal(1))
+		Expect(manifestDialog.platformsTable.GetRowCount()).To(Equal(1))
+
+		id, _ := manifestDialog.GetSelectedManifest()
+		Expect(id).To(Equal(""))
+
+		digest, _ := manifestDialog.GetSelectedPlatform()
+		Expect(digest).To(Equal(""))
+	})
+
+	AfterAll(func() {
+		manifestDialogApp.Stop()
+	})
+})
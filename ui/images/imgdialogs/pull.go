@@ -0,0 +1,400 @@
+package imgdialogs
+
+import (
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/images"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	imagePullDialogMaxWidth  = 90
+	imagePullDialogMaxHeight = 15
+)
+
+const (
+	imagePullNameFocus = 0 + iota
+	imagePullPlatformFocus
+	imagePullSkipTLSVerifyFocus
+	imagePullUsernameFocus
+	imagePullPasswordFocus
+	imagePullAuthFileFocus
+	imagePullFormFocus
+)
+
+// ImagePullDialog represents image pull dialog primitive.
+type ImagePullDialog struct {
+	*tview.Box
+	layout        *tview.Flex
+	imageName     *tview.InputField
+	platform      *tview.InputField
+	skipTLSVerify *tview.Checkbox
+	authFile      *tview.InputField
+	username      *tview.InputField
+	password      *tview.InputField
+	form          *tview.Form
+	display       bool
+	pullHandler   func()
+	cancelHandler func()
+	focusElement  int
+}
+
+// NewImagePullDialog returns a new image pull dialog primitive.
+func NewImagePullDialog() *ImagePullDialog {
+	dialog := &ImagePullDialog{
+		Box:           tview.NewBox(),
+		layout:        tview.NewFlex(),
+		imageName:     tview.NewInputField(),
+		platform:      tview.NewInputField(),
+		skipTLSVerify: tview.NewCheckbox(),
+		authFile:      tview.NewInputField(),
+		username:      tview.NewInputField(),
+		password:      tview.NewInputField(),
+		form:          tview.NewForm(),
+	}
+
+	bgColor := style.DialogBgColor
+	fgColor := style.DialogFgColor
+	inputFieldBgColor := style.InputFieldBgColor
+	labelWidth := 13
+
+	// image name input field
+	dialog.imageName.SetBackgroundColor(bgColor)
+	dialog.imageName.SetLabelColor(fgColor)
+	dialog.imageName.SetLabel("image:")
+	dialog.imageName.SetLabelWidth(labelWidth)
+	dialog.imageName.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// platform input field
+	dialog.platform.SetBackgroundColor(bgColor)
+	dialog.platform.SetLabelColor(fgColor)
+	dialog.platform.SetLabel("platform:")
+	dialog.platform.SetLabelWidth(labelWidth)
+	dialog.platform.SetPlaceholder("os/arch[/variant]")
+	dialog.platform.SetPlaceholderTextColor(style.InfoBarItemFgColor)
+	dialog.platform.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// skipTLSVerify checkbox
+	skipTLSVerifyLabel := "skip tls verify:"
+
+	dialog.skipTLSVerify.SetBackgroundColor(bgColor)
+	dialog.skipTLSVerify.SetLabelColor(fgColor)
+	dialog.skipTLSVerify.SetLabel(skipTLSVerifyLabel)
+	dialog.skipTLSVerify.SetLabelWidth(len(skipTLSVerifyLabel) + 1)
+	dialog.skipTLSVerify.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// authfile input field
+	dialog.authFile.SetBackgroundColor(bgColor)
+	dialog.authFile.SetLabelColor(fgColor)
+	dialog.authFile.SetLabel("authfile:")
+	dialog.authFile.SetLabelWidth(labelWidth)
+	dialog.authFile.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// username input field
+	dialog.username.SetBackgroundColor(bgColor)
+	dialog.username.SetLabelColor(fgColor)
+	dialog.username.SetLabel("username:")
+	dialog.username.SetLabelWidth(labelWidth)
+	dialog.username.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// password input field
+	passwordLabel := "password:"
+
+	dialog.password.SetBackgroundColor(bgColor)
+	dialog.password.SetLabelColor(fgColor)
+	dialog.password.SetLabel(passwordLabel)
+	dialog.password.SetLabelWidth(len(passwordLabel) + 1)
+	dialog.password.SetFieldBackgroundColor(inputFieldBgColor)
+	dialog.password.SetMaskCharacter('*')
+
+	// form
+	dialog.form.AddButton("Cancel", nil)
+	dialog.form.AddButton("Pull", nil)
+	dialog.form.SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	// platform and skip tls verify row layout
+	platformLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	platformLayout.AddItem(dialog.platform, 0, 1, true)
+	platformLayout.AddItem(utils.EmptyBoxSpace(bgColor), 3, 0, false)                //nolint:gomnd
+	platformLayout.AddItem(dialog.skipTLSVerify, len(skipTLSVerifyLabel)+2, 0, true) //nolint:gomnd
+
+	// username and password row layout
+	userPassLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	userPassLayout.AddItem(dialog.username, 0, 1, true)
+	userPassLayout.AddItem(utils.EmptyBoxSpace(bgColor), 3, 0, false) //nolint:gomnd
+	userPassLayout.AddItem(dialog.password, 0, 1, true)
+
+	layout := tview.NewFlex().SetDirection(tview.FlexRow)
+	layout.AddItem(utils.EmptyBoxSpace(bgColor), 0, 1, false)
+	layout.AddItem(dialog.imageName, 0, 1, true)
+	layout.AddItem(utils.EmptyBoxSpace(bgColor), 0, 1, false)
+	layout.AddItem(platformLayout, 0, 1, true)
+	layout.AddItem(utils.EmptyBoxSpace(bgColor), 0, 1, false)
+	layout.AddItem(userPassLayout, 0, 1, true)
+	layout.AddItem(utils.EmptyBoxSpace(bgColor), 0, 1, false)
+	layout.AddItem(dialog.authFile, 0, 1, true)
+
+	inputLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	inputLayout.SetBackgroundColor(bgColor)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(layout, 0, 1, true)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout.SetDirection(tview.FlexRow)
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetTitle("PODMAN IMAGE PULL")
+	dialog.layout.AddItem(inputLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, dialogs.DialogFormHeight, 0, true)
+
+	dialog.Hide()
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *ImagePullDialog) Display() {
+	d.display = true
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *ImagePullDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *ImagePullDialog) Hide() {
+	d.display = false
+	d.focusElement = imagePullNameFocus
+
+	d.imageName.SetText("")
+	d.platform.SetText("")
+	d.skipTLSVerify.SetChecked(false)
+	d.authFile.SetText("")
+	d.username.SetText("")
+	d.password.SetText("")
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *ImagePullDialog) HasFocus() bool {
+	if d.imageName.HasFocus() || d.platform.HasFocus() {
+		return true
+	}
+
+	if d.skipTLSVerify.HasFocus() || d.username.HasFocus() {
+		return true
+	}
+
+	if d.password.HasFocus() || d.authFile.HasFocus() {
+		return true
+	}
+
+	if d.form.HasFocus() || d.layout.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *ImagePullDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case imagePullNameFocus:
+		delegate(d.imageName)
+	case imagePullPlatformFocus:
+		delegate(d.platform)
+	case imagePullSkipTLSVerifyFocus:
+		delegate(d.skipTLSVerify)
+	case imagePullUsernameFocus:
+		delegate(d.username)
+	case imagePullPasswordFocus:
+		delegate(d.password)
+	case imagePullAuthFileFocus:
+		delegate(d.authFile)
+	case imagePullFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = imagePullNameFocus
+				d.Focus(delegate)
+				d.form.SetFocus(0)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *ImagePullDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) { //nolint:gocognit,lll,cyclop
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("image pull dialog: event %v received", event)
+
+		if event.Key() == utils.SwitchFocusKey.Key {
+			d.setFocusElement()
+		}
+
+		if event.Key() == tcell.KeyEsc {
+			d.cancelHandler()
+
+			return
+		}
+
+		if d.imageName.HasFocus() {
+			if imageNameHandler := d.imageName.InputHandler(); imageNameHandler != nil {
+				imageNameHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.platform.HasFocus() {
+			if platformHandler := d.platform.InputHandler(); platformHandler != nil {
+				platformHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.skipTLSVerify.HasFocus() {
+			if skipTLSVerifyHandler := d.skipTLSVerify.InputHandler(); skipTLSVerifyHandler != nil {
+				skipTLSVerifyHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.username.HasFocus() {
+			if usernameHandler := d.username.InputHandler(); usernameHandler != nil {
+				usernameHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.password.HasFocus() {
+			if passwordHandler := d.password.InputHandler(); passwordHandler != nil {
+				passwordHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.authFile.HasFocus() {
+			if authFileHandler := d.authFile.InputHandler(); authFileHandler != nil {
+				authFileHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+func (d *ImagePullDialog) setFocusElement() {
+	switch d.focusElement {
+	case imagePullNameFocus:
+		d.focusElement = imagePullPlatformFocus
+	case imagePullPlatformFocus:
+		d.focusElement = imagePullSkipTLSVerifyFocus
+	case imagePullSkipTLSVerifyFocus:
+		d.focusElement = imagePullUsernameFocus
+	case imagePullUsernameFocus:
+		d.focusElement = imagePullPasswordFocus
+	case imagePullPasswordFocus:
+		d.focusElement = imagePullAuthFileFocus
+	case imagePullAuthFileFocus:
+		d.focusElement = imagePullFormFocus
+	}
+}
+
+// SetRect set rects for this primitive.
+func (d *ImagePullDialog) SetRect(x, y, width, height int) {
+	if width > imagePullDialogMaxWidth {
+		emptySpace := (width - imagePullDialogMaxWidth) / 2 //nolint:gomnd
+		x += emptySpace
+		width = imagePullDialogMaxWidth
+	}
+
+	if height > imagePullDialogMaxHeight {
+		emptySpace := (height - imagePullDialogMaxHeight) / 2 //nolint:gomnd
+		y += emptySpace
+		height = imagePullDialogMaxHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *ImagePullDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.Box.DrawForSubclass(screen, d)
+	x, y, width, height := d.Box.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetPullFunc sets form pull button selected function.
+func (d *ImagePullDialog) SetPullFunc(handler func()) *ImagePullDialog {
+	d.pullHandler = handler
+	pullButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+	pullButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *ImagePullDialog) SetCancelFunc(handler func()) *ImagePullDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 2) //nolint:gomnd
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetImageName sets the image name to pull.
+func (d *ImagePullDialog) SetImageName(name string) {
+	d.imageName.SetText(name)
+}
+
+// GetImageName returns the image name to pull.
+func (d *ImagePullDialog) GetImageName() string {
+	return strings.TrimSpace(d.imageName.GetText())
+}
+
+// GetImagePullOptions returns image pull options based on user inputs.
+func (d *ImagePullDialog) GetImagePullOptions() images.ImagePullOptions {
+	var opts images.ImagePullOptions
+
+	opts.Platform = strings.TrimSpace(d.platform.GetText())
+	opts.SkipTLSVerify = d.skipTLSVerify.IsChecked()
+	opts.Username = strings.TrimSpace(d.username.GetText())
+	opts.Password = strings.TrimSpace(d.password.GetText())
+	opts.AuthFile = strings.TrimSpace(d.authFile.GetText())
+
+	return opts
+}
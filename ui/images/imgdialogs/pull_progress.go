@@ -0,0 +1,231 @@
+package imgdialogs
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/containers/podman-tui/pdcs/images"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/docker/go-units"
+	"github.com/gdamore/tcell/v2"
+	"github.com/navidys/tvxwidgets"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	pullPrgDialogMaxWidth = 100
+	pullPrgDialogHeight   = 14
+	// pullPrgGaugeMaxValue is the progress gauge max value (the downloaded bytes are scaled).
+	pullPrgGaugeMaxValue = 1000
+)
+
+// ImagePullProgressDialog implements image pull progress dialog primitive.
+type ImagePullProgressDialog struct {
+	*tview.Box
+	layout        *tview.Flex
+	imageName     *tview.InputField
+	progressBar   *tvxwidgets.PercentageModeGauge
+	info          *tview.TextView
+	form          *tview.Form
+	display       bool
+	mu            sync.Mutex
+	cancelHandler func()
+}
+
+// NewImagePullProgressDialog returns new image pull progress dialog.
+func NewImagePullProgressDialog() *ImagePullProgressDialog {
+	dialog := &ImagePullProgressDialog{
+		Box:         tview.NewBox(),
+		layout:      tview.NewFlex().SetDirection(tview.FlexRow),
+		imageName:   tview.NewInputField(),
+		progressBar: tvxwidgets.NewPercentageModeGauge(),
+		info:        tview.NewTextView(),
+	}
+
+	bgColor := style.DialogBgColor
+	fgColor := style.DialogFgColor
+
+	// image name field
+	imageNameLabel := "IMAGE:"
+
+	dialog.imageName.SetBackgroundColor(bgColor)
+	dialog.imageName.SetLabel("[::b]" + imageNameLabel)
+	dialog.imageName.SetLabelWidth(len(imageNameLabel) + 1)
+	dialog.imageName.SetFieldBackgroundColor(bgColor)
+	dialog.imageName.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(fgColor))
+
+	// progressbar
+	dialog.progressBar.SetBorder(true)
+	dialog.progressBar.SetBorderColor(style.DialogSubBoxBorderColor)
+	dialog.progressBar.SetPgBgColor(style.PrgBarColor)
+	dialog.progressBar.SetMaxValue(pullPrgGaugeMaxValue)
+
+	// info
+	dialog.info.SetDynamicColors(true)
+	dialog.info.SetBackgroundColor(bgColor)
+	dialog.info.SetTextColor(fgColor)
+
+	// form
+	dialog.form = tview.NewForm().
+		AddButton("Cancel", nil).
+		SetButtonsAlign(tview.AlignRight)
+
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	infoLayout := tview.NewFlex().SetDirection(tview.FlexRow)
+	infoLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	infoLayout.AddItem(dialog.imageName, 1, 0, false)
+	infoLayout.AddItem(dialog.progressBar, 3, 0, false) //nolint:gomnd
+	infoLayout.AddItem(dialog.info, 0, 1, false)
+
+	mainLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainLayout.AddItem(infoLayout, 0, 1, false)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetTitle("PODMAN IMAGE PULL")
+	dialog.layout.AddItem(mainLayout, 0, 1, false)
+	dialog.layout.AddItem(dialog.form, dialogs.DialogFormHeight, 0, true)
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *ImagePullProgressDialog) Display() {
+	d.display = true
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *ImagePullProgressDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *ImagePullProgressDialog) Hide() {
+	d.display = false
+
+	d.mu.Lock()
+	d.imageName.SetText("")
+	d.info.SetText("")
+	d.progressBar.Reset()
+	d.mu.Unlock()
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *ImagePullProgressDialog) HasFocus() bool {
+	if d.layout.HasFocus() || d.form.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *ImagePullProgressDialog) Focus(delegate func(p tview.Primitive)) {
+	delegate(d.form)
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *ImagePullProgressDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("image pull progress dialog: event %v received", event)
+
+		if event.Key() == tcell.KeyEsc || event.Key() == tcell.KeyEnter {
+			d.cancelHandler()
+
+			return
+		}
+
+		if formHandler := d.form.InputHandler(); formHandler != nil {
+			formHandler(event, setFocus)
+
+			return
+		}
+	})
+}
+
+// SetRect set rects for this primitive.
+func (d *ImagePullProgressDialog) SetRect(x, y, width, height int) {
+	if width > pullPrgDialogMaxWidth {
+		emptySpace := (width - pullPrgDialogMaxWidth) / 2 //nolint:gomnd
+		x += emptySpace
+		width = pullPrgDialogMaxWidth
+	}
+
+	if height > pullPrgDialogHeight {
+		emptySpace := (height - pullPrgDialogHeight) / 2 //nolint:gomnd
+		y += emptySpace
+		height = pullPrgDialogHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *ImagePullProgressDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.Box.DrawForSubclass(screen, d)
+	x, y, width, height := d.Box.GetInnerRect()
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *ImagePullProgressDialog) SetCancelFunc(handler func()) *ImagePullProgressDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetImageName sets the pulled image name.
+func (d *ImagePullProgressDialog) SetImageName(name string) {
+	d.mu.Lock()
+	d.imageName.SetText(name)
+	d.mu.Unlock()
+}
+
+// UpdateProgress updates the dialog with the image pull progress report.
+func (d *ImagePullProgressDialog) UpdateProgress(progress images.ImagePullProgress) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	value := 0
+	if progress.Total > 0 {
+		value = int(progress.Current * pullPrgGaugeMaxValue / progress.Total)
+	} else if progress.Layers > 0 {
+		value = progress.LayersDone * pullPrgGaugeMaxValue / progress.Layers
+	}
+
+	d.progressBar.SetValue(value)
+
+	downloaded := "-"
+	if progress.Total > 0 {
+		downloaded = fmt.Sprintf("%s / %s", units.HumanSize(float64(progress.Current)), units.HumanSize(float64(progress.Total)))
+	}
+
+	labelColor := style.GetColorHex(style.DialogFgColor)
+	info := fmt.Sprintf("[%s::b]LAYERS:[-::-]     %d/%d\n", labelColor, progress.LayersDone, progress.Layers)
+	info += fmt.Sprintf("[%s::b]DOWNLOADED:[-::-] %s\n", labelColor, downloaded)
+	info += fmt.Sprintf("[%s::b]DIGEST:[-::-]     %s\n", labelColor, progress.Digest)
+	info += fmt.Sprintf("[%s::b]STATUS:[-::-]     %s", labelColor, tview.Escape(progress.Status))
+
+	d.info.SetText(info)
+}
@@ -0,0 +1,93 @@
+package imgdialogs
+
+import (
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/images"
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("image pull progress", Ordered, func() {
+	var pullPrgDialogApp *tview.Application
+	var pullPrgDialogScreen tcell.SimulationScreen
+	var pullPrgDialog *ImagePullProgressDialog
+	var runApp func()
+
+	BeforeAll(func() {
+		pullPrgDialogApp = tview.NewApplication()
+		pullPrgDialog = NewImagePullProgressDialog()
+		pullPrgDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := pullPrgDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := pullPrgDialogApp.SetScreen(pullPrgDialogScreen).SetRoot(pullPrgDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		pullPrgDialog.Display()
+		Expect(pullPrgDialog.IsDisplay()).To(Equal(true))
+	})
+
+	It("set focus", func() {
+		pullPrgDialogApp.SetFocus(pullPrgDialog)
+		Expect(pullPrgDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("update progress", func() {
+		pullPrgDialog.SetImageName("docker.io/library/alpine:latest")
+		pullPrgDialog.UpdateProgress(images.ImagePullProgress{
+			Status:     "Downloading",
+			Digest:     "a1b2c3d4e5f6",
+			Layers:     4,
+			LayersDone: 1,
+			Current:    250,
+			Total:      1000,
+		})
+		pullPrgDialogApp.Draw()
+
+		info := pullPrgDialog.info.GetText(true)
+		Expect(pullPrgDialog.imageName.GetText()).To(Equal("docker.io/library/alpine:latest"))
+		Expect(pullPrgDialog.progressBar.GetValue()).To(Equal(pullPrgGaugeMaxValue / 4))
+		Expect(strings.Contains(info, "1/4")).To(Equal(true))
+		Expect(strings.Contains(info, "a1b2c3d4e5f6")).To(Equal(true))
+	})
+
+	It("update progress without bytes", func() {
+		pullPrgDialog.UpdateProgress(images.ImagePullProgress{
+			Layers:     4,
+			LayersDone: 2,
+		})
+		Expect(pullPrgDialog.progressBar.GetValue()).To(Equal(pullPrgGaugeMaxValue / 2))
+	})
+
+	It("cancel key pressed", func() {
+		cancel := "initial"
+		cancelWants := "cancel"
+		pullPrgDialog.SetCancelFunc(func() {
+			cancel = cancelWants
+			pullPrgDialog.Hide()
+		})
+		pullPrgDialogApp.SetFocus(pullPrgDialog)
+		pullPrgDialogApp.Draw()
+		pullPrgDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone))
+		pullPrgDialogApp.Draw()
+		Expect(cancel).To(Equal(cancelWants))
+		Expect(pullPrgDialog.IsDisplay()).To(Equal(false))
+		Expect(pullPrgDialog.progressBar.GetValue()).To(Equal(0))
+	})
+
+	AfterAll(func() {
+		pullPrgDialogApp.Stop()
+	})
+})
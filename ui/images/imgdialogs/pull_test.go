@@ -0,0 +1,161 @@
+package imgdialogs
+
+import (
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("image pull", Ordered, func() {
+	var imagePullDialogApp *tview.Application
+	var imagePullDialogScreen tcell.SimulationScreen
+	var imagePullDialog *ImagePullDialog
+	var runApp func()
+
+	BeforeAll(func() {
+		imagePullDialogApp = tview.NewApplication()
+		imagePullDialog = NewImagePullDialog()
+		imagePullDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := imagePullDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := imagePullDialogApp.SetScreen(imagePullDialogScreen).SetRoot(imagePullDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		imagePullDialog.Display()
+		Expect(imagePullDialog.IsDisplay()).To(Equal(true))
+	})
+
+	It("set focus", func() {
+		imagePullDialogApp.SetFocus(imagePullDialog)
+		Expect(imagePullDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("set image name", func() {
+		imageName := "docker.io/library/alpine:latest"
+		imagePullDialog.SetImageName(imageName)
+		Expect(imagePullDialog.GetImageName()).To(Equal(imageName))
+	})
+
+	It("cancel button selected", func() {
+		cancelFunc := func() {
+			imagePullDialog.Hide()
+		}
+		imagePullDialog.Hide()
+		imagePullDialogApp.Draw()
+		imagePullDialog.SetCancelFunc(cancelFunc)
+		imagePullDialog.Display()
+		imagePullDialogApp.Draw()
+		imagePullDialogApp.SetFocus(imagePullDialog.form)
+		imagePullDialogApp.Draw()
+		imagePullDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		imagePullDialogApp.Draw()
+		Expect(imagePullDialog.IsDisplay()).To(Equal(false))
+		Expect(imagePullDialog.GetImageName()).To(Equal(""))
+	})
+
+	It("pull button selected", func() {
+		pullButton := "initial"
+		pullButtonWants := "pull selected"
+		pullFunc := func() {
+			pullButton = pullButtonWants
+		}
+		imagePullDialog.Hide()
+		imagePullDialogApp.Draw()
+		imagePullDialog.SetPullFunc(pullFunc)
+		imagePullDialog.Display()
+		imagePullDialogApp.Draw()
+		imagePullDialogApp.SetFocus(imagePullDialog.form)
+		imagePullDialogApp.Draw()
+		imagePullDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		imagePullDialogApp.Draw()
+		imagePullDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		imagePullDialogApp.Draw()
+		Expect(pullButton).To(Equal(pullButtonWants))
+	})
+
+	It("get pull options", func() {
+		opts := struct {
+			Name          string
+			Platform      string
+			SkipTLSVerify bool
+			Username      string
+			Password      string
+			Authfile      string
+		}{
+			Name:          "a", // (256,97,0)
+			Platform:      "b", // (256,98,0)
+			SkipTLSVerify: true,
+			Username:      "c", // (256,99,0)
+			Password:      "d", // (256,100,0)
+			Authfile:      "e", // (256,101,0)
+		}
+
+		imagePullDialog.Hide()
+		imagePullDialogApp.Draw()
+		imagePullDialog.Display()
+		// image name field
+		imagePullDialogApp.SetFocus(imagePullDialog)
+		imagePullDialogApp.Draw()
+		imagePullDialogApp.QueueEvent(tcell.NewEventKey(256, 97, tcell.ModNone))
+		imagePullDialogApp.Draw()
+		// platform field
+		imagePullDialog.setFocusElement()
+		imagePullDialogApp.Draw()
+		imagePullDialogApp.SetFocus(imagePullDialog)
+		imagePullDialogApp.Draw()
+		imagePullDialogApp.QueueEvent(tcell.NewEventKey(256, 98, tcell.ModNone))
+		imagePullDialogApp.Draw()
+		// skip TLS verify field
+		imagePullDialog.setFocusElement()
+		imagePullDialogApp.Draw()
+		imagePullDialogApp.SetFocus(imagePullDialog)
+		imagePullDialogApp.Draw()
+		imagePullDialogApp.QueueEvent(tcell.NewEventKey(256, 32, tcell.ModNone)) // space
+		imagePullDialogApp.Draw()
+		// username field
+		imagePullDialog.setFocusElement()
+		imagePullDialogApp.Draw()
+		imagePullDialogApp.SetFocus(imagePullDialog)
+		imagePullDialogApp.Draw()
+		imagePullDialogApp.QueueEvent(tcell.NewEventKey(256, 99, tcell.ModNone))
+		imagePullDialogApp.Draw()
+		// password field
+		imagePullDialog.setFocusElement()
+		imagePullDialogApp.Draw()
+		imagePullDialogApp.SetFocus(imagePullDialog)
+		imagePullDialogApp.Draw()
+		imagePullDialogApp.QueueEvent(tcell.NewEventKey(256, 100, tcell.ModNone))
+		imagePullDialogApp.Draw()
+		// authfile field
+		imagePullDialog.setFocusElement()
+		imagePullDialogApp.Draw()
+		imagePullDialogApp.SetFocus(imagePullDialog)
+		imagePullDialogApp.Draw()
+		imagePullDialogApp.QueueEvent(tcell.NewEventKey(256, 101, tcell.ModNone))
+		imagePullDialogApp.Draw()
+
+		// get and check pull options
+		pullOptions := imagePullDialog.GetImagePullOptions()
+		Expect(imagePullDialog.GetImageName()).To(Equal(opts.Name))
+		Expect(pullOptions.Platform).To(Equal(opts.Platform))
+		Expect(pullOptions.SkipTLSVerify).To(Equal(opts.SkipTLSVerify))
+		Expect(pullOptions.Username).To(Equal(opts.Username))
+		Expect(pullOptions.Password).To(Equal(opts.Password))
+		Expect(pullOptions.AuthFile).To(Equal(opts.Authfile))
+	})
+
+	AfterAll(func() {
+		imagePullDialogApp.Stop()
+	})
+})
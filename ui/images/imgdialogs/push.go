@@ -196,6 +196,15 @@ func NewImagePushDialog() *ImagePushDialog {
 	return dialog
 }
 
+// NewManifestPushDialog returns a new manifest list push dialog primitive.
+func NewManifestPushDialog() *ImagePushDialog {
+	dialog := NewImagePushDialog()
+	dialog.imageInfo.SetLabel("[::b]MANIFEST:")
+	dialog.layout.SetTitle("PODMAN MANIFEST PUSH")
+
+	return dialog
+}
+
 // Display displays this primitive.
 func (d *ImagePushDialog) Display() {
 	d.display = true
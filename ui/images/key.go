@@ -101,6 +101,55 @@ func (img *Images) InputHandler() func(event *tcell.EventKey, setFocus func(p tv
 			}
 		}
 
+		// pull dialog handler
+		if img.pullDialog.HasFocus() {
+			if pullDialogHandler := img.pullDialog.InputHandler(); pullDialogHandler != nil {
+				pullDialogHandler(event, setFocus)
+			}
+		}
+
+		// pull progress dialog handler
+		if img.pullPrgDialog.HasFocus() {
+			if pullPrgDialogHandler := img.pullPrgDialog.InputHandler(); pullPrgDialogHandler != nil {
+				pullPrgDialogHandler(event, setFocus)
+			}
+		}
+
+		// manifest dialog handler
+		if img.manifestDialog.HasFocus() {
+			if manifestDialogHandler := img.manifestDialog.InputHandler(); manifestDialogHandler != nil {
+				manifestDialogHandler(event, setFocus)
+			}
+		}
+
+		// manifest create dialog handler
+		if img.mCreateDialog.HasFocus() {
+			if mCreateDialogHandler := img.mCreateDialog.InputHandler(); mCreateDialogHandler != nil {
+				mCreateDialogHandler(event, setFocus)
+			}
+		}
+
+		// manifest add dialog handler
+		if img.mAddDialog.HasFocus() {
+			if mAddDialogHandler := img.mAddDialog.InputHandler(); mAddDialogHandler != nil {
+				mAddDialogHandler(event, setFocus)
+			}
+		}
+
+		// manifest push dialog handler
+		if img.mPushDialog.HasFocus() {
+			if mPushDialogHandler := img.mPushDialog.InputHandler(); mPushDialogHandler != nil {
+				mPushDialogHandler(event, setFocus)
+			}
+		}
+
+		// filter bar handler
+		if img.filterBar.HasFocus() {
+			if filterBarHandler := img.filterBar.InputHandler(); filterBarHandler != nil {
+				filterBarHandler(event, setFocus)
+			}
+		}
+
 		// table handlers
 		if img.table.HasFocus() { //nolint:nestif
 			img.selectedID, img.selectedName = img.getSelectedItem()
@@ -122,6 +171,44 @@ func (img *Images) InputHandler() func(event *tcell.EventKey, setFocus func(p tv
 				return
 			}
 
+			if event.Rune() == utils.MarkItemKey.Rune() {
+				img.markItem()
+
+				return
+			}
+
+			if event.Key() == utils.MarkAllKey.EventKey() {
+				img.markedItems.ToggleAll(img.getItems())
+
+				return
+			}
+
+			if event.Rune() == utils.MarkPatternKey.Rune() {
+				img.markPattern()
+				setFocus(img)
+
+				return
+			}
+
+			if event.Rune() == utils.FilterKey.Rune() {
+				img.filterBar.Display()
+				setFocus(img)
+
+				return
+			}
+
+			if event.Rune() == utils.SortColumnKey.Rune() {
+				img.tableSort.Next()
+
+				return
+			}
+
+			if event.Rune() == utils.SortOrderKey.Rune() {
+				img.tableSort.Reverse()
+
+				return
+			}
+
 			if tableHandler := img.table.InputHandler(); tableHandler != nil {
 				tableHandler(event, setFocus)
 			}
@@ -0,0 +1,245 @@
+package images
+
+import (
+	"fmt"
+
+	"github.com/containers/podman-tui/pdcs/images"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+)
+
+func (img *Images) manifests() {
+	img.manifestDialog.Display()
+	img.refreshManifests()
+}
+
+func (img *Images) refreshManifests() {
+	report, err := images.ManifestList()
+	if err != nil {
+		img.displayError("MANIFEST LIST ERROR", err)
+
+		return
+	}
+
+	img.manifestDialog.SetManifests(report)
+	img.manifestInspect()
+}
+
+func (img *Images) manifestInspect() {
+	id, name := img.manifestDialog.GetSelectedManifest()
+	if id == "" {
+		img.manifestDialog.SetPlatforms(nil)
+
+		return
+	}
+
+	platforms, err := images.ManifestInspect(id)
+	if err != nil {
+		title := fmt.Sprintf("MANIFEST (%s) INSPECT ERROR", name)
+		img.displayError(title, err)
+
+		return
+	}
+
+	img.manifestDialog.SetPlatforms(platforms)
+}
+
+func (img *Images) manifestCreate() {
+	createOpts := img.mCreateDialog.GetManifestCreateOptions()
+	img.mCreateDialog.Hide()
+	img.progressDialog.SetTitle("manifest create in progress")
+	img.progressDialog.Display()
+
+	create := func() {
+		_, err := images.ManifestCreate(createOpts)
+
+		img.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("MANIFEST (%s) CREATE ERROR", createOpts.Name)
+			img.displayError(title, err)
+
+			return
+		}
+
+		img.refreshManifests()
+	}
+
+	go create()
+}
+
+func (img *Images) cmanifestAdd() {
+	id, name := img.manifestDialog.GetSelectedManifest()
+	if id == "" {
+		img.displayError("", errNoManifestToAdd)
+
+		return
+	}
+
+	img.mAddDialog.SetManifestInfo(id, name)
+	img.mAddDialog.Display()
+}
+
+func (img *Images) manifestAdd() {
+	id, name := img.manifestDialog.GetSelectedManifest()
+
+	addOpts, err := img.mAddDialog.GetManifestAddOptions()
+	if err != nil {
+		img.displayError("MANIFEST ADD ERROR", err)
+
+		return
+	}
+
+	img.mAddDialog.Hide()
+	img.progressDialog.SetTitle("manifest add in progress")
+	img.progressDialog.Display()
+
+	add := func() {
+		_, err := images.ManifestAdd(id, addOpts)
+
+		img.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("MANIFEST (%s) ADD ERROR", name)
+			img.displayError(title, err)
+
+			return
+		}
+
+		img.refreshManifests()
+	}
+
+	go add()
+}
+
+func (img *Images) cmanifestRemove() {
+	_, name := img.manifestDialog.GetSelectedManifest()
+
+	digest, platform := img.manifestDialog.GetSelectedPlatform()
+	if digest == "" {
+		img.displayError("", errNoManifestToRemove)
+
+		return
+	}
+
+	img.confirmDialog.SetTitle("podman manifest remove")
+	img.confirmData = "manifest rm"
+	bgColor := style.GetColorHex(style.DialogBorderColor)
+	fgColor := style.GetColorHex(style.DialogFgColor)
+	manifestItem := fmt.Sprintf("[%s:%s:b]MANIFEST:[:-:-] %s\n[%s:%s:b]PLATFORM:[:-:-] %s %s",
+		fgColor, bgColor, name, fgColor, bgColor, platform, digest)
+	description := fmt.Sprintf("%s\n\nAre you sure you want to remove the selected entry from the manifest list?", manifestItem) //nolint:lll
+
+	img.confirmDialog.SetText(description)
+	img.confirmDialog.Display()
+}
+
+func (img *Images) manifestRemove() {
+	id, name := img.manifestDialog.GetSelectedManifest()
+	digest, _ := img.manifestDialog.GetSelectedPlatform()
+
+	img.progressDialog.SetTitle("manifest remove in progress")
+	img.progressDialog.Display()
+
+	remove := func() {
+		_, err := images.ManifestRemove(id, digest)
+
+		img.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("MANIFEST (%s) REMOVE ERROR", name)
+			img.displayError(title, err)
+
+			return
+		}
+
+		img.refreshManifests()
+	}
+
+	go remove()
+}
+
+func (img *Images) cmanifestPush() {
+	id, name := img.manifestDialog.GetSelectedManifest()
+	if id == "" {
+		img.displayError("", errNoManifestToPush)
+
+		return
+	}
+
+	img.mPushDialog.SetImageInfo(id, name)
+	img.mPushDialog.Display()
+}
+
+func (img *Images) manifestPush() {
+	id, name := img.manifestDialog.GetSelectedManifest()
+	pushOptions := img.mPushDialog.GetImagePushOptions()
+
+	img.mPushDialog.Hide()
+	img.progressDialog.SetTitle("manifest push in progress")
+	img.progressDialog.Display()
+
+	push := func() {
+		digest, err := images.ManifestPush(name, pushOptions)
+
+		img.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("MANIFEST (%s) PUSH ERROR", name)
+			img.displayError(title, err)
+
+			return
+		}
+
+		headerLabel := fmt.Sprintf("%12s (%s)", id, name)
+
+		img.messageDialog.SetTitle("podman manifest push")
+		img.messageDialog.SetText(dialogs.MessageImageInfo, headerLabel, "Manifest list digest: "+digest)
+		img.messageDialog.Display()
+	}
+
+	go push()
+}
+
+func (img *Images) cmanifestDelete() {
+	id, name := img.manifestDialog.GetSelectedManifest()
+	if id == "" {
+		img.displayError("", errNoManifestToDelete)
+
+		return
+	}
+
+	img.confirmDialog.SetTitle("podman manifest rm")
+	img.confirmData = "manifest delete"
+	bgColor := style.GetColorHex(style.DialogBorderColor)
+	fgColor := style.GetColorHex(style.DialogFgColor)
+	manifestItem := fmt.Sprintf("[%s:%s:b]MANIFEST:[:-:-] %s (%s)", fgColor, bgColor, id, name)
+	description := fmt.Sprintf("%s\n\nAre you sure you want to delete the selected manifest list?", manifestItem) //nolint:perfsprint
+
+	img.confirmDialog.SetText(description)
+	img.confirmDialog.Display()
+}
+
+func (img *Images) manifestDelete() {
+	id, name := img.manifestDialog.GetSelectedManifest()
+
+	img.progressDialog.SetTitle("manifest delete in progress")
+	img.progressDialog.Display()
+
+	remove := func() {
+		_, err := images.ManifestDelete(id)
+
+		img.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("MANIFEST (%s) DELETE ERROR", name)
+			img.displayError(title, err)
+
+			return
+		}
+
+		img.refreshManifests()
+	}
+
+	go remove()
+}
@@ -1,9 +1,6 @@
 package images
 
 import (
-	"fmt"
-	"strings"
-
 	putils "github.com/containers/podman-tui/pdcs/utils"
 	"github.com/containers/podman-tui/ui/style"
 	"github.com/containers/podman-tui/ui/utils"
@@ -18,18 +15,19 @@ func (img *Images) refresh() {
 
 	for i := 0; i < len(img.headers); i++ {
 		img.table.SetCell(0, i,
-			tview.NewTableCell(fmt.Sprintf("[::b]%s", strings.ToUpper(img.headers[i]))). //nolint:perfsprint
-													SetExpansion(expand).
-													SetBackgroundColor(style.PageHeaderBgColor).
-													SetTextColor(style.PageHeaderFgColor).
-													SetAlign(tview.AlignLeft).
-													SetSelectable(false))
+			tview.NewTableCell(img.tableSort.HeaderLabel(i, img.headers[i])).
+				SetExpansion(expand).
+				SetBackgroundColor(style.PageHeaderBgColor).
+				SetTextColor(style.PageHeaderFgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
 	}
 
 	rowIndex := 1
 	images := img.getData()
+	filter := img.filterBar.GetFilter()
 
-	img.table.SetTitle(fmt.Sprintf("[::b]%s[%d]", strings.ToUpper(img.title), len(images)))
+	markIDs := make([]string, 0, len(images))
 
 	for i := 0; i < len(images); i++ {
 		repo := images[i].Repository
@@ -41,6 +39,12 @@ func (img *Images) refresh() {
 			imgIDString = imgIDString[:utils.IDLength]
 		}
 
+		markIDs = append(markIDs, imgIDString)
+
+		if !utils.MatchFilter(filter, images[i].Labels, imgID, repo, tag, repo+":"+tag) {
+			continue
+		}
+
 		size := putils.SizeToStr(images[i].Size)
 		created := putils.CreatedToStr(images[i].Created)
 
@@ -76,4 +80,8 @@ func (img *Images) refresh() {
 
 		rowIndex++
 	}
+
+	img.markedItems.Retain(markIDs)
+	img.markedItems.HighlightMarkedRows(img.table, viewImageIDColIndex)
+	img.table.SetTitle(utils.TableTitle(img.title, len(images), rowIndex-1, filter, img.markedItems.Count()))
 }
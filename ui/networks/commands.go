@@ -8,6 +8,7 @@ import (
 	"github.com/containers/podman-tui/pdcs/networks"
 	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
 	"github.com/rs/zerolog/log"
 )
 
@@ -202,6 +203,12 @@ func (nets *Networks) prune() {
 }
 
 func (nets *Networks) rm() {
+	if nets.markedItems.Count() > 0 {
+		nets.preBulkCommand("rm")
+
+		return
+	}
+
 	netID, netName := nets.getSelectedItem()
 	if netID == "" {
 		nets.displayError("", errNoNetworkRemove)
@@ -243,3 +250,75 @@ func (nets *Networks) remove() {
 
 	go remove(nets.selectedID)
 }
+
+func (nets *Networks) markItem() {
+	netID, netName := nets.getSelectedItem()
+	if netID == "" {
+		return
+	}
+
+	nets.markedItems.Toggle(netID, netName)
+
+	row, _ := nets.table.GetSelection()
+	if row+1 < nets.table.GetRowCount() {
+		nets.table.Select(row+1, 0)
+	}
+}
+
+func (nets *Networks) markPattern() {
+	nets.cmdInputDialog.SetTitle("mark networks")
+	nets.cmdInputDialog.SetDescription("mark the networks which their ID or name matches the regular expression")
+	nets.cmdInputDialog.SetSelectButtonLabel("mark")
+	nets.cmdInputDialog.SetLabel("pattern ")
+
+	nets.cmdInputDialog.SetSelectedFunc(func() {
+		pattern := nets.cmdInputDialog.GetInputText()
+		nets.cmdInputDialog.Hide()
+
+		if _, err := nets.markedItems.Mark(nets.getItems(), pattern); err != nil {
+			nets.displayError("NETWORK MARK ERROR", err)
+		}
+	})
+
+	nets.cmdInputDialog.Display()
+}
+
+func (nets *Networks) preBulkCommand(cmd string) {
+	nets.confirmDialog.SetTitle("podman network " + cmd)
+	nets.confirmData = "bulk"
+	nets.bulkCmd = cmd
+
+	nets.confirmDialog.SetText(utils.BulkCommandConfirmMessage("network", cmd, nets.markedItems.Items()))
+	nets.confirmDialog.Display()
+}
+
+func (nets *Networks) bulkCommand() {
+	var cmdFunc func(id string) error
+
+	cmd := nets.bulkCmd
+
+	switch cmd {
+	case "rm":
+		cmdFunc = networks.Remove
+	default:
+		return
+	}
+
+	nets.progressDialog.SetTitle(fmt.Sprintf("network %s in progress", cmd))
+	nets.progressDialog.Display()
+
+	bulk := func() {
+		results := nets.markedItems.RunBulkCommand(cmdFunc)
+
+		nets.progressDialog.Hide()
+
+		summary, report := utils.BulkCommandSummary(results)
+
+		nets.messageDialog.SetTitle("podman network " + cmd)
+		nets.messageDialog.SetText(dialogs.MessageBulkCommandInfo, summary, report)
+		nets.messageDialog.Display()
+		nets.UpdateData()
+	}
+
+	go bulk()
+}
@@ -0,0 +1,78 @@
+package networks
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/containers/common/libnetwork/types"
+	"github.com/containers/podman-tui/pdcs/networks"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+// UpdateData retrieves networks list data.
+func (nets *Networks) UpdateData() {
+	netList, err := networks.List()
+	if err != nil {
+		log.Error().Msgf("view: networks update %v", err)
+		nets.errorDialog.SetText(fmt.Sprintf("%v", err))
+		nets.errorDialog.Display()
+	}
+
+	nets.networksList.mu.Lock()
+	nets.networksList.report = netList
+	nets.networksList.mu.Unlock()
+}
+
+// getData returns a copy of the list data sorted by the table sort column and order.
+func (nets *Networks) getData() []types.Network {
+	nets.networksList.mu.Lock()
+	data := make([]types.Network, len(nets.networksList.report))
+	copy(data, nets.networksList.report)
+	nets.networksList.mu.Unlock()
+
+	column := nets.tableSort.Column()
+
+	sort.SliceStable(data, func(i, j int) bool {
+		return nets.tableSort.Less(compareNetworks(column, data[i], data[j]))
+	})
+
+	return data
+}
+
+func compareNetworks(column int, a, b types.Network) int {
+	switch column {
+	case viewNetworkNameColIndex:
+		return strings.Compare(a.ID, b.ID)
+	case viewNetworkPluginColIndex:
+		return strings.Compare(a.Driver, b.Driver)
+	default:
+		return strings.Compare(a.Name, b.Name)
+	}
+}
+
+// ClearData clears table data.
+func (nets *Networks) ClearData() {
+	nets.networksList.mu.Lock()
+	nets.networksList.report = nil
+	nets.networksList.mu.Unlock()
+	nets.table.Clear()
+
+	expand := 1
+	fgColor := style.PageHeaderFgColor
+	bgColor := style.PageHeaderBgColor
+
+	for i := 0; i < len(nets.headers); i++ {
+		nets.table.SetCell(0, i,
+			tview.NewTableCell(nets.tableSort.HeaderLabel(i, nets.headers[i])).
+				SetExpansion(expand).
+				SetBackgroundColor(bgColor).
+				SetTextColor(fgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
+	}
+
+	nets.table.SetTitle(fmt.Sprintf("[::b]%s[0]", strings.ToUpper(nets.title)))
+}
@@ -1,16 +1,24 @@
 package networks
 
 import (
+	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/gdamore/tcell/v2"
 )
 
 // Draw draws this primitive onto the screen.
 func (nets *Networks) Draw(screen tcell.Screen) {
+	nets.refresh()
 	nets.Box.DrawForSubclass(screen, nets)
 	nets.Box.SetBorder(false)
 
 	x, y, width, height := nets.GetInnerRect()
 
+	if nets.filterBar.IsVisible() {
+		height -= dialogs.FilterBarHeight
+		nets.filterBar.SetRect(x, y+height, width, dialogs.FilterBarHeight)
+		nets.filterBar.Draw(screen)
+	}
+
 	nets.table.SetRect(x, y, width, height)
 	nets.table.SetBorder(true)
 
@@ -34,6 +42,14 @@ func (nets *Networks) Draw(screen tcell.Screen) {
 		return
 	}
 
+	// command input dialog
+	if nets.cmdInputDialog.IsDisplay() {
+		nets.cmdInputDialog.SetRect(x, y, width, height)
+		nets.cmdInputDialog.Draw(screen)
+
+		return
+	}
+
 	// create dialog
 	if nets.createDialog.IsDisplay() {
 		nets.createDialog.SetRect(x, y, width, height)
@@ -51,6 +51,13 @@ func (nets *Networks) InputHandler() func(event *tcell.EventKey, setFocus func(p
 			}
 		}
 
+		// command input dialog handler
+		if nets.cmdInputDialog.HasFocus() {
+			if cmdInputHandler := nets.cmdInputDialog.InputHandler(); cmdInputHandler != nil {
+				cmdInputHandler(event, setFocus)
+			}
+		}
+
 		// confirm dialog handler
 		if nets.confirmDialog.HasFocus() {
 			if confirmDialogHandler := nets.confirmDialog.InputHandler(); confirmDialogHandler != nil {
@@ -65,6 +72,13 @@ func (nets *Networks) InputHandler() func(event *tcell.EventKey, setFocus func(p
 			}
 		}
 
+		// filter bar handler
+		if nets.filterBar.HasFocus() {
+			if filterBarHandler := nets.filterBar.InputHandler(); filterBarHandler != nil {
+				filterBarHandler(event, setFocus)
+			}
+		}
+
 		// table handlers
 		if nets.table.HasFocus() { //nolint:nestif
 			nets.selectedID, _ = nets.getSelectedItem()
@@ -86,6 +100,44 @@ func (nets *Networks) InputHandler() func(event *tcell.EventKey, setFocus func(p
 				return
 			}
 
+			if event.Rune() == utils.MarkItemKey.Rune() {
+				nets.markItem()
+
+				return
+			}
+
+			if event.Key() == utils.MarkAllKey.EventKey() {
+				nets.markedItems.ToggleAll(nets.getItems())
+
+				return
+			}
+
+			if event.Rune() == utils.MarkPatternKey.Rune() {
+				nets.markPattern()
+				setFocus(nets)
+
+				return
+			}
+
+			if event.Rune() == utils.FilterKey.Rune() {
+				nets.filterBar.Display()
+				setFocus(nets)
+
+				return
+			}
+
+			if event.Rune() == utils.SortColumnKey.Rune() {
+				nets.tableSort.Next()
+
+				return
+			}
+
+			if event.Rune() == utils.SortOrderKey.Rune() {
+				nets.tableSort.Reverse()
+
+				return
+			}
+
 			if tableHandler := nets.table.InputHandler(); tableHandler != nil {
 				tableHandler(event, setFocus)
 			}
@@ -148,28 +148,28 @@ func NewNetworkCreateDialog() *NetworkCreateDialog {
 	netDialog.networkIpv6CheckBox.SetLabelWidth(ipSettingsPageLabelWidth)
 	netDialog.networkIpv6CheckBox.SetChecked(false)
 	netDialog.networkIpv6CheckBox.SetBackgroundColor(bgColor)
-	netDialog.networkIpv6CheckBox.SetLabelColor(tcell.ColorWhite)
+	netDialog.networkIpv6CheckBox.SetLabelColor(fgColor)
 	netDialog.networkIpv6CheckBox.SetFieldBackgroundColor(inputFieldBgColor)
 
 	// gateway
 	netDialog.networkGatewayField.SetLabel("gateway:")
 	netDialog.networkGatewayField.SetLabelWidth(basicInfoPageLabelWidth)
 	netDialog.networkGatewayField.SetBackgroundColor(bgColor)
-	netDialog.networkGatewayField.SetLabelColor(tcell.ColorWhite)
+	netDialog.networkGatewayField.SetLabelColor(fgColor)
 	netDialog.networkGatewayField.SetFieldBackgroundColor(inputFieldBgColor)
 
 	// ip range
 	netDialog.networkIPRangeField.SetLabel("ip range:")
 	netDialog.networkIPRangeField.SetLabelWidth(basicInfoPageLabelWidth)
 	netDialog.networkIPRangeField.SetBackgroundColor(bgColor)
-	netDialog.networkIPRangeField.SetLabelColor(tcell.ColorWhite)
+	netDialog.networkIPRangeField.SetLabelColor(fgColor)
 	netDialog.networkIPRangeField.SetFieldBackgroundColor(inputFieldBgColor)
 
 	// subnet
 	netDialog.networkSubnetField.SetLabel("subnet:")
 	netDialog.networkSubnetField.SetLabelWidth(basicInfoPageLabelWidth)
 	netDialog.networkSubnetField.SetBackgroundColor(bgColor)
-	netDialog.networkSubnetField.SetLabelColor(tcell.ColorWhite)
+	netDialog.networkSubnetField.SetLabelColor(fgColor)
 	netDialog.networkSubnetField.SetFieldBackgroundColor(inputFieldBgColor)
 
 	// dns check box
@@ -177,7 +177,7 @@ func NewNetworkCreateDialog() *NetworkCreateDialog {
 	netDialog.networkDisableDNSCheckBox.SetLabelWidth(basicInfoPageLabelWidth)
 	netDialog.networkDisableDNSCheckBox.SetChecked(false)
 	netDialog.networkDisableDNSCheckBox.SetBackgroundColor(bgColor)
-	netDialog.networkDisableDNSCheckBox.SetLabelColor(tcell.ColorWhite)
+	netDialog.networkDisableDNSCheckBox.SetLabelColor(fgColor)
 	netDialog.networkDisableDNSCheckBox.SetFieldBackgroundColor(inputFieldBgColor)
 
 	// category pages
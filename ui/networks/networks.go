@@ -4,10 +4,13 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 
+	"github.com/containers/common/libnetwork/types"
 	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/containers/podman-tui/ui/networks/netdialogs"
 	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
 	"github.com/rivo/tview"
 )
 
@@ -34,12 +37,23 @@ type Networks struct {
 	progressDialog   *dialogs.ProgressDialog
 	confirmDialog    *dialogs.ConfirmDialog
 	cmdDialog        *dialogs.CommandDialog
+	cmdInputDialog   *dialogs.SimpleInputDialog
 	messageDialog    *dialogs.MessageDialog
 	createDialog     *netdialogs.NetworkCreateDialog
 	connectDialog    *netdialogs.NetworkConnectDialog
 	disconnectDialog *netdialogs.NetworkDisconnectDialog
+	filterBar        *dialogs.FilterBar
+	networksList     networkListReport
+	markedItems      *utils.MarkedItems
+	tableSort        *utils.TableSort
 	selectedID       string
 	confirmData      string
+	bulkCmd          string
+}
+
+type networkListReport struct {
+	mu     sync.Mutex
+	report []types.Network
 }
 
 // NewNetworks returns nets page view.
@@ -51,12 +65,17 @@ func NewNetworks() *Networks {
 		errorDialog:      dialogs.NewErrorDialog(),
 		progressDialog:   dialogs.NewProgressDialog(),
 		confirmDialog:    dialogs.NewConfirmDialog(),
+		cmdInputDialog:   dialogs.NewSimpleInputDialog(""),
 		messageDialog:    dialogs.NewMessageDialog(""),
 		createDialog:     netdialogs.NewNetworkCreateDialog(),
 		connectDialog:    netdialogs.NewNetworkConnectDialog(),
 		disconnectDialog: netdialogs.NewNetworkDisconnectDialog(),
+		filterBar:        dialogs.NewFilterBar(),
+		markedItems:      utils.NewMarkedItems(),
 	}
 
+	nets.tableSort = utils.NewTableSort(len(nets.headers), viewNetworkVersionColIndex)
+
 	nets.cmdDialog = dialogs.NewCommandDialog([][]string{
 		{"connect", "connect a container to a network"},
 		{"create", "create a Podman CNI network"},
@@ -76,12 +95,12 @@ func NewNetworks() *Networks {
 
 	for i := 0; i < len(nets.headers); i++ {
 		nets.table.SetCell(0, i,
-			tview.NewTableCell(fmt.Sprintf("[::b]%s", strings.ToUpper(nets.headers[i]))). //nolint:perfsprint
-													SetExpansion(1).
-													SetBackgroundColor(style.PageHeaderBgColor).
-													SetTextColor(style.PageHeaderFgColor).
-													SetAlign(tview.AlignLeft).
-													SetSelectable(false))
+			tview.NewTableCell(nets.tableSort.HeaderLabel(i, nets.headers[i])).
+				SetExpansion(1).
+				SetBackgroundColor(style.PageHeaderBgColor).
+				SetTextColor(style.PageHeaderFgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
 	}
 
 	nets.table.SetFixed(1, 1)
@@ -96,6 +115,10 @@ func NewNetworks() *Networks {
 		nets.cmdDialog.Hide()
 	})
 
+	// set input cmd dialog functions
+	nets.cmdInputDialog.SetCancelFunc(nets.cmdInputDialog.Hide)
+	nets.cmdInputDialog.SetSelectedFunc(nets.cmdInputDialog.Hide)
+
 	// set message dialog functions
 	nets.messageDialog.SetCancelFunc(func() {
 		nets.messageDialog.Hide()
@@ -110,6 +133,8 @@ func NewNetworks() *Networks {
 			nets.prune()
 		case "rm":
 			nets.remove()
+		case "bulk":
+			nets.bulkCommand()
 		}
 	})
 
@@ -165,6 +190,10 @@ func (nets *Networks) HasFocus() bool { //nolint:cyclop
 		return true
 	}
 
+	if nets.cmdInputDialog.HasFocus() || nets.filterBar.HasFocus() {
+		return true
+	}
+
 	return false
 }
 
@@ -186,6 +215,10 @@ func (nets *Networks) SubDialogHasFocus() bool {
 		return true
 	}
 
+	if nets.cmdInputDialog.HasFocus() || nets.filterBar.HasFocus() {
+		return true
+	}
+
 	return false
 }
 
@@ -205,6 +238,13 @@ func (nets *Networks) Focus(delegate func(p tview.Primitive)) {
 		return
 	}
 
+	// command input dialog
+	if nets.cmdInputDialog.IsDisplay() {
+		delegate(nets.cmdInputDialog)
+
+		return
+	}
+
 	// message dialog
 	if nets.messageDialog.IsDisplay() {
 		delegate(nets.messageDialog)
@@ -240,6 +280,13 @@ func (nets *Networks) Focus(delegate func(p tview.Primitive)) {
 		return
 	}
 
+	// filter bar
+	if nets.filterBar.IsDisplay() {
+		delegate(nets.filterBar)
+
+		return
+	}
+
 	delegate(nets.table)
 }
 
@@ -255,6 +302,19 @@ func (nets *Networks) getSelectedItem() (string, string) {
 	return netID, netName
 }
 
+func (nets *Networks) getItems() []utils.MarkedItem {
+	items := []utils.MarkedItem{}
+
+	for row := 1; row < nets.table.GetRowCount(); row++ {
+		items = append(items, utils.MarkedItem{
+			ID:   nets.table.GetCell(row, viewNetworkNameColIndex).Text,
+			Name: nets.table.GetCell(row, viewNetworkVersionColIndex).Text,
+		})
+	}
+
+	return items
+}
+
 // HideAllDialogs hides all sub dialogs.
 func (nets *Networks) HideAllDialogs() {
 	if nets.errorDialog.IsDisplay() {
@@ -273,6 +333,10 @@ func (nets *Networks) HideAllDialogs() {
 		nets.cmdDialog.Hide()
 	}
 
+	if nets.cmdInputDialog.IsDisplay() {
+		nets.cmdInputDialog.Hide()
+	}
+
 	if nets.messageDialog.IsDisplay() {
 		nets.messageDialog.Hide()
 	}
@@ -288,4 +352,8 @@ func (nets *Networks) HideAllDialogs() {
 	if nets.disconnectDialog.IsDisplay() {
 		nets.disconnectDialog.Hide()
 	}
+
+	if nets.filterBar.IsDisplay() {
+		nets.filterBar.Hide()
+	}
 }
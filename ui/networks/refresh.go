@@ -1,24 +1,12 @@
 package networks
 
 import (
-	"fmt"
-	"strings"
-
-	"github.com/containers/podman-tui/pdcs/networks"
 	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
 	"github.com/rivo/tview"
-	"github.com/rs/zerolog/log"
 )
 
-// UpdateData retrieves networks list data.
-func (nets *Networks) UpdateData() {
-	netList, err := networks.List()
-	if err != nil {
-		log.Error().Msgf("view: networks update %v", err)
-		nets.errorDialog.SetText(fmt.Sprintf("%v", err))
-		nets.errorDialog.Display()
-	}
-
+func (nets *Networks) refresh() {
 	nets.table.Clear()
 
 	expand := 1
@@ -26,26 +14,38 @@ func (nets *Networks) UpdateData() {
 
 	for i := 0; i < len(nets.headers); i++ {
 		nets.table.SetCell(0, i,
-			tview.NewTableCell(fmt.Sprintf("[::b]%s", strings.ToUpper(nets.headers[i]))). //nolint:perfsprint
-													SetExpansion(expand).
-													SetBackgroundColor(style.PageHeaderBgColor).
-													SetTextColor(style.PageHeaderFgColor).
-													SetAlign(tview.AlignLeft).
-													SetSelectable(false))
+			tview.NewTableCell(nets.tableSort.HeaderLabel(i, nets.headers[i])).
+				SetExpansion(expand).
+				SetBackgroundColor(style.PageHeaderBgColor).
+				SetTextColor(style.PageHeaderFgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
 	}
 
 	rowIndex := 1
+	netList := nets.getData()
+	filter := nets.filterBar.GetFilter()
 
-	nets.table.SetTitle(fmt.Sprintf("[::b]%s[%d]", strings.ToUpper(nets.title), len(netList)))
+	markIDs := make([]string, 0, len(netList))
 
 	for i := 0; i < len(netList); i++ {
-		netID := netList[i][0]
-		netName := netList[i][1]
-		netDriver := netList[i][2]
+		netID := netList[i].ID
+		if len(netID) > utils.IDLength {
+			netID = netID[:utils.IDLength]
+		}
+
+		netName := netList[i].Name
+		netDriver := netList[i].Driver
+
+		markIDs = append(markIDs, netID)
+
+		if !utils.MatchFilter(filter, netList[i].Labels, netList[i].ID, netName, netDriver) {
+			continue
+		}
 
 		// name column
 		nets.table.SetCell(rowIndex, viewNetworkNameColIndex,
-			tview.NewTableCell(netID[:12]).
+			tview.NewTableCell(netID).
 				SetExpansion(expand).
 				SetAlign(alignment))
 
@@ -63,25 +63,8 @@ func (nets *Networks) UpdateData() {
 
 		rowIndex++
 	}
-}
-
-// ClearData clears table data.
-func (nets *Networks) ClearData() {
-	nets.table.Clear()
-
-	expand := 1
-	fgColor := style.PageHeaderFgColor
-	bgColor := style.PageHeaderBgColor
-
-	for i := 0; i < len(nets.headers); i++ {
-		nets.table.SetCell(0, i,
-			tview.NewTableCell(fmt.Sprintf("[::b]%s", strings.ToUpper(nets.headers[i]))). //nolint:perfsprint
-													SetExpansion(expand).
-													SetBackgroundColor(bgColor).
-													SetTextColor(fgColor).
-													SetAlign(tview.AlignLeft).
-													SetSelectable(false))
-	}
 
-	nets.table.SetTitle(fmt.Sprintf("[::b]%s[0]", strings.ToUpper(nets.title)))
+	nets.markedItems.Retain(markIDs)
+	nets.markedItems.HighlightMarkedRows(nets.table, viewNetworkNameColIndex)
+	nets.table.SetTitle(utils.TableTitle(nets.title, len(netList), rowIndex-1, filter, nets.markedItems.Count()))
 }
@@ -2,22 +2,53 @@ package pods
 
 import (
 	"fmt"
+	"os"
+	"slices"
 	"strings"
 
+	"github.com/containers/podman-tui/pdcs/kube"
 	ppods "github.com/containers/podman-tui/pdcs/pods"
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/pdcs/systemd"
 	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/pods/poddialogs"
 	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/containers/podman/v5/pkg/domain/entities/types"
 	"github.com/rs/zerolog/log"
 )
 
 func (p *Pods) runCommand(cmd string) { //nolint:cyclop
+	// the marked pods bulk commands are run on the pods service connection
+	bulkCmd := p.markedItems.Count() > 0 && slices.Contains(bulkCommands, cmd)
+
 	switch cmd {
+	case "create", "prune", "kube down", "kube play":
+	default:
+		if !bulkCmd && !p.selectedOnActiveHost() {
+			return
+		}
+	}
+
+	switch cmd {
+	case "clone":
+		p.pclone()
 	case "create":
 		p.createDialog.Display()
+	case "generate kube":
+		p.generateKube()
+	case "generate systemd":
+		p.generateSystemd()
 	case "inspect":
 		p.inspect()
 	case "kill":
 		p.kill()
+	case "kube down":
+		p.kubePlayDialog.SetMode(poddialogs.KubeDown)
+		p.kubePlayDialog.Display()
+	case "kube play":
+		p.kubePlayDialog.SetMode(poddialogs.KubePlay)
+		p.kubePlayDialog.Display()
 	case "pause":
 		p.pause()
 	case "prune": //nolint:goconst
@@ -62,6 +93,53 @@ func (p *Pods) stats() {
 	p.statsDialog.Display()
 }
 
+func (p *Pods) pclone() {
+	podID, podName := p.getSelectedItem()
+	if podID == "" {
+		p.displayError("", errNoPodClone)
+
+		return
+	}
+
+	cloneOpts, err := ppods.CloneDefaults(podID)
+	if err != nil {
+		title := fmt.Sprintf("POD (%s) CLONE ERROR", podID)
+
+		p.displayError(title, err)
+
+		return
+	}
+
+	p.cloneDialog.SetPodInfo(podID, podName)
+	p.cloneDialog.SetCloneOptions(cloneOpts)
+	p.cloneDialog.Display()
+}
+
+func (p *Pods) clone() {
+	podID, _ := p.getSelectedItem()
+	cloneOpts := p.cloneDialog.GetPodCloneOptions()
+
+	p.cloneDialog.Hide()
+	p.progressDialog.SetTitle("pod clone in progress")
+	p.progressDialog.Display()
+
+	clone := func() {
+		_, err := ppods.Clone(podID, cloneOpts)
+
+		p.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("POD (%s) CLONE ERROR", podID)
+
+			p.displayError(title, err)
+
+			return
+		}
+	}
+
+	go clone()
+}
+
 func (p *Pods) create() {
 	podSpec := p.createDialog.GetPodSpec()
 
@@ -83,6 +161,157 @@ func (p *Pods) create() {
 	go createFunc()
 }
 
+func (p *Pods) generateKube() {
+	podID, podName := p.getSelectedItem()
+	if podID == "" {
+		p.displayError("", errNoPodKube)
+
+		return
+	}
+
+	data, err := kube.Generate([]string{podID})
+	if err != nil {
+		title := fmt.Sprintf("POD (%s) GENERATE KUBE ERROR", podID)
+
+		p.displayError(title, err)
+
+		return
+	}
+
+	headerLabel := fmt.Sprintf("%12s (%s)", podID, podName)
+
+	p.kubeDialog.SetKubeYAML(dialogs.MessagePodInfo, headerLabel, data)
+	p.kubeDialog.Display()
+}
+
+func (p *Pods) saveKube() {
+	path, err := p.kubeDialog.GetOutputPath()
+	if err != nil {
+		p.displayError("GENERATE KUBE SAVE ERROR", err)
+
+		return
+	}
+
+	if err := os.WriteFile(path, []byte(p.kubeDialog.GetKubeYAML()), 0o644); err != nil { //nolint:gosec,gomnd
+		p.displayError("GENERATE KUBE SAVE ERROR", err)
+
+		return
+	}
+
+	p.kubeDialog.Hide()
+}
+
+func (p *Pods) kubePlay() {
+	mode := p.kubePlayDialog.GetMode()
+	option := p.kubePlayDialog.GetOption()
+
+	path, err := p.kubePlayDialog.GetYAMLFile()
+	if err != nil {
+		p.displayError("KUBE PLAY ERROR", err)
+
+		return
+	}
+
+	p.kubePlayDialog.Hide()
+
+	cmdName := "play"
+	if mode == poddialogs.KubeDown {
+		cmdName = "down"
+	}
+
+	p.progressDialog.SetTitle(fmt.Sprintf("kube %s in progress", cmdName))
+	p.progressDialog.Display()
+
+	kubePlay := func() {
+		var (
+			report *types.KubePlayReport
+			err    error
+		)
+
+		if mode == poddialogs.KubeDown {
+			report, err = kube.Down(path, option)
+		} else {
+			report, err = kube.Play(path, option)
+		}
+
+		p.progressDialog.Hide()
+
+		if err != nil {
+			p.displayError(fmt.Sprintf("KUBE %s ERROR", strings.ToUpper(cmdName)), err)
+
+			return
+		}
+
+		p.messageDialog.SetTitle("podman kube " + cmdName)
+		p.messageDialog.SetText(dialogs.MessageKubeInfo, path, kube.PlayReportString(report))
+		p.messageDialog.Display()
+	}
+
+	go kubePlay()
+}
+
+func (p *Pods) generateSystemd() {
+	podID, podName := p.getSelectedItem()
+	if podID == "" {
+		p.displayError("", errNoPodSystemd)
+
+		return
+	}
+
+	headerLabel := fmt.Sprintf("%12s (%s)", podID, podName)
+
+	p.systemdDialog.SetUnitInfo(dialogs.MessagePodInfo, headerLabel)
+	p.systemdDialog.Display()
+	p.previewSystemd()
+}
+
+func (p *Pods) previewSystemd() {
+	podID, _ := p.getSelectedItem()
+
+	units, err := systemd.PodUnits(podID, p.systemdDialog.GetUnitOptions())
+	if err != nil {
+		p.systemdDialog.SetPreview(fmt.Sprintf("%v", err))
+
+		return
+	}
+
+	p.systemdDialog.SetPreview(systemd.UnitsString(units))
+}
+
+func (p *Pods) writeSystemd() {
+	podID, podName := p.getSelectedItem()
+
+	dir, err := p.systemdDialog.GetUnitDir()
+	if err != nil {
+		p.displayError("GENERATE SYSTEMD ERROR", err)
+
+		return
+	}
+
+	units, err := systemd.PodUnits(podID, p.systemdDialog.GetUnitOptions())
+	if err != nil {
+		title := fmt.Sprintf("POD (%s) GENERATE SYSTEMD ERROR", podID)
+
+		p.displayError(title, err)
+
+		return
+	}
+
+	written, err := systemd.WriteUnits(dir, units)
+	if err != nil {
+		p.displayError("GENERATE SYSTEMD ERROR", err)
+
+		return
+	}
+
+	headerLabel := fmt.Sprintf("%12s (%s)", podID, podName)
+
+	p.systemdDialog.Hide()
+	p.messageDialog.SetTitle("podman generate systemd")
+	p.messageDialog.SetText(dialogs.MessagePodInfo, headerLabel, systemd.WrittenString(written))
+	p.messageDialog.Display()
+}
+
 func (p *Pods) inspect() {
 	podID, podName := p.getSelectedItem()
 	if podID == "" {
@@ -108,6 +337,12 @@ func (p *Pods) inspect() {
 }
 
 func (p *Pods) kill() {
+	if p.markedItems.Count() > 0 {
+		p.preBulkCommand("kill")
+
+		return
+	}
+
 	if p.selectedID == "" {
 		p.displayError("", errNoPodKill)
 
@@ -135,6 +370,12 @@ func (p *Pods) kill() {
 }
 
 func (p *Pods) pause() {
+	if p.markedItems.Count() > 0 {
+		p.preBulkCommand("pause")
+
+		return
+	}
+
 	if p.selectedID == "" {
 		p.displayError("", errNoPodPause)
 
@@ -187,6 +428,12 @@ func (p *Pods) prune() {
 }
 
 func (p *Pods) restart() {
+	if p.markedItems.Count() > 0 {
+		p.preBulkCommand("restart")
+
+		return
+	}
+
 	if p.selectedID == "" {
 		p.displayError("", errNoPodRestart)
 
@@ -213,6 +460,12 @@ func (p *Pods) restart() {
 }
 
 func (p *Pods) rm() {
+	if p.markedItems.Count() > 0 {
+		p.preBulkCommand("rm")
+
+		return
+	}
+
 	podID, podName := p.getSelectedItem()
 	if podID == "" {
 		p.displayError("", errNoPodRemove)
@@ -259,6 +512,12 @@ func (p *Pods) remove() {
 }
 
 func (p *Pods) start() {
+	if p.markedItems.Count() > 0 {
+		p.preBulkCommand("start")
+
+		return
+	}
+
 	if p.selectedID == "" {
 		p.displayError("", errNoPodStart)
 
@@ -285,6 +544,12 @@ func (p *Pods) start() {
 }
 
 func (p *Pods) stop() {
+	if p.markedItems.Count() > 0 {
+		p.preBulkCommand("stop")
+
+		return
+	}
+
 	if p.selectedID == "" {
 		p.displayError("", errNoPodStop)
 
@@ -331,6 +596,12 @@ func (p *Pods) top() {
 }
 
 func (p *Pods) unpause() {
+	if p.markedItems.Count() > 0 {
+		p.preBulkCommand("unpause")
+
+		return
+	}
+
 	if p.selectedID == "" {
 		p.displayError("", errNoPodUnpause)
 
@@ -355,3 +626,82 @@ func (p *Pods) unpause() {
 
 	go unpause(p.selectedID)
 }
+
+func (p *Pods) markItem() {
+	podID, podName := p.getSelectedItem()
+	if podID == "" {
+		return
+	}
+
+	row, _ := p.table.GetSelection()
+
+	p.markedItems.ToggleItem(utils.MarkedItem{
+		ID:   podID,
+		Name: podName,
+		Host: p.table.GetCell(row, viewPodHostColIndex).Text,
+	})
+
+	if row+1 < p.table.GetRowCount() {
+		p.table.Select(row+1, 0)
+	}
+}
+
+func (p *Pods) markPattern() {
+	p.cmdInputDialog.SetTitle("mark pods")
+	p.cmdInputDialog.SetDescription("mark the pods which their ID or name matches the regular expression")
+	p.cmdInputDialog.SetSelectButtonLabel("mark")
+	p.cmdInputDialog.SetLabel("pattern ")
+
+	p.cmdInputDialog.SetSelectedFunc(func() {
+		pattern := p.cmdInputDialog.GetInputText()
+		p.cmdInputDialog.Hide()
+
+		if _, err := p.markedItems.Mark(p.getItems(), pattern); err != nil {
+			p.displayError("POD MARK ERROR", err)
+		}
+	})
+
+	p.cmdInputDialog.Display()
+}
+
+func (p *Pods) preBulkCommand(cmd string) {
+	p.confirmDialog.SetTitle("podman pod " + cmd)
+	p.confirmData = "bulk"
+	p.bulkCmd = cmd
+
+	p.confirmDialog.SetText(utils.BulkCommandConfirmMessage("pod", cmd, p.markedItems.Items()))
+	p.confirmDialog.Display()
+}
+
+func (p *Pods) bulkCommand() {
+	cmd := p.bulkCmd
+	if !slices.Contains(bulkCommands, cmd) {
+		return
+	}
+
+	// the marked pods are on their service connections (hosts)
+	cmdFunc := func(host string, id string) error {
+		if host == "" {
+			host = registry.ConnectionName()
+		}
+
+		return ppods.BulkCommandByConnection(host, cmd, id)
+	}
+
+	p.progressDialog.SetTitle(fmt.Sprintf("pod %s in progress", cmd))
+	p.progressDialog.Display()
+
+	bulk := func() {
+		results := p.markedItems.RunHostBulkCommand(cmdFunc)
+
+		p.progressDialog.Hide()
+
+		summary, report := utils.BulkCommandSummary(results)
+
+		p.messageDialog.SetTitle("podman pod " + cmd)
+		p.messageDialog.SetText(dialogs.MessageBulkCommandInfo, summary, report)
+		p.messageDialog.Display()
+	}
+
+	go bulk()
+}
@@ -1,19 +1,23 @@
 package pods
 
 import (
+	"cmp"
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
 
 	ppods "github.com/containers/podman-tui/pdcs/pods"
 	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/rivo/tview"
 	"github.com/rs/zerolog/log"
 )
 
-// UpdateData retrieves pods list data.
+// UpdateData retrieves pods list data of the active connection and the other connected services.
 func (pods *Pods) UpdateData() {
-	podList, err := ppods.List()
+	hostsList, err := utils.ListConnectedServices(ppods.ListByConnection)
 	if err != nil {
 		log.Error().Msgf("view: pods update %v", err)
 		pods.errorDialog.SetText(fmt.Sprintf("%v", err))
@@ -22,19 +26,88 @@ func (pods *Pods) UpdateData() {
 		return
 	}
 
+	podList := []hostPod{}
+
+	for _, host := range utils.SortedKeys(hostsList) {
+		for _, item := range hostsList[host] {
+			podList = append(podList, hostPod{ListPodsReport: item, host: host})
+		}
+	}
+
 	pods.podsList.mu.Lock()
 	pods.podsList.report = podList
 	pods.podsList.mu.Unlock()
 }
 
-func (pods *Pods) getData() []*entities.ListPodsReport {
+// UpdateItem updates the pod list item of the service connection after a pod
+// event instead of listing all the pods again (the removed pod is deleted).
+func (pods *Pods) UpdateItem(host string, id string, action string) {
+	var items []*entities.ListPodsReport
+
+	if action != utils.EventActionRemove {
+		var err error
+
+		items, err = ppods.ListByID(host, id)
+		if err != nil {
+			log.Error().Msgf("view: pods update %s %s: %v", host, id, err)
+
+			return
+		}
+	}
+
 	pods.podsList.mu.Lock()
-	data := pods.podsList.report
+	defer pods.podsList.mu.Unlock()
+
+	report := slices.DeleteFunc(pods.podsList.report, func(item hostPod) bool {
+		return item.host == host && item.Id == id
+	})
+
+	for _, item := range items {
+		report = append(report, hostPod{ListPodsReport: item, host: host})
+	}
+
+	pods.podsList.report = report
+}
+
+// getData returns a copy of the list data sorted by the table sort column and order.
+func (pods *Pods) getData() []hostPod {
+	pods.podsList.mu.Lock()
+	data := make([]hostPod, len(pods.podsList.report))
+	copy(data, pods.podsList.report)
 	pods.podsList.mu.Unlock()
 
+	column := pods.tableSort.Column()
+
+	sort.SliceStable(data, func(i, j int) bool {
+		return pods.tableSort.Less(comparePods(column, data[i], data[j]))
+	})
+
 	return data
 }
 
+func comparePods(column int, a, b hostPod) int {
+	switch column {
+	case viewPodIDColIndex:
+		return strings.Compare(a.Id, b.Id)
+	case viewPodStatusColIndex:
+		return strings.Compare(a.Status, b.Status)
+	case viewPodCreatedColIndex:
+		return a.Created.Compare(b.Created)
+	case viewPodInfraIDColIndex:
+		return strings.Compare(a.InfraId, b.InfraId)
+	case viewPodContainersColIndex:
+		return cmp.Compare(len(a.Containers), len(b.Containers))
+	case viewPodHostColIndex:
+		return strings.Compare(a.host, b.host)
+	}
+
+	if result := strings.Compare(a.Name, b.Name); result != 0 {
+		return result
+	}
+
+	return strings.Compare(a.host, b.host)
+}
+
 // ClearData clears table data.
 func (pods *Pods) ClearData() { //nolint:stylecheck
 	pods.podsList.mu.Lock()
@@ -48,12 +121,12 @@ func (pods *Pods) ClearData() { //nolint:stylecheck
 
 	for i := 0; i < len(pods.headers); i++ {
 		pods.table.SetCell(0, i,
-			tview.NewTableCell(fmt.Sprintf("[::b]%s", strings.ToUpper(pods.headers[i]))). //nolint:perfsprint
-													SetExpansion(expand).
-													SetBackgroundColor(bgColor).
-													SetTextColor(fgColor).
-													SetAlign(tview.AlignLeft).
-													SetSelectable(false))
+			tview.NewTableCell(pods.tableSort.HeaderLabel(i, pods.headers[i])).
+				SetExpansion(expand).
+				SetBackgroundColor(bgColor).
+				SetTextColor(fgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
 	}
 
 	pods.table.SetTitle(fmt.Sprintf("[::b]%s[0]", strings.ToUpper(pods.title)))
@@ -1,6 +1,7 @@
 package pods
 
 import (
+	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/gdamore/tcell/v2"
 )
 
@@ -12,6 +13,12 @@ func (pods *Pods) Draw(screen tcell.Screen) {
 
 	x, y, width, height := pods.GetInnerRect()
 
+	if pods.filterBar.IsVisible() {
+		height -= dialogs.FilterBarHeight
+		pods.filterBar.SetRect(x, y+height, width, dialogs.FilterBarHeight)
+		pods.filterBar.Draw(screen)
+	}
+
 	pods.table.SetRect(x, y, width, height)
 	pods.table.SetBorder(true)
 
@@ -35,6 +42,14 @@ func (pods *Pods) Draw(screen tcell.Screen) {
 		return
 	}
 
+	// command input dialog
+	if pods.cmdInputDialog.IsDisplay() {
+		pods.cmdInputDialog.SetRect(x, y, width, height)
+		pods.cmdInputDialog.Draw(screen)
+
+		return
+	}
+
 	// create dialog
 	if pods.createDialog.IsDisplay() {
 		pods.createDialog.SetRect(x, y, width, height)
@@ -43,6 +58,14 @@ func (pods *Pods) Draw(screen tcell.Screen) {
 		return
 	}
 
+	// kube play dialog
+	if pods.kubePlayDialog.IsDisplay() {
+		pods.kubePlayDialog.SetRect(x, y, width, height)
+		pods.kubePlayDialog.Draw(screen)
+
+		return
+	}
+
 	// confirm dialog
 	if pods.confirmDialog.IsDisplay() {
 		pods.confirmDialog.SetRect(x, y, width, height)
@@ -80,4 +103,28 @@ func (pods *Pods) Draw(screen tcell.Screen) {
 
 		return
 	}
+
+	// kube generate dialog
+	if pods.kubeDialog.IsDisplay() {
+		pods.kubeDialog.SetRect(x, y, width, height)
+		pods.kubeDialog.Draw(screen)
+
+		return
+	}
+
+	// systemd generate dialog
+	if pods.systemdDialog.IsDisplay() {
+		pods.systemdDialog.SetRect(x, y, width, height)
+		pods.systemdDialog.Draw(screen)
+
+		return
+	}
+
+	// clone dialog
+	if pods.cloneDialog.IsDisplay() {
+		pods.cloneDialog.SetRect(x, y, width, height)
+		pods.cloneDialog.Draw(screen)
+
+		return
+	}
 }
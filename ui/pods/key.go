@@ -51,6 +51,13 @@ func (pods *Pods) InputHandler() func(event *tcell.EventKey, setFocus func(p tvi
 			}
 		}
 
+		// command input dialog handler
+		if pods.cmdInputDialog.HasFocus() {
+			if cmdInputHandler := pods.cmdInputDialog.InputHandler(); cmdInputHandler != nil {
+				cmdInputHandler(event, setFocus)
+			}
+		}
+
 		// top dialog handler
 		if pods.topDialog.HasFocus() {
 			if topDialogHandler := pods.topDialog.InputHandler(); topDialogHandler != nil {
@@ -65,6 +72,41 @@ func (pods *Pods) InputHandler() func(event *tcell.EventKey, setFocus func(p tvi
 			}
 		}
 
+		// kube generate dialog handler
+		if pods.kubeDialog.HasFocus() {
+			if kubeDialogHandler := pods.kubeDialog.InputHandler(); kubeDialogHandler != nil {
+				kubeDialogHandler(event, setFocus)
+			}
+		}
+
+		// kube play dialog handler
+		if pods.kubePlayDialog.HasFocus() {
+			if kubePlayDialogHandler := pods.kubePlayDialog.InputHandler(); kubePlayDialogHandler != nil {
+				kubePlayDialogHandler(event, setFocus)
+			}
+		}
+
+		// systemd generate dialog handler
+		if pods.systemdDialog.HasFocus() {
+			if systemdDialogHandler := pods.systemdDialog.InputHandler(); systemdDialogHandler != nil {
+				systemdDialogHandler(event, setFocus)
+			}
+		}
+
+		// clone dialog handler
+		if pods.cloneDialog.HasFocus() {
+			if cloneDialogHandler := pods.cloneDialog.InputHandler(); cloneDialogHandler != nil {
+				cloneDialogHandler(event, setFocus)
+			}
+		}
+
+		// filter bar handler
+		if pods.filterBar.HasFocus() {
+			if filterBarHandler := pods.filterBar.InputHandler(); filterBarHandler != nil {
+				filterBarHandler(event, setFocus)
+			}
+		}
+
 		// table handlers
 		if pods.table.HasFocus() { //nolint:nestif
 			pods.selectedID, _ = pods.getSelectedItem()
@@ -80,12 +122,53 @@ func (pods *Pods) InputHandler() func(event *tcell.EventKey, setFocus func(p tvi
 			}
 
 			if event.Key() == utils.DeleteKey.EventKey() {
-				pods.rm()
+				if pods.selectedOnActiveHost() {
+					pods.rm()
+				}
+
+				setFocus(pods)
+
+				return
+			}
+
+			if event.Rune() == utils.MarkItemKey.Rune() {
+				pods.markItem()
+
+				return
+			}
+
+			if event.Key() == utils.MarkAllKey.EventKey() {
+				pods.markedItems.ToggleAll(pods.getItems())
+
+				return
+			}
+
+			if event.Rune() == utils.MarkPatternKey.Rune() {
+				pods.markPattern()
 				setFocus(pods)
 
 				return
 			}
 
+			if event.Rune() == utils.FilterKey.Rune() {
+				pods.filterBar.Display()
+				setFocus(pods)
+
+				return
+			}
+
+			if event.Rune() == utils.SortColumnKey.Rune() {
+				pods.tableSort.Next()
+
+				return
+			}
+
+			if event.Rune() == utils.SortOrderKey.Rune() {
+				pods.tableSort.Reverse()
+
+				return
+			}
+
 			if tableHandler := pods.table.InputHandler(); tableHandler != nil {
 				tableHandler(event, setFocus)
 			}
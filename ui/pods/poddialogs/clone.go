@@ -0,0 +1,394 @@
+package poddialogs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/pods"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	podCloneDialogMaxWidth = 90
+	podCloneDialogHeight   = 13
+)
+
+const (
+	podCloneNameFocus = 0 + iota
+	podCloneCPUsFocus
+	podCloneMemoryFocus
+	podCloneDestroyFocus
+	podCloneStartFocus
+	podCloneFormFocus
+)
+
+// PodCloneDialog represents pod clone dialog primitive.
+type PodCloneDialog struct {
+	*tview.Box
+	layout        *tview.Flex
+	podInfo       *tview.InputField
+	name          *tview.InputField
+	cpus          *tview.InputField
+	memory        *tview.InputField
+	destroy       *tview.Checkbox
+	start         *tview.Checkbox
+	form          *tview.Form
+	display       bool
+	focusElement  int
+	cloneHandler  func()
+	cancelHandler func()
+}
+
+// NewPodCloneDialog returns new pod clone dialog primitive.
+func NewPodCloneDialog() *PodCloneDialog {
+	dialog := &PodCloneDialog{
+		Box:     tview.NewBox(),
+		layout:  tview.NewFlex().SetDirection(tview.FlexRow),
+		podInfo: tview.NewInputField(),
+		name:    tview.NewInputField(),
+		cpus:    tview.NewInputField(),
+		memory:  tview.NewInputField(),
+		destroy: tview.NewCheckbox(),
+		start:   tview.NewCheckbox(),
+		form:    tview.NewForm(),
+	}
+
+	bgColor := style.DialogBgColor
+	fgColor := style.DialogFgColor
+	inputFieldBgColor := style.InputFieldBgColor
+	labelWidth := 8
+
+	// pod info input field
+	podInfoLabel := "POD ID:"
+
+	dialog.podInfo.SetBackgroundColor(bgColor)
+	dialog.podInfo.SetLabel("[::b]" + podInfoLabel)
+	dialog.podInfo.SetLabelWidth(len(podInfoLabel) + 1)
+	dialog.podInfo.SetFieldBackgroundColor(bgColor)
+	dialog.podInfo.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(fgColor))
+
+	// name input field
+	dialog.name.SetBackgroundColor(bgColor)
+	dialog.name.SetLabelColor(fgColor)
+	dialog.name.SetLabel("name:")
+	dialog.name.SetLabelWidth(labelWidth)
+	dialog.name.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// cpus input field
+	dialog.cpus.SetBackgroundColor(bgColor)
+	dialog.cpus.SetLabelColor(fgColor)
+	dialog.cpus.SetLabel("cpus:")
+	dialog.cpus.SetLabelWidth(labelWidth)
+	dialog.cpus.SetFieldBackgroundColor(inputFieldBgColor)
+	dialog.cpus.SetPlaceholder("number of CPUs (e.g. 1.5)")
+	dialog.cpus.SetPlaceholderTextColor(style.InfoBarItemFgColor)
+
+	// memory input field
+	dialog.memory.SetBackgroundColor(bgColor)
+	dialog.memory.SetLabelColor(fgColor)
+	dialog.memory.SetLabel("memory:")
+	dialog.memory.SetLabelWidth(labelWidth)
+	dialog.memory.SetFieldBackgroundColor(inputFieldBgColor)
+	dialog.memory.SetPlaceholder("memory limit (e.g. 512m, 2g)")
+	dialog.memory.SetPlaceholderTextColor(style.InfoBarItemFgColor)
+
+	// destroy checkbox
+	destroyLabel := "destroy original:"
+
+	dialog.destroy.SetBackgroundColor(bgColor)
+	dialog.destroy.SetLabelColor(fgColor)
+	dialog.destroy.SetLabel(destroyLabel)
+	dialog.destroy.SetLabelWidth(len(destroyLabel) + 1)
+	dialog.destroy.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// start checkbox
+	startLabel := "start clone:"
+
+	dialog.start.SetBackgroundColor(bgColor)
+	dialog.start.SetLabelColor(fgColor)
+	dialog.start.SetLabel(startLabel)
+	dialog.start.SetLabelWidth(len(startLabel) + 1)
+	dialog.start.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// form
+	dialog.form.AddButton("Cancel", nil)
+	dialog.form.AddButton("Clone", nil)
+	dialog.form.SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// cpus and memory layout row
+	resourcesLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	resourcesLayout.SetBackgroundColor(bgColor)
+	resourcesLayout.AddItem(dialog.cpus, 0, 1, true)
+	resourcesLayout.AddItem(utils.EmptyBoxSpace(bgColor), 2, 0, false) //nolint:gomnd
+	resourcesLayout.AddItem(dialog.memory, 0, 1, true)
+
+	// checkboxes layout row
+	checkboxLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	checkboxLayout.SetBackgroundColor(bgColor)
+	checkboxLayout.AddItem(dialog.destroy, len(destroyLabel)+4, 0, true) //nolint:gomnd
+	checkboxLayout.AddItem(dialog.start, 0, 1, true)
+
+	// layout
+	inputLayout := tview.NewFlex().SetDirection(tview.FlexRow)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.podInfo, 1, 0, false)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.name, 1, 0, true)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(resourcesLayout, 1, 0, true)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(checkboxLayout, 1, 0, true)
+
+	mainLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	mainLayout.SetBackgroundColor(bgColor)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainLayout.AddItem(inputLayout, 0, 1, true)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetTitle("PODMAN POD CLONE")
+	dialog.layout.AddItem(mainLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, dialogs.DialogFormHeight, 0, true)
+
+	dialog.Hide()
+
+	return dialog
+}
+
+// Display displays this primitive.
+func (d *PodCloneDialog) Display() {
+	d.display = true
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *PodCloneDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *PodCloneDialog) Hide() {
+	d.display = false
+	d.focusElement = podCloneNameFocus
+
+	d.podInfo.SetText("")
+	d.name.SetText("")
+	d.cpus.SetText("")
+	d.memory.SetText("")
+	d.destroy.SetChecked(false)
+	d.start.SetChecked(false)
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *PodCloneDialog) HasFocus() bool {
+	if d.name.HasFocus() || d.cpus.HasFocus() {
+		return true
+	}
+
+	if d.memory.HasFocus() {
+		return true
+	}
+
+	if d.destroy.HasFocus() || d.start.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus() || d.form.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *PodCloneDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case podCloneNameFocus:
+		delegate(d.name)
+	case podCloneCPUsFocus:
+		delegate(d.cpus)
+	case podCloneMemoryFocus:
+		delegate(d.memory)
+	case podCloneDestroyFocus:
+		delegate(d.destroy)
+	case podCloneStartFocus:
+		delegate(d.start)
+	case podCloneFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = podCloneNameFocus
+				d.Focus(delegate)
+				d.form.SetFocus(0)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *PodCloneDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) { //nolint:cyclop,lll
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("pod clone dialog: event %v received", event)
+
+		if event.Key() == utils.SwitchFocusKey.Key {
+			d.setFocusElement()
+		}
+
+		if event.Key() == tcell.KeyEsc {
+			d.cancelHandler()
+
+			return
+		}
+
+		if d.name.HasFocus() {
+			if nameHandler := d.name.InputHandler(); nameHandler != nil {
+				nameHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.cpus.HasFocus() {
+			if cpusHandler := d.cpus.InputHandler(); cpusHandler != nil {
+				cpusHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.memory.HasFocus() {
+			if memoryHandler := d.memory.InputHandler(); memoryHandler != nil {
+				memoryHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.destroy.HasFocus() {
+			if destroyHandler := d.destroy.InputHandler(); destroyHandler != nil {
+				destroyHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.start.HasFocus() {
+			if startHandler := d.start.InputHandler(); startHandler != nil {
+				startHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+func (d *PodCloneDialog) setFocusElement() {
+	switch d.focusElement {
+	case podCloneNameFocus:
+		d.focusElement = podCloneCPUsFocus
+	case podCloneCPUsFocus:
+		d.focusElement = podCloneMemoryFocus
+	case podCloneMemoryFocus:
+		d.focusElement = podCloneDestroyFocus
+	case podCloneDestroyFocus:
+		d.focusElement = podCloneStartFocus
+	case podCloneStartFocus:
+		d.focusElement = podCloneFormFocus
+	}
+}
+
+// SetRect set rects for this primitive.
+func (d *PodCloneDialog) SetRect(x, y, width, height int) {
+	if width > podCloneDialogMaxWidth {
+		emptySpace := (width - podCloneDialogMaxWidth) / 2 //nolint:gomnd
+		x += emptySpace
+		width = podCloneDialogMaxWidth
+	}
+
+	if height > podCloneDialogHeight {
+		emptySpace := (height - podCloneDialogHeight) / 2 //nolint:gomnd
+		y += emptySpace
+		height = podCloneDialogHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *PodCloneDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.Box.DrawForSubclass(screen, d)
+
+	x, y, width, height := d.Box.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetCloneFunc sets form clone button selected function.
+func (d *PodCloneDialog) SetCloneFunc(handler func()) *PodCloneDialog {
+	d.cloneHandler = handler
+	cloneButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+	cloneButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *PodCloneDialog) SetCancelFunc(handler func()) *PodCloneDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 2) //nolint:gomnd
+
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetPodInfo sets selected pod ID and name in clone dialog.
+func (d *PodCloneDialog) SetPodInfo(id string, name string) {
+	podInfo := fmt.Sprintf("%s (%s)", id, name)
+
+	d.podInfo.SetText(podInfo)
+}
+
+// SetCloneOptions sets the clone name, cpus and memory input fields.
+func (d *PodCloneDialog) SetCloneOptions(opts pods.PodCloneOptions) {
+	d.name.SetText(opts.Name)
+	d.cpus.SetText(opts.CPUs)
+	d.memory.SetText(opts.Memory)
+}
+
+// GetPodCloneOptions returns pod clone options based on user inputs.
+func (d *PodCloneDialog) GetPodCloneOptions() pods.PodCloneOptions {
+	return pods.PodCloneOptions{
+		Name:    strings.TrimSpace(d.name.GetText()),
+		CPUs:    strings.TrimSpace(d.cpus.GetText()),
+		Memory:  strings.TrimSpace(d.memory.GetText()),
+		Destroy: d.destroy.IsChecked(),
+		Start:   d.start.IsChecked(),
+	}
+}
@@ -0,0 +1,151 @@
+package poddialogs
+
+import (
+	"fmt"
+
+	"github.com/containers/podman-tui/pdcs/pods"
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("pod clone", Ordered, func() {
+	var podCloneApp *tview.Application
+	var podCloneScreen tcell.SimulationScreen
+	var cloneDialog *PodCloneDialog
+	var runApp func()
+
+	BeforeAll(func() {
+		podCloneApp = tview.NewApplication()
+		cloneDialog = NewPodCloneDialog()
+		podCloneScreen = tcell.NewSimulationScreen("UTF-8")
+		err := podCloneScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := podCloneApp.SetScreen(podCloneScreen).SetRoot(cloneDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		cloneDialog.Display()
+		Expect(cloneDialog.IsDisplay()).To(Equal(true))
+	})
+
+	It("set focus", func() {
+		podCloneApp.SetFocus(cloneDialog)
+		Expect(cloneDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("set pod info", func() {
+		podID := "podID"
+		podName := "podName"
+		podInfoWants := fmt.Sprintf("%s (%s)", podID, podName)
+		cloneDialog.SetPodInfo(podID, podName)
+		Expect(cloneDialog.podInfo.GetText()).To(Equal(podInfoWants))
+	})
+
+	It("cancel button selected", func() {
+		cancelFunc := func() {
+			cloneDialog.Hide()
+		}
+		cloneDialog.Hide()
+		podCloneApp.Draw()
+		cloneDialog.SetCancelFunc(cancelFunc)
+		cloneDialog.Display()
+		podCloneApp.Draw()
+		podCloneApp.SetFocus(cloneDialog.form)
+		podCloneApp.Draw()
+		podCloneApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		podCloneApp.Draw()
+		Expect(cloneDialog.IsDisplay()).To(Equal(false))
+	})
+
+	It("clone button selected", func() {
+		cloneButton := "initial"
+		cloneButtonWants := "clone selected"
+		cloneFunc := func() {
+			cloneButton = cloneButtonWants
+		}
+		cloneDialog.Hide()
+		podCloneApp.Draw()
+		cloneDialog.SetCloneFunc(cloneFunc)
+		cloneDialog.Display()
+		podCloneApp.Draw()
+		podCloneApp.SetFocus(cloneDialog.form)
+		podCloneApp.Draw()
+		podCloneApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		podCloneApp.Draw()
+		podCloneApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		podCloneApp.Draw()
+		Expect(cloneButton).To(Equal(cloneButtonWants))
+	})
+
+	It("get clone options", func() {
+		cloneOpts := pods.PodCloneOptions{
+			Name:   "pod01-clone",
+			CPUs:   "1.5",
+			Memory: "512m",
+		}
+
+		cloneDialog.Hide()
+		podCloneApp.Draw()
+		cloneDialog.SetCloneOptions(cloneOpts)
+		cloneDialog.Display()
+		podCloneApp.Draw()
+		Expect(cloneDialog.GetPodCloneOptions()).To(Equal(cloneOpts))
+
+		// override memory
+		cloneDialog.focusElement = podCloneMemoryFocus
+		podCloneApp.SetFocus(cloneDialog)
+		podCloneApp.Draw()
+		podCloneApp.QueueEvent(tcell.NewEventKey(tcell.KeyBackspace2, 0, tcell.ModNone))
+		podCloneApp.Draw()
+		podCloneApp.QueueEvent(tcell.NewEventKey(tcell.KeyBackspace2, 0, tcell.ModNone))
+		podCloneApp.Draw()
+		podCloneApp.QueueEvent(tcell.NewEventKey(tcell.KeyBackspace2, 0, tcell.ModNone))
+		podCloneApp.Draw()
+		podCloneApp.QueueEvent(tcell.NewEventKey(tcell.KeyBackspace2, 0, tcell.ModNone))
+		podCloneApp.Draw()
+		podCloneApp.QueueEvent(tcell.NewEventKey(256, '1', tcell.ModNone))
+		podCloneApp.Draw()
+		podCloneApp.QueueEvent(tcell.NewEventKey(256, 'g', tcell.ModNone))
+		podCloneApp.Draw()
+
+		// destroy original checkbox
+		cloneDialog.setFocusElement()
+		podCloneApp.SetFocus(cloneDialog)
+		podCloneApp.Draw()
+		podCloneApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		podCloneApp.Draw()
+
+		// start clone checkbox
+		cloneDialog.setFocusElement()
+		podCloneApp.SetFocus(cloneDialog)
+		podCloneApp.Draw()
+		podCloneApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		podCloneApp.Draw()
+
+		cloneOpts.Memory = "1g"
+		cloneOpts.Destroy = true
+		cloneOpts.Start = true
+		Expect(cloneDialog.GetPodCloneOptions()).To(Equal(cloneOpts))
+	})
+
+	It("hide", func() {
+		cloneDialog.Hide()
+		Expect(cloneDialog.IsDisplay()).To(Equal(false))
+		Expect(cloneDialog.GetPodCloneOptions()).To(Equal(pods.PodCloneOptions{}))
+	})
+
+	AfterAll(func() {
+		podCloneApp.Stop()
+	})
+})
@@ -211,8 +211,6 @@ func NewPodCreateDialog() *PodCreateDialog {
 	// no new privileges
 	podDialog.podNoNewPrivField.SetLabel("no new privileges ")
 	podDialog.podNoNewPrivField.SetBackgroundColor(style.DialogBgColor)
-	podDialog.podNoNewPrivField.SetLabelColor(tcell.ColorWhite)
-	podDialog.podNoNewPrivField.SetBackgroundColor(style.DialogBgColor)
 	podDialog.podNoNewPrivField.SetLabelColor(style.DialogFgColor)
 	podDialog.podNoNewPrivField.SetFieldBackgroundColor(style.InputFieldBgColor)
 
@@ -750,7 +748,7 @@ func (d *PodCreateDialog) initData() {
 	networkList, _ := networks.List()
 
 	for i := 0; i < len(networkList); i++ {
-		networkOptions = append(networkOptions, networkList[i][1])
+		networkOptions = append(networkOptions, networkList[i].Name)
 	}
 
 	d.setActiveCategory(0)
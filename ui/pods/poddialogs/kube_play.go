@@ -0,0 +1,367 @@
+package poddialogs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/kube"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	kubePlayDialogMaxWidth  = 100
+	kubePlayDialogMaxHeight = 22
+)
+
+const (
+	kubePlayFileFocus = 0 + iota
+	kubePlayOptionFocus
+	kubePlayResourcesFocus
+	kubePlayFormFocus
+)
+
+// KubePlayMode is the kube play dialog mode.
+type KubePlayMode int
+
+const (
+	// KubePlay creates the kubernetes YAML file resources.
+	KubePlay KubePlayMode = 0 + iota
+	// KubeDown removes the kubernetes YAML file resources.
+	KubeDown
+)
+
+// KubePlayDialog implements the kube play and down dialog primitive.
+type KubePlayDialog struct {
+	*tview.Box
+	layout        *tview.Flex
+	yamlFile      *tview.InputField
+	option        *tview.Checkbox
+	resources     *tview.Table
+	form          *tview.Form
+	display       bool
+	mode          KubePlayMode
+	focusElement  int
+	playHandler   func()
+	cancelHandler func()
+}
+
+// NewKubePlayDialog returns new kube play dialog primitive.
+func NewKubePlayDialog() *KubePlayDialog {
+	dialog := &KubePlayDialog{
+		Box:       tview.NewBox(),
+		layout:    tview.NewFlex().SetDirection(tview.FlexRow),
+		yamlFile:  tview.NewInputField(),
+		option:    tview.NewCheckbox(),
+		resources: tview.NewTable(),
+		form:      tview.NewForm(),
+	}
+
+	bgColor := style.DialogBgColor
+	fgColor := style.DialogFgColor
+	inputFieldBgColor := style.InputFieldBgColor
+	labelWidth := 16
+
+	// yaml file input field
+	dialog.yamlFile.SetBackgroundColor(bgColor)
+	dialog.yamlFile.SetLabelColor(fgColor)
+	dialog.yamlFile.SetLabel("yaml file:")
+	dialog.yamlFile.SetLabelWidth(labelWidth)
+	dialog.yamlFile.SetFieldBackgroundColor(inputFieldBgColor)
+	dialog.yamlFile.SetDoneFunc(func(key tcell.Key) { //nolint:revive
+		dialog.loadResources()
+	})
+
+	// option checkbox
+	dialog.option.SetBackgroundColor(bgColor)
+	dialog.option.SetLabelColor(fgColor)
+	dialog.option.SetLabelWidth(labelWidth)
+	dialog.option.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// resources table
+	dialog.resources.SetBackgroundColor(bgColor)
+	dialog.resources.SetBorder(true)
+	dialog.resources.SetBorderColor(style.DialogSubBoxBorderColor)
+	dialog.resources.SetTitle("resources")
+	dialog.resources.SetFixed(1, 1)
+	dialog.resources.SetSelectable(true, false)
+	dialog.initResourcesTable()
+
+	// form
+	dialog.form.AddButton("Cancel", nil)
+	dialog.form.AddButton("Play", nil)
+	dialog.form.SetButtonsAlign(tview.AlignRight)
+	dialog.form.SetBackgroundColor(bgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	inputLayout := tview.NewFlex().SetDirection(tview.FlexRow)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.yamlFile, 1, 0, true)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.option, 1, 0, true)
+	inputLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	inputLayout.AddItem(dialog.resources, 0, 1, true)
+
+	mainLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	mainLayout.AddItem(inputLayout, 0, 1, true)
+	mainLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	dialog.layout.SetBackgroundColor(bgColor)
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.AddItem(mainLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, dialogs.DialogFormHeight, 0, true)
+
+	dialog.SetMode(KubePlay)
+
+	return dialog
+}
+
+// SetMode sets the dialog mode (kube play or kube down).
+func (d *KubePlayDialog) SetMode(mode KubePlayMode) {
+	d.mode = mode
+	button := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+	switch mode {
+	case KubePlay:
+		d.layout.SetTitle("PODMAN KUBE PLAY")
+		d.option.SetLabel("replace:")
+		button.SetLabel("Play")
+	case KubeDown:
+		d.layout.SetTitle("PODMAN KUBE DOWN")
+		d.option.SetLabel("remove volumes:")
+		button.SetLabel("Down")
+	}
+}
+
+// GetMode returns the dialog mode (kube play or kube down).
+func (d *KubePlayDialog) GetMode() KubePlayMode {
+	return d.mode
+}
+
+// Display displays this primitive.
+func (d *KubePlayDialog) Display() {
+	d.display = true
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *KubePlayDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *KubePlayDialog) Hide() {
+	d.display = false
+	d.focusElement = kubePlayFileFocus
+
+	d.yamlFile.SetText("")
+	d.option.SetChecked(false)
+	d.initResourcesTable()
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *KubePlayDialog) HasFocus() bool {
+	if d.yamlFile.HasFocus() || d.option.HasFocus() {
+		return true
+	}
+
+	if d.resources.HasFocus() || d.form.HasFocus() {
+		return true
+	}
+
+	return d.layout.HasFocus() || d.Box.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *KubePlayDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case kubePlayFileFocus:
+		delegate(d.yamlFile)
+	case kubePlayOptionFocus:
+		delegate(d.option)
+	case kubePlayResourcesFocus:
+		delegate(d.resources)
+	case kubePlayFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = kubePlayFileFocus
+				d.Focus(delegate)
+				d.form.SetFocus(0)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *KubePlayDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("kube play dialog: event %v received", event)
+
+		if event.Key() == tcell.KeyEsc {
+			d.cancelHandler()
+
+			return
+		}
+
+		if event.Key() == utils.SwitchFocusKey.Key {
+			d.setFocusElement()
+		}
+
+		if d.yamlFile.HasFocus() {
+			if yamlFileHandler := d.yamlFile.InputHandler(); yamlFileHandler != nil {
+				yamlFileHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.option.HasFocus() {
+			if optionHandler := d.option.InputHandler(); optionHandler != nil {
+				optionHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.resources.HasFocus() {
+			if resourcesHandler := d.resources.InputHandler(); resourcesHandler != nil {
+				resourcesHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+func (d *KubePlayDialog) setFocusElement() {
+	switch d.focusElement {
+	case kubePlayFileFocus:
+		d.focusElement = kubePlayOptionFocus
+	case kubePlayOptionFocus:
+		d.focusElement = kubePlayResourcesFocus
+	case kubePlayResourcesFocus:
+		d.focusElement = kubePlayFormFocus
+	}
+}
+
+// SetRect set rects for this primitive.
+func (d *KubePlayDialog) SetRect(x, y, width, height int) {
+	if width > kubePlayDialogMaxWidth {
+		emptySpace := (width - kubePlayDialogMaxWidth) / 2 //nolint:gomnd
+		x += emptySpace
+		width = kubePlayDialogMaxWidth
+	}
+
+	if height > kubePlayDialogMaxHeight {
+		emptySpace := (height - kubePlayDialogMaxHeight) / 2 //nolint:gomnd
+		y += emptySpace
+		height = kubePlayDialogMaxHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *KubePlayDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.Box.DrawForSubclass(screen, d)
+	x, y, width, height := d.Box.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetPlayFunc sets form play (or down) button selected function.
+func (d *KubePlayDialog) SetPlayFunc(handler func()) *KubePlayDialog {
+	d.playHandler = handler
+	playButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+	playButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *KubePlayDialog) SetCancelFunc(handler func()) *KubePlayDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 2) //nolint:gomnd
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// GetYAMLFile returns the kubernetes YAML file path.
+func (d *KubePlayDialog) GetYAMLFile() (string, error) {
+	return utils.ResolveHomeDir(strings.TrimSpace(d.yamlFile.GetText()))
+}
+
+// GetOption returns the replace (kube play) or remove volumes (kube down) option.
+func (d *KubePlayDialog) GetOption() bool {
+	return d.option.IsChecked()
+}
+
+func (d *KubePlayDialog) initResourcesTable() {
+	d.resources.Clear()
+
+	for i, header := range []string{"kind", "name", "containers"} {
+		d.resources.SetCell(0, i,
+			tview.NewTableCell(fmt.Sprintf("[%s::b]%s", style.GetColorHex(style.TableHeaderFgColor), strings.ToUpper(header))).
+				SetExpansion(1).
+				SetBackgroundColor(style.TableHeaderBgColor).
+				SetTextColor(style.TableHeaderFgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
+	}
+}
+
+// loadResources displays the kubernetes YAML file resources which will be created (or removed).
+func (d *KubePlayDialog) loadResources() {
+	d.initResourcesTable()
+
+	path, err := d.GetYAMLFile()
+	if err != nil || path == "" {
+		return
+	}
+
+	resources, err := kube.Resources(path)
+	if err != nil {
+		d.resources.SetCell(1, 0,
+			tview.NewTableCell(tview.Escape(fmt.Sprintf("%v", err))).
+				SetTextColor(style.ErrorDialogBgColor).
+				SetSelectable(false))
+
+		return
+	}
+
+	for i, resource := range resources {
+		row := i + 1
+
+		d.resources.SetCell(row, 0, tview.NewTableCell(resource.Kind).SetExpansion(1))
+		d.resources.SetCell(row, 1, tview.NewTableCell(resource.Name).SetExpansion(1))
+		d.resources.SetCell(row, 2, //nolint:gomnd
+			tview.NewTableCell(tview.Escape(strings.Join(resource.Containers, ", "))).SetExpansion(1))
+	}
+}
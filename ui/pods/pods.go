@@ -6,9 +6,11 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/containers/podman-tui/pdcs/registry"
 	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/containers/podman-tui/ui/pods/poddialogs"
 	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/rivo/tview"
 )
@@ -20,6 +22,7 @@ const (
 	viewPodCreatedColIndex
 	viewPodInfraIDColIndex
 	viewPodContainersColIndex
+	viewPodHostColIndex
 )
 
 var (
@@ -33,10 +36,17 @@ var (
 	errNoPodKill    = errors.New("there is no pod to kill")
 	errNoPodInspect = errors.New("there is no pod to display inspect")
 	errNoPodStat    = errors.New("there is no pod to display stats")
+	errNoPodKube    = errors.New("there is no pod to generate kube")
+	errNoPodSystemd = errors.New("there is no pod to generate systemd")
+	errNoPodClone   = errors.New("there is no pod to clone")
 	errPodRemove    = errors.New("remove error")
 	errPodPrune     = errors.New("prune error")
+	errPodNotActive = errors.New("the pod is not on the active service connection")
 )
 
+// bulkCommands list of the commands which are run for the marked pods (if any).
+var bulkCommands = []string{"kill", "pause", "restart", "rm", "start", "stop", "unpause"}
+
 // Pods implemnents the pods page primitive.
 type Pods struct {
 	*tview.Box
@@ -47,18 +57,33 @@ type Pods struct {
 	progressDialog *dialogs.ProgressDialog
 	confirmDialog  *dialogs.ConfirmDialog
 	cmdDialog      *dialogs.CommandDialog
+	cmdInputDialog *dialogs.SimpleInputDialog
 	messageDialog  *dialogs.MessageDialog
 	topDialog      *dialogs.TopDialog
 	createDialog   *poddialogs.PodCreateDialog
 	statsDialog    *poddialogs.PodStatsDialog
+	kubeDialog     *dialogs.KubeGenerateDialog
+	kubePlayDialog *poddialogs.KubePlayDialog
+	systemdDialog  *dialogs.SystemdGenerateDialog
+	cloneDialog    *poddialogs.PodCloneDialog
+	filterBar      *dialogs.FilterBar
 	podsList       podsListReport
+	markedItems    *utils.MarkedItems
+	tableSort      *utils.TableSort
 	selectedID     string
 	confirmData    string
+	bulkCmd        string
 }
 
 type podsListReport struct {
 	mu     sync.Mutex
-	report []*entities.ListPodsReport
+	report []hostPod
+}
+
+// hostPod implements a pod list item and its service connection name.
+type hostPod struct {
+	*entities.ListPodsReport
+	host string
 }
 
 // NewPods returns pods page view.
@@ -66,22 +91,36 @@ func NewPods() *Pods {
 	pods := &Pods{
 		Box:            tview.NewBox(),
 		title:          "pods",
-		headers:        []string{"pod id", "name", "status", "created", "infra id", "# of containers"},
+		headers:        []string{"pod id", "name", "status", "created", "infra id", "# of containers", "host"},
 		errorDialog:    dialogs.NewErrorDialog(),
 		confirmDialog:  dialogs.NewConfirmDialog(),
 		progressDialog: dialogs.NewProgressDialog(),
+		cmdInputDialog: dialogs.NewSimpleInputDialog(""),
 		messageDialog:  dialogs.NewMessageDialog(""),
 		topDialog:      dialogs.NewTopDialog(),
 		createDialog:   poddialogs.NewPodCreateDialog(),
 		statsDialog:    poddialogs.NewPodStatsDialog(),
+		kubeDialog:     dialogs.NewKubeGenerateDialog(),
+		kubePlayDialog: poddialogs.NewKubePlayDialog(),
+		systemdDialog:  dialogs.NewSystemdGenerateDialog(),
+		cloneDialog:    poddialogs.NewPodCloneDialog(),
+		filterBar:      dialogs.NewFilterBar(),
+		markedItems:    utils.NewMarkedItems(),
 	}
 
+	pods.tableSort = utils.NewTableSort(len(pods.headers), viewPodNameColIndex)
+
 	pods.topDialog.SetTitle("podman pod top")
 
 	pods.cmdDialog = dialogs.NewCommandDialog([][]string{
+		{"clone", "create a copy of the selected pod and its containers with optional overrides"},
 		{"create", "create a new pod"},
+		{"generate kube", "generate kubernetes YAML of the selected pod"},
+		{"generate systemd", "generate quadlet or systemd unit files of the selected pod"},
 		{"inspect", "display information describing the selected pod"},
 		{"kill", "send SIGTERM signal to containers in the pod"},
+		{"kube down", "remove pods and containers created from a kubernetes YAML file"},
+		{"kube play", "create pods and containers from a kubernetes YAML file"},
 		{"pause", "pause  the selected pod"},
 		{"prune", "remove all stopped pods and their containers"},
 		{"restart", "restart  the selected pod"},
@@ -102,12 +141,12 @@ func NewPods() *Pods {
 
 	for i := 0; i < len(pods.headers); i++ {
 		pods.table.SetCell(0, i,
-			tview.NewTableCell(fmt.Sprintf("[black::b]%s", strings.ToUpper(pods.headers[i]))). //nolint:perfsprint
-														SetExpansion(1).
-														SetBackgroundColor(style.PageHeaderBgColor).
-														SetTextColor(style.PageHeaderFgColor).
-														SetAlign(tview.AlignLeft).
-														SetSelectable(false))
+			tview.NewTableCell("[::b]"+strings.ToUpper(pods.headers[i])).
+				SetExpansion(1).
+				SetBackgroundColor(style.PageHeaderBgColor).
+				SetTextColor(style.PageHeaderFgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
 	}
 
 	pods.table.SetFixed(1, 1)
@@ -122,6 +161,10 @@ func NewPods() *Pods {
 		pods.cmdDialog.Hide()
 	})
 
+	// set input cmd dialog functions
+	pods.cmdInputDialog.SetCancelFunc(pods.cmdInputDialog.Hide)
+	pods.cmdInputDialog.SetSelectedFunc(pods.cmdInputDialog.Hide)
+
 	// set message dialog functions
 	pods.messageDialog.SetCancelFunc(func() {
 		pods.messageDialog.Hide()
@@ -141,6 +184,8 @@ func NewPods() *Pods {
 			pods.prune()
 		case "rm":
 			pods.remove()
+		case "bulk":
+			pods.bulkCommand()
 		}
 	})
 
@@ -161,6 +206,23 @@ func NewPods() *Pods {
 	// set stats dialogs functions
 	pods.statsDialog.SetDoneFunc(pods.statsDialog.Hide)
 
+	// set kube generate dialog functions
+	pods.kubeDialog.SetCancelFunc(pods.kubeDialog.Hide)
+	pods.kubeDialog.SetSaveFunc(pods.saveKube)
+
+	// set kube play dialog functions
+	pods.kubePlayDialog.SetCancelFunc(pods.kubePlayDialog.Hide)
+	pods.kubePlayDialog.SetPlayFunc(pods.kubePlay)
+
+	// set systemd generate dialog functions
+	pods.systemdDialog.SetCancelFunc(pods.systemdDialog.Hide)
+	pods.systemdDialog.SetPreviewFunc(pods.previewSystemd)
+	pods.systemdDialog.SetWriteFunc(pods.writeSystemd)
+
+	// set clone dialog functions
+	pods.cloneDialog.SetCancelFunc(pods.cloneDialog.Hide)
+	pods.cloneDialog.SetCloneFunc(pods.clone)
+
 	return pods
 }
 
@@ -187,7 +249,19 @@ func (pods *Pods) HasFocus() bool {
 		return true
 	}
 
-	if pods.statsDialog.HasFocus() {
+	if pods.statsDialog.HasFocus() || pods.cmdInputDialog.HasFocus() {
+		return true
+	}
+
+	if pods.kubeDialog.HasFocus() || pods.kubePlayDialog.HasFocus() {
+		return true
+	}
+
+	if pods.systemdDialog.HasFocus() || pods.filterBar.HasFocus() {
+		return true
+	}
+
+	if pods.cloneDialog.HasFocus() {
 		return true
 	}
 
@@ -212,6 +286,18 @@ func (pods *Pods) SubDialogHasFocus() bool {
 		return true
 	}
 
+	if pods.cmdInputDialog.HasFocus() || pods.filterBar.HasFocus() {
+		return true
+	}
+
+	if pods.kubeDialog.HasFocus() || pods.kubePlayDialog.HasFocus() {
+		return true
+	}
+
+	if pods.systemdDialog.HasFocus() || pods.cloneDialog.HasFocus() {
+		return true
+	}
+
 	return false
 }
 
@@ -231,6 +317,13 @@ func (pods *Pods) Focus(delegate func(p tview.Primitive)) {
 		return
 	}
 
+	// command input dialog
+	if pods.cmdInputDialog.IsDisplay() {
+		delegate(pods.cmdInputDialog)
+
+		return
+	}
+
 	// message dialog
 	if pods.messageDialog.IsDisplay() {
 		delegate(pods.messageDialog)
@@ -266,6 +359,41 @@ func (pods *Pods) Focus(delegate func(p tview.Primitive)) {
 		return
 	}
 
+	// kube generate dialog
+	if pods.kubeDialog.IsDisplay() {
+		delegate(pods.kubeDialog)
+
+		return
+	}
+
+	// kube play dialog
+	if pods.kubePlayDialog.IsDisplay() {
+		delegate(pods.kubePlayDialog)
+
+		return
+	}
+
+	// systemd generate dialog
+	if pods.systemdDialog.IsDisplay() {
+		delegate(pods.systemdDialog)
+
+		return
+	}
+
+	// clone dialog
+	if pods.cloneDialog.IsDisplay() {
+		delegate(pods.cloneDialog)
+
+		return
+	}
+
+	// filter bar
+	if pods.filterBar.IsDisplay() {
+		delegate(pods.filterBar)
+
+		return
+	}
+
 	delegate(pods.table)
 }
 
@@ -286,6 +414,40 @@ func (pods *Pods) getSelectedItem() (string, string) {
 	return id, name
 }
 
+// selectedOnActiveHost returns true if the selected pod is on the
+// active service connection otherwise displays an error.
+func (pods *Pods) selectedOnActiveHost() bool {
+	if pods.table.GetRowCount() <= 1 {
+		return true
+	}
+
+	row, _ := pods.table.GetSelection()
+
+	host := pods.table.GetCell(row, viewPodHostColIndex).Text
+	if host != "" && host != registry.ConnectionName() {
+		pods.displayError("", fmt.Errorf("%w (%s), connect to %s to run the command",
+			errPodNotActive, host, host))
+
+		return false
+	}
+
+	return true
+}
+
+func (pods *Pods) getItems() []utils.MarkedItem {
+	items := []utils.MarkedItem{}
+
+	for row := 1; row < pods.table.GetRowCount(); row++ {
+		items = append(items, utils.MarkedItem{
+			ID:   pods.table.GetCell(row, viewPodIDColIndex).Text,
+			Name: pods.table.GetCell(row, viewPodNameColIndex).Text,
+			Host: pods.table.GetCell(row, viewPodHostColIndex).Text,
+		})
+	}
+
+	return items
+}
+
 func (pods *Pods) getAllItemsForStats() []poddialogs.PodStatsDropDownOptions {
 	var items []poddialogs.PodStatsDropDownOptions
 
@@ -322,6 +484,10 @@ func (pods *Pods) HideAllDialogs() {
 		pods.cmdDialog.Hide()
 	}
 
+	if pods.cmdInputDialog.IsDisplay() {
+		pods.cmdInputDialog.Hide()
+	}
+
 	if pods.messageDialog.IsDisplay() {
 		pods.messageDialog.Hide()
 	}
@@ -337,4 +503,24 @@ func (pods *Pods) HideAllDialogs() {
 	if pods.statsDialog.IsDisplay() {
 		pods.statsDialog.Hide()
 	}
+
+	if pods.kubeDialog.IsDisplay() {
+		pods.kubeDialog.Hide()
+	}
+
+	if pods.kubePlayDialog.IsDisplay() {
+		pods.kubePlayDialog.Hide()
+	}
+
+	if pods.systemdDialog.IsDisplay() {
+		pods.systemdDialog.Hide()
+	}
+
+	if pods.cloneDialog.IsDisplay() {
+		pods.cloneDialog.Hide()
+	}
+
+	if pods.filterBar.IsDisplay() {
+		pods.filterBar.Hide()
+	}
 }
@@ -20,18 +20,19 @@ func (pods *Pods) refresh() {
 
 	for i := 0; i < len(pods.headers); i++ {
 		pods.table.SetCell(0, i,
-			tview.NewTableCell(fmt.Sprintf("[::b]%s", strings.ToUpper(pods.headers[i]))). //nolint:perfsprint
-													SetExpansion(expand).
-													SetBackgroundColor(style.PageHeaderBgColor).
-													SetTextColor(style.PageHeaderFgColor).
-													SetAlign(tview.AlignLeft).
-													SetSelectable(false))
+			tview.NewTableCell(pods.tableSort.HeaderLabel(i, pods.headers[i])).
+				SetExpansion(expand).
+				SetBackgroundColor(style.PageHeaderBgColor).
+				SetTextColor(style.PageHeaderFgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
 	}
 
 	rowIndex := 1
 	podList := pods.getData()
+	filter := pods.filterBar.GetFilter()
 
-	pods.table.SetTitle(fmt.Sprintf("[::b]%s[%d]", strings.ToUpper(pods.title), len(podList)))
+	markIDs := make([]string, 0, len(podList))
 
 	for i := 0; i < len(podList); i++ {
 		podID := podList[i].Id
@@ -46,18 +47,29 @@ func (pods *Pods) refresh() {
 		}
 
 		podNumCtn := strconv.Itoa(len(podList[i].Containers))
+		podHost := podList[i].host
+
+		markIDs = append(markIDs, utils.MarkedItemKey(podHost, podID))
+
+		if !utils.MatchHostFilter(filter, podHost) {
+			continue
+		}
+
+		if !utils.MatchFilter(filter, podList[i].Labels, podList[i].Id, podName, podStatus, podHost) {
+			continue
+		}
 
 		cellTextColor := style.FgColor
 
 		switch strings.ToLower(podStatus) {
 		case "running":
-			podStatus = fmt.Sprintf("[green::]%s[-::] %s", "\u25B2", podStatus)
+			podStatus = fmt.Sprintf("[%s::]%s[-::] %s", style.GetColorHex(style.RunningStatusFgColor), "\u25B2", podStatus)
 			cellTextColor = style.RunningStatusFgColor
 		case "paused":
-			podStatus = fmt.Sprintf("[red::]%s[-::] %s", "\u25BC", podStatus)
+			podStatus = fmt.Sprintf("[%s::]%s[-::] %s", style.GetColorHex(style.StoppedStatusFgColor), "\u25BC", podStatus)
 			cellTextColor = style.PausedStatusFgColor
 		default:
-			podStatus = fmt.Sprintf("[red::]%s[-::] %s", "\u25BC", podStatus)
+			podStatus = fmt.Sprintf("[%s::]%s[-::] %s", style.GetColorHex(style.StoppedStatusFgColor), "\u25BC", podStatus)
 		}
 
 		// id column
@@ -102,6 +114,17 @@ func (pods *Pods) refresh() {
 				SetExpansion(expand).
 				SetAlign(alignment))
 
+		// host column
+		pods.table.SetCell(rowIndex, viewPodHostColIndex,
+			tview.NewTableCell(podHost).
+				SetTextColor(cellTextColor).
+				SetExpansion(expand).
+				SetAlign(alignment))
+
 		rowIndex++
 	}
+
+	pods.markedItems.Retain(markIDs)
+	pods.markedItems.HighlightHostMarkedRows(pods.table, viewPodIDColIndex, viewPodHostColIndex)
+	pods.table.SetTitle(utils.TableTitle(pods.title, len(podList), rowIndex-1, filter, pods.markedItems.Count()))
 }
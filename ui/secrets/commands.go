@@ -3,21 +3,32 @@ package secrets
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/containers/podman-tui/pdcs/containers"
 	"github.com/containers/podman-tui/pdcs/secrets"
 	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/secrets/secdialogs"
 	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/rivo/tview"
 	"github.com/rs/zerolog/log"
 )
 
 func (s *Secrets) runCommand(cmd string) {
 	switch cmd {
+	case "copy":
+		s.preReveal("copy")
 	case "create":
 		s.createDialog.Display()
 	case "inspect":
 		s.inspect()
+	case "reveal":
+		s.preReveal("reveal")
 	case "rm":
 		s.rm()
+	case "rotate":
+		s.preRotate()
 	}
 }
 
@@ -75,7 +86,149 @@ func (s *Secrets) inspect() {
 	s.messageDialog.Display()
 }
 
+func (s *Secrets) preReveal(cmd string) {
+	_, secID, secName := s.getSelectedItem()
+	if secID == "" {
+		s.displayError("", errNoSecretReveal)
+
+		return
+	}
+
+	s.confirmDialog.SetTitle("podman secret " + cmd)
+	s.confirmData = cmd
+
+	bgColor := style.GetColorHex(style.DialogBorderColor)
+	fgColor := style.GetColorHex(style.DialogFgColor)
+	secretItem := fmt.Sprintf("[%s:%s:b]SECRET ID:[:-:-] %s (%s)", fgColor, bgColor, secID, secName)
+
+	question := fmt.Sprintf("Are you sure you want to display the selected secret value for %v?", s.revealTimeout)
+	if cmd == "copy" {
+		question = "Are you sure you want to copy the selected secret value to the clipboard?"
+	}
+
+	description := fmt.Sprintf("%s\n\n%s", secretItem, question)
+	if s.auditLog != "" {
+		description += "\n(the secret access is recorded in the audit log)"
+	}
+
+	s.confirmDialog.SetText(description)
+	s.confirmDialog.Display()
+}
+
+// revealSecret returns the secret value after recording the access in the audit log.
+func (s *Secrets) revealSecret(secID string, secName string, action string) (string, error) {
+	if secID == "" {
+		return "", errNoSecretReveal
+	}
+
+	value, err := secrets.Reveal(secID)
+	if err != nil {
+		return "", err
+	}
+
+	if s.auditLog != "" {
+		if err := secrets.AuditReveal(s.auditLog, secID, secName, action); err != nil {
+			return "", fmt.Errorf("audit log: %w", err)
+		}
+	}
+
+	return value, nil
+}
+
+func (s *Secrets) reveal() {
+	_, secID, secName := s.getSelectedItem()
+
+	value, err := s.revealSecret(secID, secName, secrets.RevealActionDisplay)
+	if err != nil {
+		title := fmt.Sprintf("SECRET (%s) REVEAL ERROR", secID)
+		s.displayError(title, err)
+
+		return
+	}
+
+	headerLabel := fmt.Sprintf("%s (%s)", secID, secName)
+
+	s.revealDialog.SetTitle(fmt.Sprintf("podman secret reveal (hidden after %v)", s.revealTimeout))
+	s.revealDialog.SetText(dialogs.MessageSecretInfo, headerLabel, tview.Escape(value))
+	s.revealDialog.Display()
+
+	if s.revealTimer != nil {
+		s.revealTimer.Stop()
+	}
+
+	var timer *time.Timer
+
+	timer = time.AfterFunc(s.revealTimeout, func() {
+		s.queueUpdateDraw(func() {
+			// a newer reveal replaced or hid the value
+			if s.revealTimer != timer {
+				return
+			}
+
+			s.hideReveal()
+		})
+	})
+
+	s.revealTimer = timer
+}
+
+// hideReveal hides the revealed secret value (before its display timeout if
+// not called by the reveal timer) and gives the focus back to the secrets table.
+func (s *Secrets) hideReveal() {
+	if s.revealTimer != nil {
+		s.revealTimer.Stop()
+		s.revealTimer = nil
+	}
+
+	focused := s.revealDialog.HasFocus()
+
+	s.revealDialog.Hide()
+
+	if focused && s.appFocus != nil {
+		s.appFocus(s)
+	}
+}
+
+func (s *Secrets) copySecret() {
+	_, secID, secName := s.getSelectedItem()
+
+	copyValue := func() {
+		value, err := s.revealSecret(secID, secName, secrets.RevealActionCopy)
+
+		// the clipboard sequence is written to the screen terminal from the UI goroutine
+		s.queueUpdateDraw(func() {
+			if err == nil {
+				err = s.clipboardFunc(value)
+			}
+
+			if err != nil {
+				title := fmt.Sprintf("SECRET (%s) COPY ERROR", secID)
+				s.displayError(title, err)
+			} else {
+				headerLabel := fmt.Sprintf("%s (%s)", secID, secName)
+
+				s.messageDialog.SetTitle("podman secret copy")
+				s.messageDialog.SetText(dialogs.MessageSecretInfo, headerLabel, "secret value copied to the clipboard")
+				s.messageDialog.Display()
+			}
+
+			// the error or message dialog gets the focus
+			if s.appFocus != nil {
+				s.appFocus(s)
+			}
+		})
+	}
+
+	go copyValue()
+}
+
 func (s *Secrets) rm() {
+	if s.markedItems.Count() > 0 {
+		s.preBulkCommand("rm")
+
+		return
+	}
+
 	_, secID, secName := s.getSelectedItem()
 	if secID == "" {
 		s.displayError("", errNoSecretRemove)
@@ -84,6 +237,7 @@ func (s *Secrets) rm() {
 	}
 
 	s.confirmDialog.SetTitle("podman secret remove")
+	s.confirmData = "rm"
 
 	bgColor := style.GetColorHex(style.DialogBorderColor)
 	fgColor := style.GetColorHex(style.DialogFgColor)
@@ -128,3 +282,205 @@ func (s *Secrets) remove() {
 
 	go remove(secID)
 }
+
+func (s *Secrets) preRotate() {
+	_, secID, secName := s.getSelectedItem()
+	if secID == "" {
+		s.displayError("", errNoSecretRotate)
+
+		return
+	}
+
+	s.progressDialog.SetTitle("secret consumers scan in progress")
+	s.progressDialog.Display()
+
+	scan := func() {
+		consumers, err := secrets.Consumers(secID, secName)
+
+		s.progressDialog.Hide()
+
+		if err != nil {
+			title := fmt.Sprintf("SECRET (%s) ROTATE ERROR", secID)
+			s.displayError(title, err)
+
+			return
+		}
+
+		s.consumers = consumers
+
+		s.rotateDialog.SetSecretInfo(secID, secName)
+		s.rotateDialog.SetConsumers(consumers)
+		s.rotateDialog.Display()
+	}
+
+	go scan()
+}
+
+func (s *Secrets) rotate() {
+	rotateOpts, action := s.rotateDialog.GetRotateOptions()
+
+	if rotateOpts.File != "" && rotateOpts.Text != "" {
+		s.displayError("SECRET ROTATE ERROR", errSecretFileAndText)
+
+		return
+	}
+
+	if rotateOpts.File == "" && rotateOpts.Text == "" {
+		s.displayError("SECRET ROTATE ERROR", errEmptySecretFileOrText)
+
+		return
+	}
+
+	file, err := utils.ResolveHomeDir(rotateOpts.File)
+	if err != nil {
+		s.displayError("SECRET ROTATE ERROR", err)
+
+		return
+	}
+
+	rotateOpts.File = file
+
+	_, secID, secName := s.getSelectedItem()
+	consumers := s.consumers
+
+	s.rotateDialog.Hide()
+	s.progressDialog.SetTitle("secret rotate in progress")
+	s.progressDialog.Display()
+
+	rotate := func() {
+		err := secrets.Rotate(secID, rotateOpts)
+		if err != nil {
+			s.progressDialog.Hide()
+
+			title := fmt.Sprintf("SECRET (%s) ROTATE ERROR", secID)
+			s.displayError(title, err)
+
+			return
+		}
+
+		message := "secret value replaced"
+
+		if results := rotateConsumers(consumers, action); len(results) > 0 {
+			summary, report := utils.BulkCommandSummary(results)
+			message = fmt.Sprintf("%s, consumers %s: %s\n\n%s", message, action, summary, report)
+		}
+
+		s.progressDialog.Hide()
+
+		headerLabel := fmt.Sprintf("%s (%s)", secID, secName)
+
+		s.messageDialog.SetTitle("podman secret rotate")
+		s.messageDialog.SetText(dialogs.MessageSecretInfo, headerLabel, message)
+		s.messageDialog.Display()
+		s.UpdateData()
+	}
+
+	go rotate()
+}
+
+// rotateConsumers restarts the running consumers or recreates the consumers of a rotated
+// secret according to the action and returns the results.
+func rotateConsumers(consumers []secrets.SecretConsumer, action string) []utils.BulkCommandResult {
+	if action == secdialogs.SecretRotateActionNone {
+		return nil
+	}
+
+	results := make([]utils.BulkCommandResult, 0, len(consumers))
+
+	for _, consumer := range consumers {
+		var err error
+
+		switch action {
+		case secdialogs.SecretRotateActionRestart:
+			if !consumer.Running {
+				continue
+			}
+
+			err = containers.Restart(consumer.ID, containers.DefaultRestartTimeout)
+		case secdialogs.SecretRotateActionRecreate:
+			_, err = containers.Recreate(consumer.ID)
+		default:
+			continue
+		}
+
+		results = append(results, utils.BulkCommandResult{
+			Item: utils.MarkedItem{ID: utils.GetIDWithLimit(consumer.ID), Name: consumer.Name},
+			Err:  err,
+		})
+	}
+
+	return results
+}
+
+func (s *Secrets) markItem() {
+	rowIndex, secID, secName := s.getSelectedItem()
+	if secID == "" {
+		return
+	}
+
+	s.markedItems.Toggle(secID, secName)
+
+	if rowIndex+1 < s.table.GetRowCount() {
+		s.table.Select(rowIndex+1, 0)
+	}
+}
+
+func (s *Secrets) markPattern() {
+	s.cmdInputDialog.SetTitle("mark secrets")
+	s.cmdInputDialog.SetDescription("mark the secrets which their ID or name matches the regular expression")
+	s.cmdInputDialog.SetSelectButtonLabel("mark")
+	s.cmdInputDialog.SetLabel("pattern ")
+
+	s.cmdInputDialog.SetSelectedFunc(func() {
+		pattern := s.cmdInputDialog.GetInputText()
+		s.cmdInputDialog.Hide()
+
+		if _, err := s.markedItems.Mark(s.getItems(), pattern); err != nil {
+			s.displayError("SECRET MARK ERROR", err)
+
+			return
+		}
+	})
+
+	s.cmdInputDialog.Display()
+}
+
+func (s *Secrets) preBulkCommand(cmd string) {
+	s.confirmDialog.SetTitle("podman secret " + cmd)
+	s.confirmData = "bulk"
+	s.bulkCmd = cmd
+
+	s.confirmDialog.SetText(utils.BulkCommandConfirmMessage("secret", cmd, s.markedItems.Items()))
+	s.confirmDialog.Display()
+}
+
+func (s *Secrets) bulkCommand() {
+	var cmdFunc func(id string) error
+
+	cmd := s.bulkCmd
+
+	switch cmd {
+	case "rm":
+		cmdFunc = secrets.Remove
+	default:
+		return
+	}
+
+	s.progressDialog.SetTitle(fmt.Sprintf("secret %s in progress", cmd))
+	s.progressDialog.Display()
+
+	bulk := func() {
+		results := s.markedItems.RunBulkCommand(cmdFunc)
+
+		s.progressDialog.Hide()
+
+		summary, report := utils.BulkCommandSummary(results)
+
+		s.messageDialog.SetTitle("podman secret " + cmd)
+		s.messageDialog.SetText(dialogs.MessageBulkCommandInfo, summary, report)
+		s.messageDialog.Display()
+		s.UpdateData()
+	}
+
+	go bulk()
+}
@@ -0,0 +1,81 @@
+package secrets
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/secrets"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman/v5/pkg/domain/entities/types"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+// UpdateData retrieves secrets list data.
+func (s *Secrets) UpdateData() {
+	secResponse, err := secrets.List()
+	if err != nil {
+		log.Error().Msgf("view: secrets update %v", err)
+
+		s.errorDialog.SetText(fmt.Sprintf("%v", err))
+		s.errorDialog.Display()
+	}
+
+	s.secretsList.mu.Lock()
+	s.secretsList.report = secResponse
+	s.secretsList.mu.Unlock()
+}
+
+// getData returns a copy of the list data sorted by the table sort column and order.
+func (s *Secrets) getData() []*types.SecretInfoReport {
+	s.secretsList.mu.Lock()
+	data := make([]*types.SecretInfoReport, len(s.secretsList.report))
+	copy(data, s.secretsList.report)
+	s.secretsList.mu.Unlock()
+
+	column := s.tableSort.Column()
+
+	sort.SliceStable(data, func(i, j int) bool {
+		return s.tableSort.Less(compareSecrets(column, data[i], data[j]))
+	})
+
+	return data
+}
+
+func compareSecrets(column int, a, b *types.SecretInfoReport) int {
+	switch column {
+	case viewSecretsIDColIndex:
+		return strings.Compare(a.ID, b.ID)
+	case viewSecretsDriverColIndex:
+		return strings.Compare(a.Spec.Driver.Name, b.Spec.Driver.Name)
+	case viewSecretsCreatedColIndex:
+		return a.CreatedAt.Compare(b.CreatedAt)
+	case viewSecretsUpdatedColIndex:
+		return a.UpdatedAt.Compare(b.UpdatedAt)
+	default:
+		return strings.Compare(a.Spec.Name, b.Spec.Name)
+	}
+}
+
+// ClearData clears table data.
+func (s *Secrets) ClearData() {
+	s.secretsList.mu.Lock()
+	s.secretsList.report = nil
+	s.secretsList.mu.Unlock()
+	s.table.Clear()
+
+	expand := 1
+
+	for i := 0; i < len(s.headers); i++ {
+		s.table.SetCell(0, i,
+			tview.NewTableCell(s.tableSort.HeaderLabel(i, s.headers[i])).
+				SetExpansion(expand).
+				SetBackgroundColor(style.PageHeaderBgColor).
+				SetTextColor(style.PageHeaderFgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
+	}
+
+	s.table.SetTitle(fmt.Sprintf("[::b]%s[0]", strings.ToUpper(s.title)))
+}
@@ -1,14 +1,24 @@
 package secrets
 
-import "github.com/gdamore/tcell/v2"
+import (
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/gdamore/tcell/v2"
+)
 
 // Draw draws this primitive onto the screen.
 func (s *Secrets) Draw(screen tcell.Screen) {
+	s.refresh()
 	s.Box.DrawForSubclass(screen, s)
 	s.Box.SetBorder(false)
 
 	x, y, width, height := s.GetInnerRect()
 
+	if s.filterBar.IsVisible() {
+		height -= dialogs.FilterBarHeight
+		s.filterBar.SetRect(x, y+height, width, dialogs.FilterBarHeight)
+		s.filterBar.Draw(screen)
+	}
+
 	s.table.SetRect(x, y, width, height)
 	s.table.SetBorder(true)
 	s.table.Draw(screen)
@@ -37,6 +47,22 @@ func (s *Secrets) Draw(screen tcell.Screen) {
 		return
 	}
 
+	// reveal dialog
+	if s.revealDialog.IsDisplay() {
+		s.revealDialog.SetRect(x, y, width, height+1)
+		s.revealDialog.Draw(screen)
+
+		return
+	}
+
+	// cmd input dialog
+	if s.cmdInputDialog.IsDisplay() {
+		s.cmdInputDialog.SetRect(x, y, width, height)
+		s.cmdInputDialog.Draw(screen)
+
+		return
+	}
+
 	// confirm dialog
 	if s.confirmDialog.IsDisplay() {
 		s.confirmDialog.SetRect(x, y, width, height)
@@ -57,5 +83,13 @@ func (s *Secrets) Draw(screen tcell.Screen) {
 	if s.createDialog.IsDisplay() {
 		s.createDialog.SetRect(x, y, width, height)
 		s.createDialog.Draw(screen)
+
+		return
+	}
+
+	// rotate dialog
+	if s.rotateDialog.IsDisplay() {
+		s.rotateDialog.SetRect(x, y, width, height)
+		s.rotateDialog.Draw(screen)
 	}
 }
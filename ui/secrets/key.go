@@ -30,6 +30,13 @@ func (s *Secrets) InputHandler() func(event *tcell.EventKey, setFocus func(p tvi
 			}
 		}
 
+		// reveal dialog handler
+		if s.revealDialog.HasFocus() {
+			if revealDialogHandler := s.revealDialog.InputHandler(); revealDialogHandler != nil {
+				revealDialogHandler(event, setFocus)
+			}
+		}
+
 		// confirm dialog handler
 		if s.confirmDialog.HasFocus() {
 			if confirmDialogHandler := s.confirmDialog.InputHandler(); confirmDialogHandler != nil {
@@ -44,6 +51,13 @@ func (s *Secrets) InputHandler() func(event *tcell.EventKey, setFocus func(p tvi
 			}
 		}
 
+		// command input dialog handler
+		if s.cmdInputDialog.HasFocus() {
+			if cmdInputHandler := s.cmdInputDialog.InputHandler(); cmdInputHandler != nil {
+				cmdInputHandler(event, setFocus)
+			}
+		}
+
 		// create dialog
 		if s.createDialog.HasFocus() {
 			if createHandler := s.createDialog.InputHandler(); createHandler != nil {
@@ -51,6 +65,20 @@ func (s *Secrets) InputHandler() func(event *tcell.EventKey, setFocus func(p tvi
 			}
 		}
 
+		// rotate dialog
+		if s.rotateDialog.HasFocus() {
+			if rotateHandler := s.rotateDialog.InputHandler(); rotateHandler != nil {
+				rotateHandler(event, setFocus)
+			}
+		}
+
+		// filter bar handler
+		if s.filterBar.HasFocus() {
+			if filterBarHandler := s.filterBar.InputHandler(); filterBarHandler != nil {
+				filterBarHandler(event, setFocus)
+			}
+		}
+
 		// table handlers
 		if s.table.HasFocus() { //nolint:nestif
 			if event.Rune() == utils.CommandMenuKey.Rune() {
@@ -71,6 +99,44 @@ func (s *Secrets) InputHandler() func(event *tcell.EventKey, setFocus func(p tvi
 				return
 			}
 
+			if event.Rune() == utils.MarkItemKey.Rune() {
+				s.markItem()
+
+				return
+			}
+
+			if event.Key() == utils.MarkAllKey.EventKey() {
+				s.markedItems.ToggleAll(s.getItems())
+
+				return
+			}
+
+			if event.Rune() == utils.MarkPatternKey.Rune() {
+				s.markPattern()
+				setFocus(s)
+
+				return
+			}
+
+			if event.Rune() == utils.FilterKey.Rune() {
+				s.filterBar.Display()
+				setFocus(s)
+
+				return
+			}
+
+			if event.Rune() == utils.SortColumnKey.Rune() {
+				s.tableSort.Next()
+
+				return
+			}
+
+			if event.Rune() == utils.SortOrderKey.Rune() {
+				s.tableSort.Reverse()
+
+				return
+			}
+
 			if tableHandler := s.table.InputHandler(); tableHandler != nil {
 				tableHandler(event, setFocus)
 			}
@@ -1,27 +1,15 @@
 package secrets
 
 import (
-	"fmt"
-	"strings"
 	"time"
 
-	"github.com/containers/podman-tui/pdcs/secrets"
 	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
 	"github.com/docker/go-units"
 	"github.com/rivo/tview"
-	"github.com/rs/zerolog/log"
 )
 
-// UpdateData retrieves secrets list data.
-func (s *Secrets) UpdateData() {
-	secResponse, err := secrets.List()
-	if err != nil {
-		log.Error().Msgf("view: secrets update %v", err)
-
-		s.errorDialog.SetText(fmt.Sprintf("%v", err))
-		s.errorDialog.Display()
-	}
-
+func (s *Secrets) refresh() {
 	s.table.Clear()
 
 	expand := 1
@@ -29,17 +17,19 @@ func (s *Secrets) UpdateData() {
 
 	for i := 0; i < len(s.headers); i++ {
 		s.table.SetCell(0, i,
-			tview.NewTableCell(fmt.Sprintf("[::b]%s", strings.ToUpper(s.headers[i]))). //nolint:perfsprint
-													SetExpansion(expand).
-													SetBackgroundColor(style.PageHeaderBgColor).
-													SetTextColor(style.PageHeaderFgColor).
-													SetAlign(tview.AlignLeft).
-													SetSelectable(false))
+			tview.NewTableCell(s.tableSort.HeaderLabel(i, s.headers[i])).
+				SetExpansion(expand).
+				SetBackgroundColor(style.PageHeaderBgColor).
+				SetTextColor(style.PageHeaderFgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
 	}
 
 	rowIndex := 1
+	secResponse := s.getData()
+	filter := s.filterBar.GetFilter()
 
-	s.table.SetTitle(fmt.Sprintf("[::b]%s[%d]", strings.ToUpper(s.title), len(secResponse)))
+	markIDs := make([]string, 0, len(secResponse))
 
 	for i := 0; i < len(secResponse); i++ {
 		secID := secResponse[i].ID
@@ -48,6 +38,12 @@ func (s *Secrets) UpdateData() {
 		secCreated := units.HumanDuration(time.Since(secResponse[i].CreatedAt)) + " ago"
 		secUpdated := units.HumanDuration(time.Since(secResponse[i].UpdatedAt)) + " ago"
 
+		markIDs = append(markIDs, secID)
+
+		if !utils.MatchFilter(filter, secResponse[i].Spec.Labels, secID, secName, secDriver) {
+			continue
+		}
+
 		// ID column
 		s.table.SetCell(rowIndex, viewSecretsIDColIndex,
 			tview.NewTableCell(secID).
@@ -80,23 +76,8 @@ func (s *Secrets) UpdateData() {
 
 		rowIndex++
 	}
-}
-
-// ClearData clears table data.
-func (s *Secrets) ClearData() {
-	s.table.Clear()
-
-	expand := 1
-
-	for i := 0; i < len(s.headers); i++ {
-		s.table.SetCell(0, i,
-			tview.NewTableCell(fmt.Sprintf("[::b]%s", strings.ToUpper(s.headers[i]))). //nolint:perfsprint
-													SetExpansion(expand).
-													SetBackgroundColor(style.PageHeaderBgColor).
-													SetTextColor(style.PageHeaderFgColor).
-													SetAlign(tview.AlignLeft).
-													SetSelectable(false))
-	}
 
-	s.table.SetTitle(fmt.Sprintf("[::b]%s[0]", strings.ToUpper(s.title)))
+	s.markedItems.Retain(markIDs)
+	s.markedItems.HighlightMarkedRows(s.table, viewSecretsIDColIndex)
+	s.table.SetTitle(utils.TableTitle(s.title, len(secResponse), rowIndex-1, filter, s.markedItems.Count()))
 }
@@ -0,0 +1,374 @@
+package secdialogs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/containers/podman-tui/pdcs/secrets"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	secretRotateDialogMaxWidth  = 90
+	secretRotateDialogMaxHeight = 20
+)
+
+const (
+	// SecretRotateActionNone leaves the secret consumers unchanged, the running
+	// consumers keep the old secret value.
+	SecretRotateActionNone = "none"
+	// SecretRotateActionRestart restarts the running secret consumers (podman reads
+	// the secret value when the container starts).
+	SecretRotateActionRestart = "restart running"
+	// SecretRotateActionRecreate creates the secret consumers again with the same
+	// name and configuration (podman applies a replaced secret value to newly created containers).
+	SecretRotateActionRecreate = "recreate"
+)
+
+// secretRotateConsumersNote is the consumers list limitation note.
+const secretRotateConsumersNote = "[::d]the environment variable secrets of the containers created without " +
+	"the podman command line (e.g. compose) are not listed[::-]"
+
+const (
+	secretRotateFileFocus = 0 + iota
+	secretRotateTextFocus
+	secretRotateActionFocus
+	secretRotateFormFocus
+)
+
+// SecretRotateDialog implements secret rotate dialog.
+type SecretRotateDialog struct {
+	*tview.Box
+	layout        *tview.Flex
+	form          *tview.Form
+	secretInfo    *tview.InputField
+	secretFile    *tview.InputField
+	secretText    *tview.InputField
+	consumers     *tview.TextView
+	action        *tview.DropDown
+	display       bool
+	focusElement  int
+	rotateHandler func()
+	cancelHandler func()
+}
+
+// NewSecretRotateDialog returns new secret rotate dialog primitive.
+func NewSecretRotateDialog() *SecretRotateDialog {
+	rotateDialog := &SecretRotateDialog{
+		Box:          tview.NewBox(),
+		layout:       tview.NewFlex().SetDirection(tview.FlexColumn),
+		form:         tview.NewForm(),
+		secretInfo:   tview.NewInputField(),
+		secretFile:   tview.NewInputField(),
+		secretText:   tview.NewInputField(),
+		consumers:    tview.NewTextView(),
+		action:       tview.NewDropDown(),
+		display:      false,
+		focusElement: secretRotateFileFocus,
+	}
+
+	bgColor := style.DialogBgColor
+	fgColor := style.DialogFgColor
+	inputFieldBgColor := style.InputFieldBgColor
+
+	// secret info field
+	secretInfoLabel := "SECRET ID:"
+
+	rotateDialog.secretInfo.SetBackgroundColor(bgColor)
+	rotateDialog.secretInfo.SetLabel("[::b]" + secretInfoLabel)
+	rotateDialog.secretInfo.SetLabelWidth(len(secretInfoLabel) + 1)
+	rotateDialog.secretInfo.SetFieldBackgroundColor(bgColor)
+	rotateDialog.secretInfo.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(fgColor))
+
+	// secret file field
+	rotateDialog.secretFile.SetBackgroundColor(bgColor)
+	rotateDialog.secretFile.SetLabel("secret file:")
+	rotateDialog.secretFile.SetLabelWidth(labelWidth)
+	rotateDialog.secretFile.SetLabelColor(fgColor)
+	rotateDialog.secretFile.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// secret text field
+	rotateDialog.secretText.SetBackgroundColor(bgColor)
+	rotateDialog.secretText.SetLabel("secret text:")
+	rotateDialog.secretText.SetLabelWidth(labelWidth)
+	rotateDialog.secretText.SetLabelColor(fgColor)
+	rotateDialog.secretText.SetFieldBackgroundColor(inputFieldBgColor)
+	rotateDialog.secretText.SetMaskCharacter('*')
+
+	// consumers
+	rotateDialog.consumers.SetDynamicColors(true)
+	rotateDialog.consumers.SetBackgroundColor(style.BgColor)
+	rotateDialog.consumers.SetTextColor(style.FgColor)
+	rotateDialog.consumers.SetBorder(true)
+	rotateDialog.consumers.SetBorderColor(style.DialogSubBoxBorderColor)
+	rotateDialog.consumers.SetTitle("CONSUMERS")
+	rotateDialog.consumers.SetTitleColor(fgColor)
+
+	// consumers action
+	rotateDialog.action.SetBackgroundColor(bgColor)
+	rotateDialog.action.SetLabelColor(fgColor)
+	rotateDialog.action.SetLabel("consumers:")
+	rotateDialog.action.SetLabelWidth(labelWidth)
+	rotateDialog.action.SetOptions([]string{
+		SecretRotateActionNone,
+		SecretRotateActionRestart,
+		SecretRotateActionRecreate,
+	}, nil)
+	rotateDialog.action.SetListStyles(style.DropDownUnselected, style.DropDownSelected)
+	rotateDialog.action.SetCurrentOption(0)
+	rotateDialog.action.SetFieldBackgroundColor(inputFieldBgColor)
+
+	// form
+	rotateDialog.form.AddButton("Cancel", nil)
+	rotateDialog.form.AddButton("Rotate", nil)
+	rotateDialog.form.SetButtonsAlign(tview.AlignRight)
+	rotateDialog.form.SetBackgroundColor(bgColor)
+	rotateDialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	// layout
+	optionsLayout := tview.NewFlex().SetDirection(tview.FlexRow)
+	optionsLayout.SetBackgroundColor(bgColor)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(rotateDialog.secretInfo, 1, 0, false)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(rotateDialog.secretFile, 1, 0, true)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(rotateDialog.secretText, 1, 0, true)
+	optionsLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	optionsLayout.AddItem(rotateDialog.consumers, 0, 1, false)
+	optionsLayout.AddItem(rotateDialog.action, 1, 0, true)
+	optionsLayout.AddItem(rotateDialog.form, dialogs.DialogFormHeight, 0, true)
+
+	rotateDialog.layout.SetBackgroundColor(bgColor)
+	rotateDialog.layout.SetBorder(true)
+	rotateDialog.layout.SetBorderColor(style.DialogBorderColor)
+	rotateDialog.layout.SetTitle("PODMAN SECRET ROTATE")
+	rotateDialog.layout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	rotateDialog.layout.AddItem(optionsLayout, 0, 1, true)
+	rotateDialog.layout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+
+	return rotateDialog
+}
+
+// Display displays this primitive.
+func (d *SecretRotateDialog) Display() {
+	d.display = true
+	d.focusElement = secretRotateFileFocus
+
+	d.secretFile.SetText("")
+	d.secretText.SetText("")
+	d.action.SetCurrentOption(0)
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *SecretRotateDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *SecretRotateDialog) Hide() {
+	d.display = false
+
+	d.secretText.SetText("")
+}
+
+// SetRect set rects for this primitive.
+func (d *SecretRotateDialog) SetRect(x, y, width, height int) {
+	if width > secretRotateDialogMaxWidth {
+		emptySpace := (width - secretRotateDialogMaxWidth) / 2 //nolint:gomnd
+		x += emptySpace
+		width = secretRotateDialogMaxWidth
+	}
+
+	if height > secretRotateDialogMaxHeight {
+		emptySpace := (height - secretRotateDialogMaxHeight) / 2 //nolint:gomnd
+		y += emptySpace
+		height = secretRotateDialogMaxHeight
+	}
+
+	d.Box.SetRect(x, y, width, height)
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (d *SecretRotateDialog) HasFocus() bool {
+	if d.layout.HasFocus() || d.form.HasFocus() {
+		return true
+	}
+
+	if d.secretFile.HasFocus() || d.secretText.HasFocus() {
+		return true
+	}
+
+	return d.action.HasFocus() || d.Box.HasFocus()
+}
+
+// Focus is called when this primitive receives focus.
+func (d *SecretRotateDialog) Focus(delegate func(p tview.Primitive)) {
+	switch d.focusElement {
+	case secretRotateFileFocus:
+		delegate(d.secretFile)
+	case secretRotateTextFocus:
+		delegate(d.secretText)
+	case secretRotateActionFocus:
+		delegate(d.action)
+	case secretRotateFormFocus:
+		button := d.form.GetButton(d.form.GetButtonCount() - 1)
+		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == utils.SwitchFocusKey.Key {
+				d.focusElement = secretRotateFileFocus
+
+				d.Focus(delegate)
+				d.form.SetFocus(0)
+
+				return nil
+			}
+
+			return event
+		})
+
+		delegate(d.form)
+	}
+}
+
+// Draw draws this primitive into the screen.
+func (d *SecretRotateDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.Box.DrawForSubclass(screen, d)
+
+	x, y, width, height := d.Box.GetInnerRect()
+
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *SecretRotateDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) { //nolint:cyclop,lll
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("secret rotate dialog: event %v received", event)
+
+		if event.Key() == utils.CloseDialogKey.Key {
+			if !d.action.HasFocus() {
+				d.cancelHandler()
+
+				return
+			}
+		}
+
+		if event.Key() == utils.SwitchFocusKey.Key {
+			d.setFocusElement()
+		}
+
+		if d.secretFile.HasFocus() {
+			if fileHandler := d.secretFile.InputHandler(); fileHandler != nil {
+				fileHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.secretText.HasFocus() {
+			if textHandler := d.secretText.InputHandler(); textHandler != nil {
+				textHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.action.HasFocus() {
+			event = utils.ParseKeyEventKey(event)
+			if actionHandler := d.action.InputHandler(); actionHandler != nil {
+				actionHandler(event, setFocus)
+
+				return
+			}
+		}
+
+		if d.form.HasFocus() {
+			if formHandler := d.form.InputHandler(); formHandler != nil {
+				formHandler(event, setFocus)
+
+				return
+			}
+		}
+	})
+}
+
+// SetRotateFunc sets form rotate button selected function.
+func (d *SecretRotateDialog) SetRotateFunc(handler func()) *SecretRotateDialog {
+	d.rotateHandler = handler
+	rotateButton := d.form.GetButton(d.form.GetButtonCount() - 1)
+
+	rotateButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *SecretRotateDialog) SetCancelFunc(handler func()) *SecretRotateDialog {
+	d.cancelHandler = handler
+	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 2) //nolint:gomnd
+
+	cancelButton.SetSelectedFunc(handler)
+
+	return d
+}
+
+func (d *SecretRotateDialog) setFocusElement() {
+	switch d.focusElement {
+	case secretRotateFileFocus:
+		d.focusElement = secretRotateTextFocus
+	case secretRotateTextFocus:
+		d.focusElement = secretRotateActionFocus
+	case secretRotateActionFocus:
+		d.focusElement = secretRotateFormFocus
+	}
+}
+
+// SetSecretInfo sets the rotated secret ID and name.
+func (d *SecretRotateDialog) SetSecretInfo(id string, name string) {
+	d.secretInfo.SetText(fmt.Sprintf("%s (%s)", id, name))
+}
+
+// SetConsumers sets the list of the containers which use the secret.
+func (d *SecretRotateDialog) SetConsumers(consumers []secrets.SecretConsumer) {
+	d.consumers.Clear()
+
+	if len(consumers) == 0 {
+		d.consumers.SetText("no container uses the secret\n\n" + secretRotateConsumersNote)
+
+		return
+	}
+
+	lines := make([]string, 0, len(consumers))
+	for _, consumer := range consumers {
+		lines = append(lines, fmt.Sprintf("%s  %-30s %s",
+			utils.GetIDWithLimit(consumer.ID), tview.Escape(consumer.Name), consumer.State))
+	}
+
+	lines = append(lines, "", secretRotateConsumersNote)
+
+	d.consumers.SetText(strings.Join(lines, "\n"))
+	d.consumers.ScrollToBeginning()
+}
+
+// GetRotateOptions returns the secret rotate options and the selected consumers action.
+func (d *SecretRotateDialog) GetRotateOptions() (secrets.SecretRotateOptions, string) {
+	opts := secrets.SecretRotateOptions{
+		File: strings.TrimSpace(d.secretFile.GetText()),
+		Text: d.secretText.GetText(),
+	}
+
+	_, action := d.action.GetCurrentOption()
+
+	return opts, action
+}
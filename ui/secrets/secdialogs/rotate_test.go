@@ -0,0 +1,155 @@
+package secdialogs
+
+import (
+	"fmt"
+
+	"github.com/containers/podman-tui/pdcs/secrets"
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("secret rotate", Ordered, func() {
+	var secretRotateApp *tview.Application
+	var secretRotateScreen tcell.SimulationScreen
+	var rotateDialog *SecretRotateDialog
+	var runApp func()
+
+	BeforeAll(func() {
+		secretRotateApp = tview.NewApplication()
+		rotateDialog = NewSecretRotateDialog()
+		secretRotateScreen = tcell.NewSimulationScreen("UTF-8")
+		err := secretRotateScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+		runApp = func() {
+			if err := secretRotateApp.SetScreen(secretRotateScreen).SetRoot(rotateDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		rotateDialog.Display()
+		Expect(rotateDialog.IsDisplay()).To(Equal(true))
+		Expect(rotateDialog.focusElement).To(Equal(secretRotateFileFocus))
+	})
+
+	It("set focus", func() {
+		secretRotateApp.SetFocus(rotateDialog)
+		Expect(rotateDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("set secret info", func() {
+		secID := "secID"
+		secName := "secName"
+		secInfoWants := fmt.Sprintf("%s (%s)", secID, secName)
+		rotateDialog.SetSecretInfo(secID, secName)
+		Expect(rotateDialog.secretInfo.GetText()).To(Equal(secInfoWants))
+	})
+
+	It("set consumers", func() {
+		rotateDialog.SetConsumers(nil)
+		Expect(rotateDialog.consumers.GetText(true)).To(Equal("no container uses the secret" +
+			"\n\nthe environment variable secrets of the containers created without the podman command line (e.g. compose) are not listed"))
+
+		rotateDialog.SetConsumers([]secrets.SecretConsumer{
+			{ID: "0123456789abcdef", Name: "db", State: "running", Running: true},
+			{ID: "fedcba9876543210", Name: "web", State: "exited"},
+		})
+		Expect(rotateDialog.consumers.GetText(true)).To(Equal(
+			fmt.Sprintf("0123456789ab  %-30s running\nfedcba987654  %-30s exited", "db", "web") +
+				"\n\nthe environment variable secrets of the containers created without the podman command line (e.g. compose) are not listed"))
+	})
+
+	It("get rotate options", func() {
+		rotateDialog.Hide()
+		rotateDialog.Display()
+		secretRotateApp.SetFocus(rotateDialog)
+		secretRotateApp.Draw()
+
+		for _, r := range " /tmp/secret " {
+			secretRotateApp.QueueEvent(tcell.NewEventKey(256, r, tcell.ModNone))
+			secretRotateApp.Draw()
+		}
+
+		opts, action := rotateDialog.GetRotateOptions()
+		Expect(opts).To(Equal(secrets.SecretRotateOptions{File: "/tmp/secret"}))
+		Expect(action).To(Equal(SecretRotateActionNone))
+
+		// secret text
+		rotateDialog.setFocusElement()
+		secretRotateApp.SetFocus(rotateDialog)
+		secretRotateApp.Draw()
+
+		for _, r := range "s3cret " {
+			secretRotateApp.QueueEvent(tcell.NewEventKey(256, r, tcell.ModNone))
+			secretRotateApp.Draw()
+		}
+
+		// consumers action
+		rotateDialog.setFocusElement()
+		rotateDialog.action.SetCurrentOption(1)
+
+		opts, action = rotateDialog.GetRotateOptions()
+		Expect(opts).To(Equal(secrets.SecretRotateOptions{File: "/tmp/secret", Text: "s3cret "}))
+		Expect(action).To(Equal(SecretRotateActionRestart))
+
+		rotateDialog.action.SetCurrentOption(2)
+		_, action = rotateDialog.GetRotateOptions()
+		Expect(action).To(Equal(SecretRotateActionRecreate))
+	})
+
+	It("cancel button selected", func() {
+		cancelFunc := func() {
+			rotateDialog.Hide()
+		}
+		rotateDialog.Hide()
+		secretRotateApp.Draw()
+		rotateDialog.SetCancelFunc(cancelFunc)
+		rotateDialog.Display()
+		secretRotateApp.Draw()
+		secretRotateApp.SetFocus(rotateDialog.form)
+		secretRotateApp.Draw()
+		secretRotateApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		secretRotateApp.Draw()
+		Expect(rotateDialog.IsDisplay()).To(Equal(false))
+	})
+
+	It("rotate button selected", func() {
+		rotateButton := "initial"
+		rotateButtonWants := "rotate selected"
+		rotateFunc := func() {
+			rotateButton = rotateButtonWants
+		}
+		rotateDialog.Hide()
+		secretRotateApp.Draw()
+		rotateDialog.SetRotateFunc(rotateFunc)
+		rotateDialog.Display()
+		secretRotateApp.Draw()
+		secretRotateApp.SetFocus(rotateDialog.form)
+		secretRotateApp.Draw()
+		secretRotateApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		secretRotateApp.Draw()
+		secretRotateApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		secretRotateApp.Draw()
+		Expect(rotateButton).To(Equal(rotateButtonWants))
+	})
+
+	It("hide", func() {
+		rotateDialog.Hide()
+		Expect(rotateDialog.IsDisplay()).To(Equal(false))
+
+		opts, _ := rotateDialog.GetRotateOptions()
+		Expect(opts.Text).To(Equal(""))
+	})
+
+	AfterAll(func() {
+		secretRotateApp.Stop()
+	})
+})
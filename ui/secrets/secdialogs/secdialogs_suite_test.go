@@ -0,0 +1,13 @@
+package secdialogs_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSecdialogs(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Secret Dialogs Suite")
+}
@@ -4,10 +4,15 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/containers/podman-tui/pdcs/secrets"
 	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/containers/podman-tui/ui/secrets/secdialogs"
 	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/containers/podman/v5/pkg/domain/entities/types"
 	"github.com/rivo/tview"
 )
 
@@ -22,6 +27,8 @@ const (
 var (
 	errNoSecretRemove        = errors.New("there is no secret to remove")
 	errNoSecretInspect       = errors.New("there is no secret to display inspect")
+	errNoSecretReveal        = errors.New("there is no secret to reveal")
+	errNoSecretRotate        = errors.New("there is no secret to rotate")
 	errSecretFileAndText     = errors.New("cannot select secret file and secret text together")
 	errEmptySecretFileOrText = errors.New("secret content not provided")
 )
@@ -33,11 +40,32 @@ type Secrets struct {
 	headers        []string
 	table          *tview.Table
 	cmdDialog      *dialogs.CommandDialog
+	cmdInputDialog *dialogs.SimpleInputDialog
 	messageDialog  *dialogs.MessageDialog
+	revealDialog   *dialogs.MessageDialog
 	errorDialog    *dialogs.ErrorDialog
 	progressDialog *dialogs.ProgressDialog
 	confirmDialog  *dialogs.ConfirmDialog
 	createDialog   *secdialogs.SecretCreateDialog
+	rotateDialog   *secdialogs.SecretRotateDialog
+	filterBar      *dialogs.FilterBar
+	secretsList    secretListReport
+	markedItems    *utils.MarkedItems
+	tableSort      *utils.TableSort
+	confirmData    string
+	bulkCmd        string
+	consumers      []secrets.SecretConsumer
+	revealTimeout  time.Duration
+	revealTimer    *time.Timer
+	auditLog       string
+	clipboardFunc  func(text string) error
+	queueUpdate    func(f func())
+	appFocus       func(p tview.Primitive)
+}
+
+type secretListReport struct {
+	mu     sync.Mutex
+	report []*types.SecretInfoReport
 }
 
 // NewSecrets returns secrets page view.
@@ -47,17 +75,28 @@ func NewSecrets() *Secrets {
 		title:          "secrets",
 		headers:        []string{"id", "name", "driver", "created", "updated"},
 		table:          tview.NewTable(),
+		cmdInputDialog: dialogs.NewSimpleInputDialog(""),
 		messageDialog:  dialogs.NewMessageDialog(""),
+		revealDialog:   dialogs.NewMessageDialog(""),
 		errorDialog:    dialogs.NewErrorDialog(),
 		progressDialog: dialogs.NewProgressDialog(),
 		confirmDialog:  dialogs.NewConfirmDialog(),
 		createDialog:   secdialogs.NewSecretCreateDialog(),
+		rotateDialog:   secdialogs.NewSecretRotateDialog(),
+		filterBar:      dialogs.NewFilterBar(),
+		markedItems:    utils.NewMarkedItems(),
+		revealTimeout:  utils.SecretRevealTimeout,
 	}
 
+	secrets.tableSort = utils.NewTableSort(len(secrets.headers), viewSecretsNameColIndex)
+
 	secrets.cmdDialog = dialogs.NewCommandDialog([][]string{
+		{"copy", "copy the secret value to the clipboard without displaying it"},
 		{"create", "create a new secret"},
 		{"inspect", "inspect a secret"},
+		{"reveal", "display the secret value for a limited time"},
 		{"rm", "remove a secret"},
+		{"rotate", "replace the secret value and restart or recreate its consumers"},
 	})
 
 	secrets.table.SetTitle(fmt.Sprintf("[::b]%s[0]", strings.ToUpper(secrets.title)))
@@ -79,16 +118,32 @@ func NewSecrets() *Secrets {
 		secrets.cmdDialog.Hide()
 	})
 
+	// set input cmd dialog functions
+	secrets.cmdInputDialog.SetCancelFunc(secrets.cmdInputDialog.Hide)
+	secrets.cmdInputDialog.SetSelectedFunc(secrets.cmdInputDialog.Hide)
+
 	// set message dialog function
 	secrets.messageDialog.SetCancelFunc(func() {
 		secrets.messageDialog.Hide()
 	})
 
+	// set reveal dialog function
+	secrets.revealDialog.SetCancelFunc(secrets.hideReveal)
+
 	// set confirm dialog functions
 	secrets.confirmDialog.SetSelectedFunc(func() {
 		secrets.confirmDialog.Hide()
 
-		secrets.remove()
+		switch secrets.confirmData {
+		case "copy":
+			secrets.copySecret()
+		case "reveal":
+			secrets.reveal()
+		case "rm":
+			secrets.remove()
+		case "bulk":
+			secrets.bulkCommand()
+		}
 	})
 
 	secrets.confirmDialog.SetCancelFunc(func() {
@@ -105,6 +160,10 @@ func NewSecrets() *Secrets {
 		secrets.create()
 	})
 
+	// set rotate dialog functions
+	secrets.rotateDialog.SetCancelFunc(secrets.rotateDialog.Hide)
+	secrets.rotateDialog.SetRotateFunc(secrets.rotate)
+
 	return secrets
 }
 
@@ -127,10 +186,18 @@ func (s *Secrets) HasFocus() bool {
 		return true
 	}
 
+	if s.revealDialog.HasFocus() || s.rotateDialog.HasFocus() {
+		return true
+	}
+
 	if s.confirmDialog.HasFocus() || s.createDialog.HasFocus() {
 		return true
 	}
 
+	if s.cmdInputDialog.HasFocus() || s.filterBar.HasFocus() {
+		return true
+	}
+
 	return false
 }
 
@@ -144,10 +211,18 @@ func (s *Secrets) SubDialogHasFocus() bool {
 		return true
 	}
 
+	if s.revealDialog.HasFocus() || s.rotateDialog.HasFocus() {
+		return true
+	}
+
 	if s.confirmDialog.HasFocus() || s.createDialog.HasFocus() {
 		return true
 	}
 
+	if s.cmdInputDialog.HasFocus() || s.filterBar.HasFocus() {
+		return true
+	}
+
 	return false
 }
 
@@ -167,6 +242,13 @@ func (s *Secrets) Focus(delegate func(p tview.Primitive)) {
 		return
 	}
 
+	// reveal dialog
+	if s.revealDialog.IsDisplay() {
+		delegate(s.revealDialog)
+
+		return
+	}
+
 	// confirmation dialog
 	if s.confirmDialog.IsDisplay() {
 		delegate(s.confirmDialog)
@@ -181,6 +263,13 @@ func (s *Secrets) Focus(delegate func(p tview.Primitive)) {
 		return
 	}
 
+	// cmd input dialog
+	if s.cmdInputDialog.IsDisplay() {
+		delegate(s.cmdInputDialog)
+
+		return
+	}
+
 	// create dialog
 	if s.createDialog.IsDisplay() {
 		delegate(s.createDialog)
@@ -188,6 +277,20 @@ func (s *Secrets) Focus(delegate func(p tview.Primitive)) {
 		return
 	}
 
+	// rotate dialog
+	if s.rotateDialog.IsDisplay() {
+		delegate(s.rotateDialog)
+
+		return
+	}
+
+	// filter bar
+	if s.filterBar.IsDisplay() {
+		delegate(s.filterBar)
+
+		return
+	}
+
 	delegate(s.table)
 }
 
@@ -201,6 +304,10 @@ func (s *Secrets) HideAllDialogs() {
 		s.messageDialog.Hide()
 	}
 
+	if s.revealDialog.IsDisplay() {
+		s.hideReveal()
+	}
+
 	if s.progressDialog.IsDisplay() {
 		s.progressDialog.Hide()
 	}
@@ -213,9 +320,52 @@ func (s *Secrets) HideAllDialogs() {
 		s.cmdDialog.Hide()
 	}
 
+	if s.cmdInputDialog.IsDisplay() {
+		s.cmdInputDialog.Hide()
+	}
+
 	if s.createDialog.IsDisplay() {
 		s.createDialog.Hide()
 	}
+
+	if s.rotateDialog.IsDisplay() {
+		s.rotateDialog.Hide()
+	}
+
+	if s.filterBar.IsDisplay() {
+		s.filterBar.Hide()
+	}
+}
+
+// SetRevealOptions sets how long a revealed secret value is displayed and
+// the reveal audit log file path (the audit is disabled if empty).
+func (s *Secrets) SetRevealOptions(timeout time.Duration, auditLog string) {
+	s.revealTimeout = timeout
+	s.auditLog = auditLog
+}
+
+// SetClipboardFunc sets the function which copies the secret value to the terminal clipboard.
+func (s *Secrets) SetClipboardFunc(handler func(text string) error) {
+	s.clipboardFunc = handler
+}
+
+// SetAppFuncs sets the functions which queue an update to the application UI goroutine
+// (the screen is drawn after the update) and set the application focus.
+func (s *Secrets) SetAppFuncs(queueUpdate func(f func()), appFocus func(p tview.Primitive)) {
+	s.queueUpdate = queueUpdate
+	s.appFocus = appFocus
+}
+
+// queueUpdateDraw runs the function on the application UI goroutine, it shall not be
+// called from the UI goroutine.
+func (s *Secrets) queueUpdateDraw(f func()) {
+	if s.queueUpdate == nil {
+		f()
+
+		return
+	}
+
+	s.queueUpdate(f)
 }
 
 func (s *Secrets) getSelectedItem() (int, string, string) {
@@ -235,3 +385,16 @@ func (s *Secrets) getSelectedItem() (int, string, string) {
 
 	return rowIndex, secID, secName
 }
+
+func (s *Secrets) getItems() []utils.MarkedItem {
+	items := []utils.MarkedItem{}
+
+	for row := 1; row < s.table.GetRowCount(); row++ {
+		items = append(items, utils.MarkedItem{
+			ID:   s.table.GetCell(row, viewSecretsIDColIndex).Text,
+			Name: s.table.GetCell(row, viewSecretsNameColIndex).Text,
+		})
+	}
+
+	return items
+}
@@ -32,6 +32,7 @@ var (
 	PageHeaderFgColor    = tcell.ColorFloralWhite
 	RunningStatusFgColor = tcell.NewRGBColor(95, 215, 0)  //nolint:gomnd
 	PausedStatusFgColor  = tcell.NewRGBColor(255, 175, 0) //nolint:gomnd
+	StoppedStatusFgColor = tcell.NewRGBColor(215, 0, 0)   //nolint:gomnd
 	// dialogs.
 	DialogBgColor            = tcell.NewRGBColor(38, 38, 38) //nolint:gomnd
 	DialogBorderColor        = tcell.ColorMediumPurple
@@ -43,9 +44,14 @@ var (
 	TerminalFgColor     = tcell.ColorFloralWhite
 	TerminalBgColor     = tcell.NewRGBColor(5, 5, 5) //nolint:gomnd
 	TerminalBorderColor = tcell.ColorDimGray
+	// logs.
+	LogsStderrFgColor = tcell.NewRGBColor(255, 95, 95) //nolint:gomnd
+	LogsMatchBgColor  = tcell.ColorGold
+	LogsMatchFgColor  = tcell.ColorBlack
 	// table header.
-	TableHeaderBgColor = tcell.ColorMediumPurple
-	TableHeaderFgColor = tcell.ColorFloralWhite
+	TableHeaderBgColor    = tcell.ColorMediumPurple
+	TableHeaderFgColor    = tcell.ColorFloralWhite
+	TableMarkedRowBgColor = tcell.NewRGBColor(68, 68, 110) //nolint:gomnd
 	// progress bar.
 	PrgBgColor       = tcell.ColorDimGray
 	PrgBarColor      = tcell.ColorDarkOrange
@@ -74,5 +80,5 @@ func GetColorName(color tcell.Color) string {
 
 // GetColorHex returns convert tcell color to its hex useful for textview primitives.
 func GetColorHex(color tcell.Color) string {
-	return fmt.Sprintf("#%x", color.Hex())
+	return fmt.Sprintf("#%06x", color.Hex())
 }
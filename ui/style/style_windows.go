@@ -29,6 +29,7 @@ var (
 	PageHeaderFgColor    = tview.Styles.PrimaryTextColor
 	RunningStatusFgColor = tcell.ColorLime
 	PausedStatusFgColor  = tcell.ColorYellow
+	StoppedStatusFgColor = tcell.ColorRed
 
 	// dialogs.
 	DialogBgColor            = tview.Styles.PrimitiveBackgroundColor
@@ -41,9 +42,14 @@ var (
 	TerminalBgColor     = tview.Styles.PrimitiveBackgroundColor
 	TerminalFgColor     = tview.Styles.PrimaryTextColor
 	TerminalBorderColor = tview.Styles.PrimitiveBackgroundColor
+	// logs.
+	LogsStderrFgColor = tcell.ColorRed
+	LogsMatchBgColor  = tcell.ColorYellow
+	LogsMatchFgColor  = tcell.ColorBlack
 	// table header.
-	TableHeaderBgColor = tcell.ColorPink
-	TableHeaderFgColor = tview.Styles.PrimaryTextColor
+	TableHeaderBgColor    = tcell.ColorPink
+	TableHeaderFgColor    = tview.Styles.PrimaryTextColor
+	TableMarkedRowBgColor = tcell.ColorNavy
 	// progress bar.
 	PrgBgColor       = tview.Styles.PrimaryTextColor
 	PrgBarColor      = tcell.ColorFuchsia
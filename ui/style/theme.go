@@ -0,0 +1,341 @@
+package style
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const (
+	// DefaultTheme default theme name.
+	DefaultTheme = "dark"
+	// MonochromeTheme monochrome theme name (used if NO_COLOR is set).
+	MonochromeTheme = "monochrome"
+	// themeBaseKey is the theme key which specifies the theme to be extended.
+	themeBaseKey = "base"
+)
+
+var (
+	ErrUnknownTheme      = errors.New("unknown theme")
+	ErrUnknownThemeColor = errors.New("unknown theme color")
+	ErrInvalidThemeColor = errors.New("invalid theme color")
+	ErrThemeBaseLoop     = errors.New("theme base loop")
+)
+
+// Theme is a set of user interface colors (color name = color name or #hex).
+// The colors which are not set in the theme are taken from its base theme
+// (i.e. base = "light") or from the default theme.
+type Theme map[string]string
+
+// builtinThemes podman-tui built-in themes.
+var builtinThemes = map[string]Theme{
+	DefaultTheme: {},
+	"light": {
+		"infobar_item_fg":        "dimgray",
+		"fg":                     "black",
+		"bg":                     "white",
+		"border":                 "slateblue",
+		"help_header_fg":         "slateblue",
+		"menu_bg":                "slateblue",
+		"page_header_bg":         "slateblue",
+		"page_header_fg":         "white",
+		"running_status_fg":      "green",
+		"paused_status_fg":       "darkorange",
+		"stopped_status_fg":      "firebrick",
+		"dialog_bg":              "whitesmoke",
+		"dialog_border":          "slateblue",
+		"dialog_fg":              "black",
+		"dialog_sub_box_border":  "darkgray",
+		"error_dialog_bg":        "lightcoral",
+		"error_dialog_button_bg": "firebrick",
+		"terminal_fg":            "black",
+		"terminal_bg":            "white",
+		"terminal_border":        "darkgray",
+		"logs_stderr_fg":         "firebrick",
+		"logs_match_bg":          "gold",
+		"logs_match_fg":          "black",
+		"table_header_bg":        "slateblue",
+		"table_header_fg":        "white",
+		"table_marked_row_bg":    "lightsteelblue",
+		"prg_bg":                 "lightgray",
+		"prg_bar":                "darkorange",
+		"prg_bar_empty":          "darkgray",
+		"prg_bar_ok":             "green",
+		"prg_bar_warn":           "darkorange",
+		"prg_bar_crit":           "red",
+		"dropdown_unselected_bg": "lightgray",
+		"dropdown_unselected_fg": "black",
+		"dropdown_selected_bg":   "slateblue",
+		"dropdown_selected_fg":   "white",
+		"input_field_bg":         "lightgray",
+		"button_bg":              "lightsteelblue",
+	},
+	"high-contrast": {
+		"infobar_item_fg":        "white",
+		"fg":                     "white",
+		"bg":                     "black",
+		"border":                 "yellow",
+		"help_header_fg":         "yellow",
+		"menu_bg":                "blue",
+		"page_header_bg":         "blue",
+		"page_header_fg":         "white",
+		"running_status_fg":      "lime",
+		"paused_status_fg":       "yellow",
+		"stopped_status_fg":      "red",
+		"dialog_bg":              "black",
+		"dialog_border":          "yellow",
+		"dialog_fg":              "white",
+		"dialog_sub_box_border":  "white",
+		"error_dialog_bg":        "red",
+		"error_dialog_button_bg": "maroon",
+		"terminal_fg":            "white",
+		"terminal_bg":            "black",
+		"terminal_border":        "white",
+		"logs_stderr_fg":         "red",
+		"logs_match_bg":          "yellow",
+		"logs_match_fg":          "black",
+		"table_header_bg":        "blue",
+		"table_header_fg":        "white",
+		"table_marked_row_bg":    "navy",
+		"prg_bg":                 "gray",
+		"prg_bar":                "yellow",
+		"prg_bar_empty":          "white",
+		"prg_bar_ok":             "lime",
+		"prg_bar_warn":           "yellow",
+		"prg_bar_crit":           "red",
+		"dropdown_unselected_bg": "white",
+		"dropdown_unselected_fg": "black",
+		"dropdown_selected_bg":   "blue",
+		"dropdown_selected_fg":   "white",
+		"input_field_bg":         "blue",
+		"button_bg":              "blue",
+	},
+	MonochromeTheme: {
+		"infobar_item_fg":        "silver",
+		"fg":                     "white",
+		"bg":                     "black",
+		"border":                 "white",
+		"help_header_fg":         "white",
+		"menu_bg":                "gray",
+		"page_header_bg":         "gray",
+		"page_header_fg":         "white",
+		"running_status_fg":      "white",
+		"paused_status_fg":       "silver",
+		"stopped_status_fg":      "gray",
+		"dialog_bg":              "black",
+		"dialog_border":          "white",
+		"dialog_fg":              "white",
+		"dialog_sub_box_border":  "gray",
+		"error_dialog_bg":        "dimgray",
+		"error_dialog_button_bg": "gray",
+		"terminal_fg":            "white",
+		"terminal_bg":            "black",
+		"terminal_border":        "gray",
+		"logs_stderr_fg":         "silver",
+		"logs_match_bg":          "gray",
+		"logs_match_fg":          "black",
+		"table_header_bg":        "gray",
+		"table_header_fg":        "white",
+		"table_marked_row_bg":    "dimgray",
+		"prg_bg":                 "dimgray",
+		"prg_bar":                "white",
+		"prg_bar_empty":          "gray",
+		"prg_bar_ok":             "white",
+		"prg_bar_warn":           "silver",
+		"prg_bar_crit":           "white",
+		"dropdown_unselected_bg": "gray",
+		"dropdown_unselected_fg": "white",
+		"dropdown_selected_bg":   "white",
+		"dropdown_selected_fg":   "black",
+		"input_field_bg":         "dimgray",
+		"button_bg":              "gray",
+	},
+}
+
+// themeColors returns the theme color names and their style colors.
+func themeColors() map[string]*tcell.Color {
+	return map[string]*tcell.Color{
+		"infobar_item_fg":        &InfoBarItemFgColor,
+		"fg":                     &FgColor,
+		"bg":                     &BgColor,
+		"border":                 &BorderColor,
+		"help_header_fg":         &HelpHeaderFgColor,
+		"menu_bg":                &MenuBgColor,
+		"page_header_bg":         &PageHeaderBgColor,
+		"page_header_fg":         &PageHeaderFgColor,
+		"running_status_fg":      &RunningStatusFgColor,
+		"paused_status_fg":       &PausedStatusFgColor,
+		"stopped_status_fg":      &StoppedStatusFgColor,
+		"dialog_bg":              &DialogBgColor,
+		"dialog_border":          &DialogBorderColor,
+		"dialog_fg":              &DialogFgColor,
+		"dialog_sub_box_border":  &DialogSubBoxBorderColor,
+		"error_dialog_bg":        &ErrorDialogBgColor,
+		"error_dialog_button_bg": &ErrorDialogButtonBgColor,
+		"terminal_fg":            &TerminalFgColor,
+		"terminal_bg":            &TerminalBgColor,
+		"terminal_border":        &TerminalBorderColor,
+		"logs_stderr_fg":         &LogsStderrFgColor,
+		"logs_match_bg":          &LogsMatchBgColor,
+		"logs_match_fg":          &LogsMatchFgColor,
+		"table_header_bg":        &TableHeaderBgColor,
+		"table_header_fg":        &TableHeaderFgColor,
+		"table_marked_row_bg":    &TableMarkedRowBgColor,
+		"prg_bg":                 &PrgBgColor,
+		"prg_bar":                &PrgBarColor,
+		"prg_bar_empty":          &PrgBarEmptyColor,
+		"prg_bar_ok":             &PrgBarOKColor,
+		"prg_bar_warn":           &PrgBarWarnColor,
+		"prg_bar_crit":           &PrgBarCritColor,
+		"input_field_bg":         &InputFieldBgColor,
+		"button_bg":              &ButtonBgColor,
+	}
+}
+
+// ThemeNames returns list of built-in and custom theme names.
+func ThemeNames(customThemes map[string]Theme) []string {
+	names := []string{}
+
+	for name := range builtinThemes {
+		names = append(names, name)
+	}
+
+	for name := range customThemes {
+		if _, ok := builtinThemes[name]; !ok {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// SetTheme applies the theme colors to the user interface, it shall be called
+// before the user interface primitives are created.
+// The custom themes take precedence over the built-in themes.
+func SetTheme(name string, customThemes map[string]Theme) error {
+	colors, err := resolveTheme(name, customThemes, map[string]bool{})
+	if err != nil {
+		return err
+	}
+
+	themeColorValues := make(map[string]tcell.Color, len(colors))
+
+	for colorName, colorValue := range colors {
+		color := tcell.GetColor(colorValue)
+		if color == tcell.ColorDefault && !strings.EqualFold(colorValue, "default") {
+			return fmt.Errorf("%w %q for %q in theme %q", ErrInvalidThemeColor, colorValue, colorName, name)
+		}
+
+		themeColorValues[colorName] = color
+	}
+
+	styleColors := themeColors()
+
+	for colorName, color := range themeColorValues {
+		if styleColor, ok := styleColors[colorName]; ok {
+			*styleColor = color
+		}
+	}
+
+	setDropDownColors(themeColorValues)
+
+	if _, ok := colors["bg"]; ok {
+		tview.Styles.PrimitiveBackgroundColor = BgColor
+	}
+
+	if _, ok := colors["fg"]; ok {
+		tview.Styles.PrimaryTextColor = FgColor
+		tview.Styles.BorderColor = FgColor
+		tview.Styles.TitleColor = FgColor
+	}
+
+	return nil
+}
+
+// resolveTheme returns the theme colors merged with its base theme colors.
+func resolveTheme(name string, customThemes map[string]Theme, visited map[string]bool) (Theme, error) {
+	if visited[name] {
+		return nil, fmt.Errorf("%w %q", ErrThemeBaseLoop, name)
+	}
+
+	visited[name] = true
+
+	theme, ok := customThemes[name]
+	if !ok {
+		theme, ok = builtinThemes[name]
+		if !ok {
+			return nil, fmt.Errorf("%w %q (available themes: %s)",
+				ErrUnknownTheme, name, strings.Join(ThemeNames(customThemes), ", "))
+		}
+
+		customThemes = nil
+	}
+
+	colors := Theme{}
+
+	if baseName, ok := theme[themeBaseKey]; ok {
+		baseThemes := customThemes
+		if baseName == name {
+			// the custom theme extends the built-in theme with the same name.
+			baseThemes = nil
+
+			delete(visited, name)
+		}
+
+		baseColors, err := resolveTheme(baseName, baseThemes, visited)
+		if err != nil {
+			return nil, err
+		}
+
+		colors = baseColors
+	}
+
+	styleColors := themeColors()
+
+	for colorName, colorValue := range theme {
+		if colorName == themeBaseKey {
+			continue
+		}
+
+		if _, ok := styleColors[colorName]; !ok && !isDropDownColor(colorName) {
+			return nil, fmt.Errorf("%w %q in theme %q", ErrUnknownThemeColor, colorName, name)
+		}
+
+		colors[colorName] = colorValue
+	}
+
+	return colors, nil
+}
+
+func isDropDownColor(name string) bool {
+	switch name {
+	case "dropdown_unselected_bg", "dropdown_unselected_fg", "dropdown_selected_bg", "dropdown_selected_fg":
+		return true
+	}
+
+	return false
+}
+
+func setDropDownColors(colors map[string]tcell.Color) {
+	if color, ok := colors["dropdown_unselected_bg"]; ok {
+		DropDownUnselected = DropDownUnselected.Background(color)
+	}
+
+	if color, ok := colors["dropdown_unselected_fg"]; ok {
+		DropDownUnselected = DropDownUnselected.Foreground(color)
+	}
+
+	if color, ok := colors["dropdown_selected_bg"]; ok {
+		DropDownSelected = DropDownSelected.Background(color)
+	}
+
+	if color, ok := colors["dropdown_selected_fg"]; ok {
+		DropDownSelected = DropDownSelected.Foreground(color)
+	}
+}
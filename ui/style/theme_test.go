@@ -0,0 +1,72 @@
+package style
+
+import (
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("theme", func() {
+	It("theme names", func() {
+		names := ThemeNames(map[string]Theme{"solarized": {}, "light": {}})
+		Expect(names).To(Equal([]string{DefaultTheme, "high-contrast", "light", MonochromeTheme, "solarized"}))
+	})
+
+	It("set built-in theme", func() {
+		Expect(SetTheme("light", nil)).To(BeNil())
+		Expect(BgColor).To(Equal(tcell.ColorWhite))
+		Expect(StoppedStatusFgColor).To(Equal(tcell.ColorFireBrick))
+		Expect(LogsMatchBgColor).To(Equal(tcell.ColorGold))
+	})
+
+	It("set custom theme with base theme", func() {
+		themes := map[string]Theme{
+			"solarized": {
+				"base":            "high-contrast",
+				"bg":              "#002b36",
+				"table_header_bg": "#268bd2",
+			},
+		}
+
+		Expect(SetTheme("solarized", themes)).To(BeNil())
+		Expect(BgColor).To(Equal(tcell.GetColor("#002b36")))
+		Expect(TableHeaderBgColor).To(Equal(tcell.GetColor("#268bd2")))
+		// color from the base theme
+		Expect(BorderColor).To(Equal(tcell.ColorYellow))
+	})
+
+	It("custom theme extends the built-in theme with the same name", func() {
+		themes := map[string]Theme{
+			"light": {
+				"base":   "light",
+				"border": "red",
+			},
+		}
+
+		Expect(SetTheme("light", themes)).To(BeNil())
+		Expect(BorderColor).To(Equal(tcell.ColorRed))
+		Expect(BgColor).To(Equal(tcell.ColorWhite))
+	})
+
+	It("unknown theme", func() {
+		Expect(SetTheme("solarized", nil)).To(MatchError(ErrUnknownTheme))
+	})
+
+	It("unknown theme color", func() {
+		themes := map[string]Theme{"custom": {"headers_fg": "red"}}
+		Expect(SetTheme("custom", themes)).To(MatchError(ErrUnknownThemeColor))
+	})
+
+	It("invalid theme color", func() {
+		themes := map[string]Theme{"custom": {"bg": "not-a-color"}}
+		Expect(SetTheme("custom", themes)).To(MatchError(ErrInvalidThemeColor))
+	})
+
+	It("theme base loop", func() {
+		themes := map[string]Theme{
+			"one": {"base": "two"},
+			"two": {"base": "one"},
+		}
+		Expect(SetTheme("one", themes)).To(MatchError(ErrThemeBaseLoop))
+	})
+})
@@ -8,6 +8,7 @@ import (
 	"github.com/containers/podman-tui/pdcs/sysinfo"
 	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
 	"github.com/rs/zerolog/log"
 )
 
@@ -23,6 +24,8 @@ func (sys *System) runCommand(cmd string) {
 		sys.df()
 	case "events":
 		sys.events()
+	case "health history":
+		sys.healthHistory()
 	case "info":
 		sys.info()
 	case "prune": //nolint:goconst
@@ -73,14 +76,23 @@ func (sys *System) connect() {
 		Identity: selectedItem.identity,
 	}
 
-	sys.eventDialog.SetText("")
+	sys.eventDialog.ClearEvents()
 	sys.connectionConnectFunc(dest)
 	sys.UpdateConnectionsData()
 }
 
 func (sys *System) disconnect() {
-	sys.connectionDisconnectFunc()
-	sys.eventDialog.SetText("")
+	selectedItem := sys.getSelectedItem()
+	// empty table
+	if selectedItem.name == "" {
+		return
+	}
+
+	if selectedItem.name == registry.ConnectionName() {
+		sys.eventDialog.ClearEvents()
+	}
+
+	sys.connectionDisconnectFunc(selectedItem.name)
 	sys.UpdateConnectionsData()
 }
 
@@ -122,6 +134,71 @@ func (sys *System) events() {
 	sys.eventDialog.Display()
 }
 
+// eventsHistory loads the active connection events of the events dialog time range.
+func (sys *System) eventsHistory() {
+	if !sys.destIsSet() {
+		return
+	}
+
+	sys.progressDialog.SetTitle("podman events history in progress")
+	sys.progressDialog.Display()
+
+	opts := sys.eventDialog.GetHistoryOptions()
+
+	history := func() {
+		messages, err := sysinfo.EventsHistory(opts)
+
+		sys.progressDialog.Hide()
+
+		if err != nil {
+			sys.displayError("SYSTEM EVENTS HISTORY ERROR", err)
+
+			return
+		}
+
+		sys.eventDialog.SetHistory(messages)
+	}
+
+	go history()
+}
+
+// exportEvents writes the filtered events of the events dialog to the export file as JSON lines.
+func (sys *System) exportEvents() {
+	output, err := utils.ResolveHomeDir(sys.eventDialog.GetExportOutput())
+	if err == nil {
+		err = utils.ValidateFileName(output)
+	}
+
+	messages := sys.eventDialog.GetFilteredEvents()
+
+	if err == nil {
+		err = sysinfo.ExportEvents(output, messages)
+	}
+
+	if err != nil {
+		sys.displayError("SYSTEM EVENTS EXPORT ERROR", err)
+
+		return
+	}
+
+	sys.messageDialog.SetTitle("podman events export")
+	sys.messageDialog.SetText(dialogs.MessageSystemInfo, registry.ConnectionName(),
+		fmt.Sprintf("%d events exported to %s", len(messages), output))
+	sys.messageDialog.Display()
+}
+
+func (sys *System) healthHistory() {
+	selectedItem := sys.getSelectedItem()
+	// empty table
+	if selectedItem.name == "" {
+		return
+	}
+
+	sys.historyDialog.SetServiceName(selectedItem.name)
+	sys.historyDialog.UpdateHistory(sys.connectionHistoryFunc(selectedItem.name))
+	sys.historyDialog.Display()
+}
+
 func (sys *System) info() {
 	if !sys.destIsSet() {
 		return
@@ -20,15 +20,14 @@ func (sys *System) udpateConnectionDataStatus() {
 	sys.connectionList.mu.Lock()
 	defer sys.connectionList.mu.Unlock()
 
-	name := registry.ConnectionName()
-	status := registry.ConnectionStatus()
+	connStatus := make(map[string]registry.ConnStatus)
 
-	for i := 0; i < len(sys.connectionList.report); i++ {
-		if sys.connectionList.report[i].Name == name {
-			sys.connectionList.report[i].Status = status
+	for _, conn := range registry.Connections() {
+		connStatus[conn.Name] = conn.Status
+	}
 
-			return
-		}
+	for i := 0; i < len(sys.connectionList.report); i++ {
+		sys.connectionList.report[i].Status = connStatus[sys.connectionList.report[i].Name]
 	}
 }
 
@@ -42,6 +41,7 @@ func (sys *System) getConnectionsData() []registry.Connection {
 
 type connectionItemStatus struct {
 	status registry.ConnStatus
+	active bool
 }
 
 func (connStatus connectionItemStatus) StatusString() string {
@@ -54,5 +54,9 @@ func (connStatus connectionItemStatus) StatusString() string {
 		status = fmt.Sprintf("%s %s", style.HeavyRedCrossMark, "connection error")
 	}
 
+	if status != "" && connStatus.active {
+		status += " (active)"
+	}
+
 	return status
 }
@@ -64,6 +64,16 @@ func (sys *System) Draw(screen tcell.Screen) {
 		return
 	}
 
+	// connection health history dialog
+	if sys.historyDialog.IsDisplay() {
+		// update the history while its displayed
+		sys.historyDialog.UpdateHistory(sys.connectionHistoryFunc(sys.historyDialog.GetServiceName()))
+		sys.historyDialog.SetRect(x, y, width, height)
+		sys.historyDialog.Draw(screen)
+
+		return
+	}
+
 	// progress dialog
 	if sys.progressDialog.IsDisplay() {
 		sys.progressDialog.SetRect(x, y, width, height)
@@ -44,6 +44,13 @@ func (sys *System) InputHandler() func(event *tcell.EventKey, setFocus func(p tv
 			}
 		}
 
+		// connection health history dialog handler
+		if sys.historyDialog.HasFocus() {
+			if historyDialogHandler := sys.historyDialog.InputHandler(); historyDialogHandler != nil {
+				historyDialogHandler(event, setFocus)
+			}
+		}
+
 		// error dialog handler
 		if sys.errorDialog.HasFocus() {
 			if errorDialogHandler := sys.errorDialog.InputHandler(); errorDialogHandler != nil {
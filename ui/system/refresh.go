@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/containers/podman-tui/pdcs/registry"
 	"github.com/containers/podman-tui/ui/style"
 	"github.com/rivo/tview"
 )
@@ -34,11 +35,12 @@ func (sys *System) refresh() {
 	}
 
 	rowIndex := 1
+	activeName := registry.ConnectionName()
 
 	for i := 0; i < len(connections); i++ {
 		isDefault := ""
 		conn := connections[i]
-		status := connectionItemStatus{conn.Status}.StatusString()
+		status := connectionItemStatus{status: conn.Status, active: conn.Name == activeName}.StatusString()
 
 		if conn.Default {
 			isDefault = style.HeavyGreenCheckMark
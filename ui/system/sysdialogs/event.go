@@ -1,88 +1,214 @@
 package sysdialogs
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/containers/podman-tui/pdcs/sysinfo"
 	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/containers/podman-tui/ui/style"
 	"github.com/containers/podman-tui/ui/utils"
+	"github.com/docker/docker/api/types/events"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 	"github.com/rs/zerolog/log"
 )
 
 const (
-	formFieldHasFocus = 0 + iota
-	textviewHasFocus
+	eventsTypeFocus = 0 + iota
+	eventsObjectFocus
+	eventsLabelFocus
+	eventsSinceFocus
+	eventsUntilFocus
+	eventsExportFocus
+	eventsTableFocus
+	eventsFormFocus
+)
+
+const (
+	viewEventsTimeColIndex = 0 + iota
+	viewEventsTypeColIndex
+	viewEventsActionColIndex
+	viewEventsNameColIndex
+	viewEventsIDColIndex
+	viewEventsAttributesColIndex
+)
+
+const (
+	eventsFollowMode = 0 + iota
+	eventsPausedMode
+	eventsHistoryMode
+)
+
+const (
+	eventsCancelButton  = "Cancel"
+	eventsExportButton  = "Export"
+	eventsHistoryButton = "History"
+	eventsPauseButton   = "Pause"
+	eventsFollowButton  = "Follow"
+	eventsAllTypes      = "all"
+	eventsTimeFormat    = "2006-01-02 15:04:05"
+	eventsLabelWidth    = 8
 )
 
-// EventsDialog implements system events view dialog primitive.
+// EventTypes is the list of the podman event types.
+var EventTypes = []string{"container", "image", "machine", "network", "pod", "secret", "system", "volume"}
+
+// EventsDialog implements system events table dialog primitive.
 type EventsDialog struct {
 	*tview.Box
-	layout        *tview.Flex
-	serviceName   *tview.InputField
-	textview      *tview.TextView
-	form          *tview.Form
-	display       bool
-	cancelHandler func()
-	focusElement  int
+	layout         *tview.Flex
+	serviceName    *tview.InputField
+	eventType      *tview.DropDown
+	object         *tview.InputField
+	label          *tview.InputField
+	since          *tview.InputField
+	until          *tview.InputField
+	exportOutput   *tview.InputField
+	table          *tview.Table
+	form           *tview.Form
+	headers        []string
+	liveEvents     []events.Message
+	events         []events.Message
+	filteredEvents []events.Message
+	mode           int
+	display        bool
+	focusElement   int
+	cancelHandler  func()
 }
 
 // NewEventDialog returns new EventsDialog primitive.
 func NewEventDialog() *EventsDialog {
 	eventsDialog := EventsDialog{
-		Box:         tview.NewBox(),
-		serviceName: tview.NewInputField(),
-		layout:      tview.NewFlex().SetDirection(tview.FlexRow),
+		Box:          tview.NewBox(),
+		serviceName:  tview.NewInputField(),
+		eventType:    tview.NewDropDown(),
+		object:       tview.NewInputField(),
+		label:        tview.NewInputField(),
+		since:        tview.NewInputField(),
+		until:        tview.NewInputField(),
+		exportOutput: tview.NewInputField(),
+		table:        tview.NewTable(),
+		layout:       tview.NewFlex().SetDirection(tview.FlexRow),
+		headers:      []string{"time", "type", "action", "name", "id", "attributes"},
 	}
 
+	bgColor := style.DialogBgColor
+	fgColor := style.DialogFgColor
+	inputFieldBgColor := style.InputFieldBgColor
+
 	// service name input field
 	serviceNameLabel := "SERVICE NAME:"
 
-	eventsDialog.serviceName.SetBackgroundColor(style.DialogBgColor)
+	eventsDialog.serviceName.SetBackgroundColor(bgColor)
 	eventsDialog.serviceName.SetLabel("[::b]" + serviceNameLabel)
 	eventsDialog.serviceName.SetLabelWidth(len(serviceNameLabel) + 1)
-	eventsDialog.serviceName.SetFieldBackgroundColor(style.DialogBgColor)
+	eventsDialog.serviceName.SetFieldBackgroundColor(bgColor)
 	eventsDialog.serviceName.SetLabelStyle(tcell.StyleDefault.
 		Background(style.DialogBorderColor).
-		Foreground(style.DialogFgColor))
+		Foreground(fgColor))
+
+	// events table
+	eventsDialog.table.SetBackgroundColor(bgColor)
+	eventsDialog.table.SetBorder(true)
+	eventsDialog.table.SetBorderColor(style.DialogSubBoxBorderColor)
+	eventsDialog.table.SetTitleColor(fgColor)
+	eventsDialog.table.SetFixed(1, 1)
+	eventsDialog.table.SetSelectable(true, false)
+
+	// filter fields
+	eventsDialog.eventType.SetLabel("type:")
+	eventsDialog.eventType.SetLabelWidth(eventsLabelWidth)
+	eventsDialog.eventType.SetLabelColor(fgColor)
+	eventsDialog.eventType.SetBackgroundColor(bgColor)
+	eventsDialog.eventType.SetListStyles(style.DropDownUnselected, style.DropDownSelected)
+	eventsDialog.eventType.SetFieldBackgroundColor(inputFieldBgColor)
+	eventsDialog.eventType.SetOptions(append([]string{eventsAllTypes}, EventTypes...), func(_ string, _ int) {
+		eventsDialog.refresh()
+	})
+	eventsDialog.eventType.SetCurrentOption(0)
+
+	inputFields := []struct {
+		field       *tview.InputField
+		label       string
+		placeholder string
+	}{
+		{eventsDialog.object, "object:", "name or ID"},
+		{eventsDialog.label, "label:", "key=value"},
+		{eventsDialog.since, "since:", "timestamp or duration (10m)"},
+		{eventsDialog.until, "until:", "timestamp or duration"},
+		{eventsDialog.exportOutput, "export:", "JSON lines file"},
+	}
 
-	// text view
-	eventsDialog.textview = tview.NewTextView().
-		SetDynamicColors(true).
-		SetWrap(true).
-		SetTextAlign(tview.AlignLeft)
+	for _, input := range inputFields {
+		input.field.SetLabel(input.label)
+		input.field.SetLabelWidth(eventsLabelWidth)
+		input.field.SetLabelColor(fgColor)
+		input.field.SetBackgroundColor(bgColor)
+		input.field.SetFieldBackgroundColor(inputFieldBgColor)
+		input.field.SetPlaceholder(input.placeholder)
+		input.field.SetPlaceholderTextColor(style.InfoBarItemFgColor)
+	}
 
-	eventsDialog.textview.SetTextColor(style.FgColor)
-	eventsDialog.textview.SetBackgroundColor(style.BgColor)
-	eventsDialog.textview.SetBorderColor(style.DialogSubBoxBorderColor)
-	eventsDialog.textview.SetBorder(true)
+	eventsDialog.object.SetChangedFunc(func(_ string) {
+		eventsDialog.refresh()
+	})
+
+	eventsDialog.label.SetChangedFunc(func(_ string) {
+		eventsDialog.refresh()
+	})
 
 	// form
 	eventsDialog.form = tview.NewForm().
-		AddButton("Cancel", nil).
+		AddButton(eventsCancelButton, nil).
+		AddButton(eventsExportButton, nil).
+		AddButton(eventsHistoryButton, nil).
+		AddButton(eventsPauseButton, eventsDialog.togglePause).
 		SetButtonsAlign(tview.AlignRight)
 
-	eventsDialog.form.SetBackgroundColor(style.DialogBgColor)
+	eventsDialog.form.SetBackgroundColor(bgColor)
 	eventsDialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
 
-	// textview layout
+	// filter fields layout
+	filterLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	filterLayout.AddItem(eventsDialog.eventType, 0, 1, true)
+	filterLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	filterLayout.AddItem(eventsDialog.object, 0, 1, true)
+	filterLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	filterLayout.AddItem(eventsDialog.label, 0, 1, true)
+
+	rangeLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+	rangeLayout.AddItem(eventsDialog.since, 0, 1, true)
+	rangeLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	rangeLayout.AddItem(eventsDialog.until, 0, 1, true)
+	rangeLayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
+	rangeLayout.AddItem(eventsDialog.exportOutput, 0, 1, true)
+
+	// table layout
 	tlayout := tview.NewFlex().SetDirection(tview.FlexColumn)
-	tlayout.AddItem(utils.EmptyBoxSpace(style.DialogBgColor), 1, 0, false)
+	tlayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
 
 	tlayout.AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
-		AddItem(utils.EmptyBoxSpace(style.DialogBgColor), 1, 0, false).
-		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
-			AddItem(eventsDialog.serviceName, 1, 0, false).
-			AddItem(utils.EmptyBoxSpace(style.DialogBgColor), 1, 0, false).
-			AddItem(eventsDialog.textview, 0, 1, true),
-			0, 1, true), 0, 1, true)
-
-	tlayout.AddItem(utils.EmptyBoxSpace(style.DialogBgColor), 1, 0, false)
+		AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false).
+		AddItem(eventsDialog.serviceName, 1, 0, false).
+		AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false).
+		AddItem(filterLayout, 1, 0, true).
+		AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false).
+		AddItem(rangeLayout, 1, 0, true).
+		AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false).
+		AddItem(eventsDialog.table, 0, 1, true),
+		0, 1, true)
+
+	tlayout.AddItem(utils.EmptyBoxSpace(bgColor), 1, 0, false)
 
 	// layout
 	eventsDialog.layout.AddItem(tlayout, 0, 1, true)
 	eventsDialog.layout.AddItem(eventsDialog.form, dialogs.DialogFormHeight, 0, true)
 	eventsDialog.layout.SetBorder(true)
-	eventsDialog.layout.SetBackgroundColor(style.DialogBgColor)
+	eventsDialog.layout.SetTitle("SYSTEM EVENTS")
+	eventsDialog.layout.SetBackgroundColor(bgColor)
 	eventsDialog.layout.SetBorderColor(style.DialogBorderColor)
 
 	return &eventsDialog
@@ -90,7 +216,9 @@ func NewEventDialog() *EventsDialog {
 
 // Display displays this primitive.
 func (d *EventsDialog) Display() {
+	d.focusElement = eventsTableFocus
 	d.display = true
+	d.table.ScrollToEnd()
 }
 
 // IsDisplay returns true if primitive is shown.
@@ -105,46 +233,86 @@ func (d *EventsDialog) Hide() {
 
 // SetServiceName sets event dialog service (connection) name.
 func (d *EventsDialog) SetServiceName(name string) {
-	d.layout.SetTitle("SYSTEM EVENTS")
 	d.serviceName.SetText(name)
 }
 
-// SetText sets message dialog text messages.
-func (d *EventsDialog) SetText(message string) {
-	d.textview.Clear()
-	d.textview.SetText(message)
-	d.textview.ScrollToEnd()
+// SetEvents sets the live events of the service connection (oldest first),
+// the table is updated only in follow mode.
+func (d *EventsDialog) SetEvents(messages []events.Message) {
+	d.liveEvents = messages
+
+	if d.mode == eventsFollowMode {
+		d.events = messages
+		d.refresh()
+		d.table.ScrollToEnd()
+	}
+}
+
+// SetHistory displays the events history (oldest first), the live events are not
+// displayed until the follow mode is resumed.
+func (d *EventsDialog) SetHistory(messages []events.Message) {
+	d.events = messages
+	d.setMode(eventsHistoryMode)
+	d.refresh()
+	d.table.ScrollToBeginning()
+}
+
+// ClearEvents clears the events and resumes the follow mode.
+func (d *EventsDialog) ClearEvents() {
+	d.liveEvents = nil
+	d.events = nil
+	d.setMode(eventsFollowMode)
+	d.refresh()
+}
+
+// GetHistoryOptions returns the events history time range and the selected event type filter.
+func (d *EventsDialog) GetHistoryOptions() sysinfo.EventsHistoryOptions {
+	opts := sysinfo.EventsHistoryOptions{
+		Since: strings.TrimSpace(d.since.GetText()),
+		Until: strings.TrimSpace(d.until.GetText()),
+	}
+
+	if _, eventType := d.eventType.GetCurrentOption(); eventType != eventsAllTypes {
+		opts.Filters = map[string][]string{"type": {eventType}}
+	}
+
+	return opts
+}
+
+// GetExportOutput returns the events export output file path.
+func (d *EventsDialog) GetExportOutput() string {
+	return strings.TrimSpace(d.exportOutput.GetText())
+}
+
+// GetFilteredEvents returns the displayed events which match the filters (oldest first).
+func (d *EventsDialog) GetFilteredEvents() []events.Message {
+	return d.filteredEvents
 }
 
 // Focus is called when this primitive receives focus.
 func (d *EventsDialog) Focus(delegate func(p tview.Primitive)) {
 	switch d.focusElement {
-	// text screen field focus
-	case textviewHasFocus:
-		d.textview.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
-			if event.Key() == utils.SwitchFocusKey.EventKey() {
-				d.focusElement = formFieldHasFocus
-				d.Focus(delegate)
-
-				return nil
-			}
-
-			return event
-		})
-		delegate(d.textview)
-	// form field focus
-	case formFieldHasFocus:
+	case eventsTypeFocus:
+		delegate(d.eventType)
+	case eventsObjectFocus:
+		delegate(d.object)
+	case eventsLabelFocus:
+		delegate(d.label)
+	case eventsSinceFocus:
+		delegate(d.since)
+	case eventsUntilFocus:
+		delegate(d.until)
+	case eventsExportFocus:
+		delegate(d.exportOutput)
+	case eventsTableFocus:
+		delegate(d.table)
+	case eventsFormFocus:
 		button := d.form.GetButton(d.form.GetButtonCount() - 1)
 		button.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
 			if event.Key() == utils.SwitchFocusKey.EventKey() {
-				d.focusElement = textviewHasFocus
+				d.focusElement = eventsTypeFocus
 				d.Focus(delegate)
-
-				return nil
-			}
-
-			if event.Key() == tcell.KeyEnter {
-				d.cancelHandler()
+				d.form.SetFocus(0)
 
 				return nil
 			}
@@ -158,7 +326,19 @@ func (d *EventsDialog) Focus(delegate func(p tview.Primitive)) {
 
 // HasFocus returns whether or not this primitive has focus.
 func (d *EventsDialog) HasFocus() bool {
-	return d.form.HasFocus() || d.textview.HasFocus()
+	if d.form.HasFocus() || d.table.HasFocus() || d.eventType.HasFocus() {
+		return true
+	}
+
+	if d.object.HasFocus() || d.label.HasFocus() {
+		return true
+	}
+
+	if d.since.HasFocus() || d.until.HasFocus() || d.exportOutput.HasFocus() {
+		return true
+	}
+
+	return d.Box.HasFocus()
 }
 
 // SetRect set rects for this primitive.
@@ -193,26 +373,49 @@ func (d *EventsDialog) Draw(screen tcell.Screen) {
 }
 
 // InputHandler returns input handler function for this primitive.
-func (d *EventsDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+func (d *EventsDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) { //nolint:cyclop
 	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
 		log.Debug().Msgf("events dialog: event %v received", event)
 
+		if event.Key() == utils.SwitchFocusKey.EventKey() {
+			d.setFocusElement()
+		}
+
+		// dropdown widgets shall handle events before "Esc" key handler
+		if d.eventType.HasFocus() {
+			event = utils.ParseKeyEventKey(event)
+			if typeHandler := d.eventType.InputHandler(); typeHandler != nil {
+				typeHandler(event, setFocus)
+
+				return
+			}
+		}
+
 		if event.Key() == utils.CloseDialogKey.EventKey() {
 			d.cancelHandler()
 
 			return
 		}
 
-		// textview field
-		if d.textview.HasFocus() {
-			if textviewHandler := d.textview.InputHandler(); textviewHandler != nil {
-				textviewHandler(event, setFocus)
+		for _, field := range []*tview.InputField{d.object, d.label, d.since, d.until, d.exportOutput} {
+			if field.HasFocus() {
+				if fieldHandler := field.InputHandler(); fieldHandler != nil {
+					fieldHandler(event, setFocus)
+
+					return
+				}
+			}
+		}
+
+		if d.table.HasFocus() {
+			if tableHandler := d.table.InputHandler(); tableHandler != nil {
+				event = utils.ParseKeyEventKey(event)
+				tableHandler(event, setFocus)
 
 				return
 			}
 		}
 
-		// form primitive
 		if d.form.HasFocus() {
 			if formHandler := d.form.InputHandler(); formHandler != nil {
 				formHandler(event, setFocus)
@@ -223,11 +426,155 @@ func (d *EventsDialog) InputHandler() func(event *tcell.EventKey, setFocus func(
 	})
 }
 
+func (d *EventsDialog) setFocusElement() {
+	if d.focusElement < eventsFormFocus {
+		d.focusElement++
+	}
+}
+
 // SetCancelFunc sets form cancel button selected function.
 func (d *EventsDialog) SetCancelFunc(handler func()) *EventsDialog {
 	d.cancelHandler = handler
-	cancelButton := d.form.GetButton(d.form.GetButtonCount() - 1)
-	cancelButton.SetSelectedFunc(handler)
+	d.setButtonFunc(eventsCancelButton, handler)
+
+	return d
+}
+
+// SetExportFunc sets form export button selected function.
+func (d *EventsDialog) SetExportFunc(handler func()) *EventsDialog {
+	d.setButtonFunc(eventsExportButton, handler)
+
+	return d
+}
+
+// SetHistoryFunc sets form history button selected function.
+func (d *EventsDialog) SetHistoryFunc(handler func()) *EventsDialog {
+	d.setButtonFunc(eventsHistoryButton, handler)
 
 	return d
 }
+
+func (d *EventsDialog) setButtonFunc(label string, handler func()) {
+	button := d.form.GetButton(d.form.GetButtonIndex(label))
+	button.SetSelectedFunc(handler)
+}
+
+// togglePause pauses the live events table update or resumes the follow mode
+// (also after the events history is displayed).
+func (d *EventsDialog) togglePause() {
+	if d.mode == eventsFollowMode {
+		d.setMode(eventsPausedMode)
+		d.refresh()
+
+		return
+	}
+
+	d.events = d.liveEvents
+	d.setMode(eventsFollowMode)
+	d.refresh()
+	d.table.ScrollToEnd()
+}
+
+func (d *EventsDialog) setMode(mode int) {
+	d.mode = mode
+
+	label := eventsPauseButton
+	if mode != eventsFollowMode {
+		label = eventsFollowButton
+	}
+
+	d.form.GetButton(d.form.GetButtonCount() - 1).SetLabel(label)
+}
+
+// matchEvent returns true if the event matches the type, object (name or ID) and label filters.
+func (d *EventsDialog) matchEvent(message events.Message) bool {
+	if _, eventType := d.eventType.GetCurrentOption(); eventType != eventsAllTypes && string(message.Type) != eventType {
+		return false
+	}
+
+	if !utils.MatchFilter(d.object.GetText(), nil, message.Actor.ID, message.Actor.Attributes["name"]) {
+		return false
+	}
+
+	return utils.MatchFilter(d.label.GetText(), message.Actor.Attributes)
+}
+
+func (d *EventsDialog) refresh() {
+	d.table.Clear()
+
+	for i := 0; i < len(d.headers); i++ {
+		d.table.SetCell(0, i,
+			tview.NewTableCell(fmt.Sprintf("[%s::b]%s", style.GetColorHex(style.TableHeaderFgColor), strings.ToUpper(d.headers[i]))).
+				SetExpansion(1).
+				SetBackgroundColor(style.TableHeaderBgColor).
+				SetTextColor(style.TableHeaderFgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
+	}
+
+	d.filteredEvents = []events.Message{}
+	rowIndex := 1
+
+	for _, message := range d.events {
+		if !d.matchEvent(message) {
+			continue
+		}
+
+		d.filteredEvents = append(d.filteredEvents, message)
+
+		cells := []string{
+			eventTime(message).Format(eventsTimeFormat),
+			string(message.Type),
+			string(message.Action),
+			message.Actor.Attributes["name"],
+			utils.GetIDWithLimit(message.Actor.ID),
+			eventAttributes(message),
+		}
+
+		for col, text := range cells {
+			d.table.SetCell(rowIndex, col,
+				tview.NewTableCell(tview.Escape(text)).
+					SetExpansion(1).
+					SetAlign(tview.AlignLeft))
+		}
+
+		rowIndex++
+	}
+
+	mode := "following"
+
+	switch d.mode {
+	case eventsPausedMode:
+		mode = "paused"
+	case eventsHistoryMode:
+		mode = "history"
+	}
+
+	d.table.SetTitle(fmt.Sprintf("[::b]EVENTS[%d/%d] (%s)", len(d.filteredEvents), len(d.events), mode))
+}
+
+// eventTime returns the event time.
+func eventTime(message events.Message) time.Time {
+	if message.TimeNano != 0 {
+		return time.Unix(0, message.TimeNano)
+	}
+
+	return time.Unix(message.Time, 0)
+}
+
+// eventAttributes returns the event actor attributes (labels, image, exit code, ...) except its name.
+func eventAttributes(message events.Message) string {
+	attributes := make([]string, 0, len(message.Actor.Attributes))
+
+	for key, value := range message.Actor.Attributes {
+		if key == "name" {
+			continue
+		}
+
+		attributes = append(attributes, key+"="+value)
+	}
+
+	sort.Strings(attributes)
+
+	return strings.Join(attributes, ", ")
+}
@@ -1,6 +1,7 @@
 package sysdialogs
 
 import (
+	"github.com/docker/docker/api/types/events"
 	"github.com/gdamore/tcell/v2"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -9,6 +10,30 @@ import (
 )
 
 var _ = Describe("system event", Ordered, func() {
+	testEvents := []events.Message{
+		{
+			Type:     "container",
+			Action:   "died",
+			TimeNano: 1714532400000000000,
+			Actor: events.Actor{
+				ID:         "f2a4c6e8b0d1f2a4c6e8b0d1",
+				Attributes: map[string]string{"name": "web01", "app": "web", "containerExitCode": "137"},
+			},
+		},
+		{
+			Type:   "pod",
+			Action: "create",
+			Time:   1714532460,
+			Actor:  events.Actor{ID: "a1b2c3d4e5f6", Attributes: map[string]string{"name": "pod01"}},
+		},
+		{
+			Type:   "image",
+			Action: "pull",
+			Time:   1714532520,
+			Actor:  events.Actor{ID: "docker.io/library/alpine:latest"},
+		},
+	}
+
 	var eventDialogApp *tview.Application
 	var eventDialogScreen tcell.SimulationScreen
 	var eventDialog *EventsDialog
@@ -37,7 +62,7 @@ var _ = Describe("system event", Ordered, func() {
 		eventDialog.Display()
 		eventDialogApp.Draw()
 		Expect(eventDialog.IsDisplay()).To(Equal(true))
-		Expect(eventDialog.focusElement).To(Equal(formFieldHasFocus))
+		Expect(eventDialog.focusElement).To(Equal(eventsTableFocus))
 	})
 
 	It("set focus", func() {
@@ -46,16 +71,86 @@ var _ = Describe("system event", Ordered, func() {
 		Expect(eventDialog.HasFocus()).To(Equal(true))
 	})
 
-	It("cancel button selected", func() {
+	It("set events", func() {
+		eventDialog.SetServiceName("node01")
+		eventDialog.SetEvents(testEvents[:2])
+		eventDialogApp.Draw()
+		Expect(eventDialog.serviceName.GetText()).To(Equal("node01"))
+		Expect(eventDialog.table.GetRowCount()).To(Equal(3))
+		Expect(eventDialog.table.GetCell(1, viewEventsTypeColIndex).Text).To(Equal("container"))
+		Expect(eventDialog.table.GetCell(1, viewEventsActionColIndex).Text).To(Equal("died"))
+		Expect(eventDialog.table.GetCell(1, viewEventsNameColIndex).Text).To(Equal("web01"))
+		Expect(eventDialog.table.GetCell(1, viewEventsAttributesColIndex).Text).To(Equal("app=web, containerExitCode=137"))
+	})
+
+	It("filter events", func() {
+		eventDialog.object.SetText("web")
+		Expect(eventDialog.GetFilteredEvents()).To(HaveLen(1))
+		eventDialog.object.SetText("")
+
+		eventDialog.label.SetText("app=db")
+		Expect(eventDialog.GetFilteredEvents()).To(HaveLen(0))
+		eventDialog.label.SetText("")
+
+		eventDialog.eventType.SetCurrentOption(5) // pod
+		Expect(eventDialog.GetFilteredEvents()).To(HaveLen(1))
+		Expect(eventDialog.GetHistoryOptions().Filters).To(Equal(map[string][]string{"type": {"pod"}}))
+		eventDialog.eventType.SetCurrentOption(0)
+		Expect(eventDialog.GetFilteredEvents()).To(HaveLen(2))
+	})
+
+	It("pause and follow", func() {
+		eventDialog.togglePause()
+		eventDialog.SetEvents(testEvents)
+		Expect(eventDialog.GetFilteredEvents()).To(HaveLen(2))
+		Expect(eventDialog.form.GetButton(3).GetLabel()).To(Equal(eventsFollowButton))
+
+		eventDialog.togglePause()
+		Expect(eventDialog.GetFilteredEvents()).To(HaveLen(3))
+		Expect(eventDialog.form.GetButton(3).GetLabel()).To(Equal(eventsPauseButton))
+	})
+
+	It("events history", func() {
+		eventDialog.since.SetText(" 10m ")
+		eventDialog.SetHistory(testEvents[2:])
+		eventDialog.SetEvents(testEvents)
+		Expect(eventDialog.GetHistoryOptions().Since).To(Equal("10m"))
+		Expect(eventDialog.GetFilteredEvents()).To(HaveLen(1))
+		Expect(eventDialog.table.GetCell(1, viewEventsTypeColIndex).Text).To(Equal("image"))
+
+		eventDialog.ClearEvents()
+		Expect(eventDialog.GetFilteredEvents()).To(HaveLen(0))
+	})
+
+	It("export button selected", func() {
+		exportWants := "export selected"
+		exportAction := "export init"
+		eventDialog.SetExportFunc(func() {
+			exportAction = exportWants
+		})
+		eventDialog.exportOutput.SetText(" events.json ")
+		eventDialog.focusElement = eventsFormFocus
+		eventDialogApp.SetFocus(eventDialog)
+		eventDialogApp.Draw()
+		eventDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone))
+		eventDialogApp.Draw()
+		eventDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		eventDialogApp.Draw()
+		Expect(exportAction).To(Equal(exportWants))
+		Expect(eventDialog.GetExportOutput()).To(Equal("events.json"))
+	})
+
+	It("cancel key pressed", func() {
 		cancelWants := "cancel selected"
 		cancelAction := "cancel init"
 		cancelFunc := func() {
 			cancelAction = cancelWants
 		}
 		eventDialog.SetCancelFunc(cancelFunc)
+		eventDialog.focusElement = eventsTableFocus
 		eventDialogApp.SetFocus(eventDialog)
 		eventDialogApp.Draw()
-		eventDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		eventDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone))
 		eventDialogApp.Draw()
 		Expect(cancelAction).To(Equal(cancelWants))
 	})
@@ -0,0 +1,252 @@
+package sysdialogs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/containers/podman-tui/ui/dialogs"
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
+	"github.com/docker/go-units"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	historyDialogMaxWidth  = 120
+	historyDialogMaxHeight = 20
+	historyTimeFormat      = "2006-01-02 15:04:05"
+)
+
+// ConnectionHistoryDialog is a dialog with connection status transitions history table.
+type ConnectionHistoryDialog struct {
+	*tview.Box
+	layout        *tview.Flex
+	serviceName   *tview.InputField
+	table         *tview.Table
+	form          *tview.Form
+	display       bool
+	tableHeaders  []string
+	cancelHandler func()
+}
+
+// NewConnectionHistoryDialog returns new ConnectionHistoryDialog primitive.
+func NewConnectionHistoryDialog() *ConnectionHistoryDialog {
+	dialog := &ConnectionHistoryDialog{
+		Box:          tview.NewBox(),
+		serviceName:  tview.NewInputField(),
+		tableHeaders: []string{"time", "status", "duration", "latency", "reason"},
+		display:      false,
+	}
+
+	// service name input field
+	serviceNameLabel := "SERVICE NAME:"
+
+	dialog.serviceName.SetBackgroundColor(style.DialogBgColor)
+	dialog.serviceName.SetLabel("[::b]" + serviceNameLabel)
+	dialog.serviceName.SetLabelWidth(len(serviceNameLabel) + 1)
+	dialog.serviceName.SetFieldBackgroundColor(style.DialogBgColor)
+	dialog.serviceName.SetLabelStyle(tcell.StyleDefault.
+		Background(style.DialogBorderColor).
+		Foreground(style.DialogFgColor))
+
+	// history table
+	dialog.table = tview.NewTable()
+
+	dialog.table.SetBackgroundColor(style.DialogBgColor)
+	dialog.table.SetBorder(true)
+	dialog.table.SetBorderColor(style.DialogSubBoxBorderColor)
+	dialog.initTable()
+
+	dialog.form = tview.NewForm().
+		AddButton("Cancel", nil).
+		SetButtonsAlign(tview.AlignRight)
+
+	dialog.form.SetBackgroundColor(style.DialogBgColor)
+	dialog.form.SetButtonBackgroundColor(style.ButtonBgColor)
+
+	dialog.layout = tview.NewFlex().SetDirection(tview.FlexRow)
+
+	dialog.layout.SetBorder(true)
+	dialog.layout.SetBorderColor(style.DialogBorderColor)
+	dialog.layout.SetBackgroundColor(style.DialogBgColor)
+	dialog.layout.SetTitle("CONNECTION HEALTH HISTORY")
+
+	tableLayout := tview.NewFlex().SetDirection(tview.FlexColumn)
+
+	tableLayout.AddItem(utils.EmptyBoxSpace(style.DialogBgColor), 1, 0, true)
+	tableLayout.AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(utils.EmptyBoxSpace(style.DialogBgColor), 1, 0, false).
+		AddItem(dialog.serviceName, 1, 0, false).
+		AddItem(utils.EmptyBoxSpace(style.DialogBgColor), 1, 0, false).
+		AddItem(dialog.table, 0, 1, true), 0, 1, true)
+	tableLayout.AddItem(utils.EmptyBoxSpace(style.DialogBgColor), 1, 0, true)
+
+	dialog.layout.AddItem(tableLayout, 0, 1, true)
+	dialog.layout.AddItem(dialog.form, dialogs.DialogFormHeight, 0, true)
+
+	return dialog
+}
+
+// SetServiceName sets history dialog service (connection) name.
+func (d *ConnectionHistoryDialog) SetServiceName(name string) {
+	d.serviceName.SetText(name)
+}
+
+// GetServiceName returns history dialog service (connection) name.
+func (d *ConnectionHistoryDialog) GetServiceName() string {
+	return d.serviceName.GetText()
+}
+
+// Display displays this primitive.
+func (d *ConnectionHistoryDialog) Display() {
+	d.table.ScrollToBeginning()
+	d.display = true
+}
+
+// IsDisplay returns true if primitive is shown.
+func (d *ConnectionHistoryDialog) IsDisplay() bool {
+	return d.display
+}
+
+// Hide stops displaying this primitive.
+func (d *ConnectionHistoryDialog) Hide() {
+	d.display = false
+}
+
+// Focus is called when this primitive receives focus.
+func (d *ConnectionHistoryDialog) Focus(delegate func(p tview.Primitive)) {
+	delegate(d.form)
+}
+
+// HasFocus returns true if this primitive has focus.
+func (d *ConnectionHistoryDialog) HasFocus() bool {
+	return d.form.HasFocus() || d.table.HasFocus()
+}
+
+// InputHandler returns input handler function for this primitive.
+func (d *ConnectionHistoryDialog) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		log.Debug().Msgf("connection history dialog: event %v received", event)
+
+		if event.Key() == tcell.KeyEsc || event.Key() == tcell.KeyEnter {
+			d.cancelHandler()
+
+			return
+		}
+
+		// scroll between history items
+		if tableHandler := d.table.InputHandler(); tableHandler != nil {
+			tableHandler(event, setFocus)
+
+			return
+		}
+	})
+}
+
+// SetRect set rects for this primitive.
+func (d *ConnectionHistoryDialog) SetRect(x, y, width, height int) {
+	dX := x + dialogs.DialogPadding
+	dY := y
+	dWidth := width - (2 * dialogs.DialogPadding) //nolint:gomnd
+
+	if dWidth > historyDialogMaxWidth {
+		dWidth = historyDialogMaxWidth
+		emptySpace := (width - dWidth) / 2 //nolint:gomnd
+		dX = x + emptySpace
+	}
+
+	dHeight := dialogs.DialogFormHeight + historyDialogMaxHeight
+	if height > dHeight {
+		dY = y + ((height - dHeight) / 2) //nolint:gomnd
+		height = dHeight
+	}
+
+	d.Box.SetRect(dX, dY, dWidth, height)
+}
+
+// Draw draws this primitive onto the screen.
+func (d *ConnectionHistoryDialog) Draw(screen tcell.Screen) {
+	if !d.display {
+		return
+	}
+
+	d.Box.DrawForSubclass(screen, d)
+	x, y, width, height := d.Box.GetInnerRect()
+	d.layout.SetRect(x, y, width, height)
+	d.layout.Draw(screen)
+}
+
+// SetCancelFunc sets form cancel button selected function.
+func (d *ConnectionHistoryDialog) SetCancelFunc(handler func()) *ConnectionHistoryDialog {
+	d.cancelHandler = handler
+
+	return d
+}
+
+func (d *ConnectionHistoryDialog) initTable() {
+	bgColor := style.TableHeaderBgColor
+	fgColor := style.TableHeaderFgColor
+
+	d.table.Clear()
+
+	// add headers
+	for i := 0; i < len(d.tableHeaders); i++ {
+		d.table.SetCell(0, i,
+			tview.NewTableCell(fmt.Sprintf("[%s::b]%s", style.GetColorHex(fgColor), strings.ToUpper(d.tableHeaders[i]))).
+				SetExpansion(1).
+				SetBackgroundColor(bgColor).
+				SetTextColor(fgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
+	}
+
+	d.table.SetFixed(1, 1)
+	d.table.SetSelectable(true, false)
+}
+
+// UpdateHistory updates the history table with the connection transitions (oldest first),
+// the most recent transition is displayed first.
+func (d *ConnectionHistoryDialog) UpdateHistory(transitions []registry.ConnectionTransition) {
+	d.initTable()
+
+	rowIndex := 1
+	now := time.Now()
+
+	for i := len(transitions) - 1; i >= 0; i-- {
+		transition := transitions[i]
+
+		// duration of the status until the next transition
+		until := now
+		if i < len(transitions)-1 {
+			until = transitions[i+1].Time
+		}
+
+		duration := units.HumanDuration(until.Sub(transition.Time))
+
+		latency := ""
+		if transition.Latency > 0 {
+			latency = transition.Latency.Round(time.Millisecond).String()
+		}
+
+		cells := []string{
+			transition.Time.Format(historyTimeFormat),
+			transition.Status.String(),
+			duration,
+			latency,
+			transition.Message,
+		}
+
+		for col, text := range cells {
+			d.table.SetCell(rowIndex, col,
+				tview.NewTableCell(text).
+					SetExpansion(1).
+					SetAlign(tview.AlignLeft))
+		}
+
+		rowIndex++
+	}
+}
@@ -0,0 +1,90 @@
+package sysdialogs
+
+import (
+	"time"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("connection history", Ordered, func() {
+	var historyDialogApp *tview.Application
+	var historyDialogScreen tcell.SimulationScreen
+	var historyDialog *ConnectionHistoryDialog
+	var runApp func()
+
+	BeforeAll(func() {
+		historyDialogApp = tview.NewApplication()
+		historyDialog = NewConnectionHistoryDialog()
+		historyDialogScreen = tcell.NewSimulationScreen("UTF-8")
+		err := historyDialogScreen.Init()
+		if err != nil {
+			panic(err)
+		}
+
+		runApp = func() {
+			if err := historyDialogApp.SetScreen(historyDialogScreen).SetRoot(historyDialog, true).Run(); err != nil {
+				panic(err)
+			}
+		}
+
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+		go runApp()
+	})
+
+	It("display", func() {
+		historyDialog.Display()
+		historyDialogApp.Draw()
+		Expect(historyDialog.IsDisplay()).To(Equal(true))
+	})
+
+	It("set focus", func() {
+		historyDialogApp.SetFocus(historyDialog)
+		historyDialogApp.Draw()
+		Expect(historyDialog.HasFocus()).To(Equal(true))
+	})
+
+	It("cancel button selected", func() {
+		cancelWants := "cancel selected"
+		cancelAction := "cancel init"
+		cancelFunc := func() {
+			cancelAction = cancelWants
+		}
+		historyDialog.SetCancelFunc(cancelFunc)
+		historyDialogApp.SetFocus(historyDialog)
+		historyDialogApp.Draw()
+		historyDialogApp.QueueEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+		historyDialogApp.Draw()
+		Expect(cancelAction).To(Equal(cancelWants))
+	})
+
+	It("update history", func() {
+		now := time.Now()
+		historyDialog.SetServiceName("node01")
+		historyDialog.UpdateHistory([]registry.ConnectionTransition{
+			{Time: now.Add(-time.Minute), Status: registry.ConnectionStatusConnected, Latency: time.Millisecond},
+			{Time: now, Status: registry.ConnectionStatusConnectionError, Message: "connection refused"},
+		})
+		historyDialogApp.Draw()
+		Expect(historyDialog.GetServiceName()).To(Equal("node01"))
+		Expect(historyDialog.table.GetRowCount()).To(Equal(3))
+		Expect(historyDialog.table.GetCell(1, 1).Text).To(Equal("connection error"))
+		Expect(historyDialog.table.GetCell(1, 4).Text).To(Equal("connection refused"))
+		Expect(historyDialog.table.GetCell(2, 1).Text).To(Equal("connected"))
+		Expect(historyDialog.table.GetCell(2, 2).Text).To(Equal("About a minute"))
+		Expect(historyDialog.table.GetCell(2, 3).Text).To(Equal("1ms"))
+	})
+
+	It("hide", func() {
+		historyDialog.Hide()
+		Expect(historyDialog.IsDisplay()).To(Equal(false))
+	})
+
+	AfterAll(func() {
+		historyDialogApp.Stop()
+	})
+})
@@ -10,6 +10,7 @@ import (
 	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/containers/podman-tui/ui/style"
 	"github.com/containers/podman-tui/ui/system/sysdialogs"
+	"github.com/docker/docker/api/types/events"
 	"github.com/rivo/tview"
 )
 
@@ -31,13 +32,15 @@ type System struct {
 	dfDialog                 *sysdialogs.DfDialog
 	connPrgDialog            *sysdialogs.ConnectDialog
 	connAddDialog            *sysdialogs.AddConnectionDialog
+	historyDialog            *sysdialogs.ConnectionHistoryDialog
 	confirmData              string
 	connectionListFunc       func() []registry.Connection
 	connectionAddFunc        func(string, string, string) error
 	connectionRemoveFunc     func(string) error
 	connectionSetDefaultFunc func(string) error
 	connectionConnectFunc    func(registry.Connection)
-	connectionDisconnectFunc func()
+	connectionDisconnectFunc func(string)
+	connectionHistoryFunc    func(string) []registry.ConnectionTransition
 }
 
 type connectionListReport struct {
@@ -67,6 +70,7 @@ func NewSystem() *System {
 		dfDialog:         sysdialogs.NewDfDialog(),
 		connPrgDialog:    sysdialogs.NewConnectDialog(),
 		connAddDialog:    sysdialogs.NewAddConnectionDialog(),
+		historyDialog:    sysdialogs.NewConnectionHistoryDialog(),
 	}
 
 	// connection table
@@ -91,10 +95,11 @@ func NewSystem() *System {
 
 	sys.cmdDialog = dialogs.NewCommandDialog([][]string{
 		{"add connection", "record destination for the Podman TUI service"},
-		{"connect", "connect to selected destination"},
-		{"disconnect", "disconnect from connected destination"},
+		{"connect", "connect to selected destination and set it as active destination"},
+		{"disconnect", "disconnect from selected destination"},
 		{"disk usage", "display destination podman related disk usage"},
 		{"events", "display destination system events"},
+		{"health history", "display selected destination connection health history"},
 		{"info", "display destination podman system information"},
 		{"prune", "remove all unused pod, container, image and volume data"},
 		{"remove connection", "delete named destination for the Podman TUI"},
@@ -136,17 +141,22 @@ func NewSystem() *System {
 	sys.eventDialog.SetCancelFunc(func() {
 		sys.eventDialog.Hide()
 	})
+	sys.eventDialog.SetHistoryFunc(sys.eventsHistory)
+	sys.eventDialog.SetExportFunc(sys.exportEvents)
 
 	// set disk usage function
 	sys.dfDialog.SetCancelFunc(func() {
 		sys.dfDialog.Hide()
 	})
 
+	// set connection health history dialog function
+	sys.historyDialog.SetCancelFunc(sys.historyDialog.Hide)
+
 	// set connection progress bar cancel function
 	sys.connPrgDialog.SetCancelFunc(func() {
 		sys.connPrgDialog.Hide()
 		registry.UnsetConnection()
-		sys.eventDialog.SetText("")
+		sys.eventDialog.ClearEvents()
 		sys.UpdateConnectionsData()
 	})
 
@@ -186,6 +196,10 @@ func (sys *System) HasFocus() bool { //nolint:cyclop
 		return true
 	}
 
+	if sys.historyDialog.HasFocus() {
+		return true
+	}
+
 	return sys.Box.HasFocus()
 }
 
@@ -208,6 +222,10 @@ func (sys *System) SubDialogHasFocus() bool {
 		return true
 	}
 
+	if sys.historyDialog.HasFocus() {
+		return true
+	}
+
 	return false
 }
 
@@ -248,6 +266,13 @@ func (sys *System) Focus(delegate func(p tview.Primitive)) {
 		return
 	}
 
+	// connection health history dialog
+	if sys.historyDialog.IsDisplay() {
+		delegate(sys.historyDialog)
+
+		return
+	}
+
 	// connection progress dialog
 	if sys.connPrgDialog.IsDisplay() {
 		delegate(sys.connPrgDialog)
@@ -272,10 +297,9 @@ func (sys *System) Focus(delegate func(p tview.Primitive)) {
 	delegate(sys.connTable)
 }
 
-// SetEventMessage appends podman events to textview.
-func (sys *System) SetEventMessage(messages []string) {
-	msg := strings.Join(messages, "\n")
-	sys.eventDialog.SetText(msg)
+// SetEventMessage sets the active connection podman events of the events dialog.
+func (sys *System) SetEventMessage(messages []events.Message) {
+	sys.eventDialog.SetEvents(messages)
 }
 
 // SetConnectionProgressMessage sets connection progressbar error message.
@@ -326,6 +350,7 @@ func (sys *System) hideAllDialogs() {
 	sys.progressDialog.Hide()
 	sys.eventDialog.Hide()
 	sys.connAddDialog.Hide()
+	sys.historyDialog.Hide()
 }
 
 // SetConnectionListFunc sets list destination function.
@@ -344,10 +369,15 @@ func (sys *System) SetConnectionConnectFunc(connect func(dest registry.Connectio
 }
 
 // SetConnectionDisconnectFunc sets system disconnect function.
-func (sys *System) SetConnectionDisconnectFunc(disconnect func()) {
+func (sys *System) SetConnectionDisconnectFunc(disconnect func(name string)) {
 	sys.connectionDisconnectFunc = disconnect
 }
 
+// SetConnectionHistoryFunc sets system connection health history function.
+func (sys *System) SetConnectionHistoryFunc(history func(name string) []registry.ConnectionTransition) {
+	sys.connectionHistoryFunc = history
+}
+
 // SetConnectionAddFunc sets system add new connection function.
 func (sys *System) SetConnectionAddFunc(add func(name string, uri string, identity string) error) {
 	sys.connectionAddFunc = add
@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// CopyToClipboard copies the text to the terminal clipboard using the OSC 52 escape sequence
+// (the text is not displayed), it also works over ssh sessions if the terminal supports it.
+// The writer shall be the screen terminal (see TerminalWriter) and it shall be called from
+// the user interface goroutine to not interleave with the screen updates.
+func CopyToClipboard(writer io.Writer, text string) error {
+	return writeClipboard(writer, text, os.Getenv("TMUX") != "")
+}
+
+// TerminalWriter returns the terminal of the screen (or the standard output if the screen
+// has no terminal) to write the escape sequences which are not supported by the screen.
+func TerminalWriter(screen tcell.Screen) io.Writer {
+	if screen != nil {
+		if tty, ok := screen.Tty(); ok && tty != nil {
+			return tty
+		}
+	}
+
+	return os.Stdout
+}
+
+// writeClipboard writes the OSC 52 clipboard sequence of the text, the sequence is wrapped
+// in a tmux passthrough sequence if tmux is set.
+func writeClipboard(writer io.Writer, text string, tmux bool) error {
+	sequence := fmt.Sprintf("\x1b]52;c;%s\a", base64.StdEncoding.EncodeToString([]byte(text)))
+
+	if tmux {
+		sequence = "\x1bPtmux;" + strings.ReplaceAll(sequence, "\x1b", "\x1b\x1b") + "\x1b\\"
+	}
+
+	_, err := io.WriteString(writer, sequence)
+
+	return err
+}
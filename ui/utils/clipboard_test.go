@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"os"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("clipboard", func() {
+	It("write clipboard sequence", func() {
+		var writer strings.Builder
+
+		Expect(writeClipboard(&writer, "s3cret", false)).To(BeNil())
+		Expect(writer.String()).To(Equal("\x1b]52;c;czNjcmV0\a"))
+	})
+
+	It("write clipboard tmux passthrough sequence", func() {
+		var writer strings.Builder
+
+		Expect(writeClipboard(&writer, "s3cret", true)).To(BeNil())
+		Expect(writer.String()).To(Equal("\x1bPtmux;\x1b\x1b]52;c;czNjcmV0\a\x1b\\"))
+	})
+
+	It("terminal writer", func() {
+		Expect(TerminalWriter(nil)).To(Equal(os.Stdout))
+		Expect(TerminalWriter(tcell.NewSimulationScreen("UTF-8"))).To(Equal(os.Stdout))
+	})
+})
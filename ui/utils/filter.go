@@ -0,0 +1,109 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// hostFilterPrefix is the filter term prefix which matches the item service connection name.
+	hostFilterPrefix = "@"
+	// healthFilterPrefix is the filter term prefix which matches the container health status.
+	healthFilterPrefix = "health:"
+	// UnhealthyFilter is the filter term of the unhealthy containers.
+	UnhealthyFilter = healthFilterPrefix + "unhealthy"
+)
+
+// MatchFilter returns true if the item matches all the filter terms.
+// A term in key=value format matches the item labels (key= matches label key
+// only) and other terms match any of the item fields (case insensitive).
+// The @name and health:status terms are ignored (see MatchHostFilter and MatchHealthFilter).
+func MatchFilter(filter string, labels map[string]string, fields ...string) bool {
+	for _, term := range strings.Fields(filter) {
+		if strings.HasPrefix(term, hostFilterPrefix) || strings.HasPrefix(term, healthFilterPrefix) {
+			continue
+		}
+
+		if key, value, ok := strings.Cut(term, "="); ok && key != "" {
+			labelValue, found := labels[key]
+			if !found || (value != "" && labelValue != value) {
+				return false
+			}
+
+			continue
+		}
+
+		if !matchFilterFields(strings.ToLower(term), fields) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MatchHostFilter returns true if the filter does not have any @name term or
+// the item service connection name (host) matches one of the @name terms.
+func MatchHostFilter(filter string, host string) bool {
+	hasHostTerm := false
+
+	for _, term := range strings.Fields(filter) {
+		name, ok := strings.CutPrefix(term, hostFilterPrefix)
+		if !ok {
+			continue
+		}
+
+		hasHostTerm = true
+
+		if name == host {
+			return true
+		}
+	}
+
+	return !hasHostTerm
+}
+
+// MatchHealthFilter returns true if the filter does not have any health:status term or
+// the item health status (healthy, unhealthy or starting) matches one of the health:status terms.
+func MatchHealthFilter(filter string, health string) bool {
+	hasHealthTerm := false
+
+	for _, term := range strings.Fields(filter) {
+		status, ok := strings.CutPrefix(term, healthFilterPrefix)
+		if !ok {
+			continue
+		}
+
+		hasHealthTerm = true
+
+		if strings.EqualFold(status, health) {
+			return true
+		}
+	}
+
+	return !hasHealthTerm
+}
+
+func matchFilterFields(term string, fields []string) bool {
+	for _, field := range fields {
+		if strings.Contains(strings.ToLower(field), term) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TableTitle returns resource table title with number of items, number of
+// filter matched items if the filter is set and number of marked items.
+func TableTitle(title string, total int, matched int, filter string, marked int) string {
+	tableTitle := fmt.Sprintf("[::b]%s[%d]", strings.ToUpper(title), total)
+	if filter != "" {
+		tableTitle = fmt.Sprintf("[::b]%s[%d/%d]", strings.ToUpper(title), matched, total)
+	}
+
+	if marked > 0 {
+		tableTitle = fmt.Sprintf("%s MARKED[%d]", tableTitle, marked)
+	}
+
+	return tableTitle
+}
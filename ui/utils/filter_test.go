@@ -0,0 +1,49 @@
+package utils
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("table filter", func() {
+	labels := map[string]string{"app": "web", "tier": "frontend"}
+
+	It("match filter", func() {
+		Expect(MatchFilter("", labels, "id01", "web01")).To(Equal(true))
+		Expect(MatchFilter("WEB", labels, "id01", "web01")).To(Equal(true))
+		Expect(MatchFilter("web id01", labels, "id01", "web01")).To(Equal(true))
+		Expect(MatchFilter("web id02", labels, "id01", "web01")).To(Equal(false))
+		Expect(MatchFilter("app=web", labels, "id01", "db01")).To(Equal(true))
+		Expect(MatchFilter("app=db", labels, "id01", "db01")).To(Equal(false))
+		Expect(MatchFilter("tier=", labels, "id01", "db01")).To(Equal(true))
+		Expect(MatchFilter("env=", labels, "id01", "db01")).To(Equal(false))
+		Expect(MatchFilter("app=web", nil, "id01", "web01")).To(Equal(false))
+		Expect(MatchFilter("@node01 web", labels, "id01", "web01")).To(Equal(true))
+		Expect(MatchFilter("health:unhealthy web", labels, "id01", "web01")).To(Equal(true))
+	})
+
+	It("match host filter", func() {
+		Expect(MatchHostFilter("", "node01")).To(Equal(true))
+		Expect(MatchHostFilter("web", "node01")).To(Equal(true))
+		Expect(MatchHostFilter("@node01 web", "node01")).To(Equal(true))
+		Expect(MatchHostFilter("@node01 @node02", "node02")).To(Equal(true))
+		Expect(MatchHostFilter("@node01", "node02")).To(Equal(false))
+		Expect(MatchHostFilter("@node", "node01")).To(Equal(false))
+	})
+
+	It("match health filter", func() {
+		Expect(MatchHealthFilter("", "")).To(Equal(true))
+		Expect(MatchHealthFilter("web", "healthy")).To(Equal(true))
+		Expect(MatchHealthFilter(UnhealthyFilter, "unhealthy")).To(Equal(true))
+		Expect(MatchHealthFilter("health:UNHEALTHY web", "unhealthy")).To(Equal(true))
+		Expect(MatchHealthFilter("health:healthy health:starting", "starting")).To(Equal(true))
+		Expect(MatchHealthFilter(UnhealthyFilter, "healthy")).To(Equal(false))
+		Expect(MatchHealthFilter(UnhealthyFilter, "")).To(Equal(false))
+	})
+
+	It("table title", func() {
+		Expect(TableTitle("pods", 5, 5, "", 0)).To(Equal("[::b]PODS[5]"))
+		Expect(TableTitle("pods", 5, 2, "web", 0)).To(Equal("[::b]PODS[2/5]"))
+		Expect(TableTitle("pods", 5, 2, "web", 1)).To(Equal("[::b]PODS[2/5] MARKED[1]"))
+	})
+})
@@ -1,167 +1,265 @@
 package utils
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
 	"github.com/gdamore/tcell/v2"
 	"github.com/rs/zerolog/log"
 )
 
-// application key bindings names.
-
+// application key bindings.
 var (
 	CommandMenuKey = uiKeyInfo{
-		Key:      tcell.Key(256), //nolint:gomnd
-		KeyRune:  rune('m'),
-		KeyLabel: "m",
-		KeyDesc:  "display command menu",
+		KeyBinding: "command_menu",
+		Key:        tcell.Key(256), //nolint:gomnd
+		KeyRune:    rune('m'),
+		KeyLabel:   "m",
+		KeyDesc:    "display command menu",
 	}
 	NextScreenKey = uiKeyInfo{
-		Key:      tcell.Key(256), //nolint:gomnd
-		KeyRune:  rune('l'),
-		KeyLabel: "l",
-		KeyDesc:  "switch to next screen",
+		KeyBinding: "next_screen",
+		Key:        tcell.Key(256), //nolint:gomnd
+		KeyRune:    rune('l'),
+		KeyLabel:   "l",
+		KeyDesc:    "switch to next screen",
 	}
 	PreviousScreenKey = uiKeyInfo{
-		Key:      tcell.Key(256), //nolint:gomnd
-		KeyRune:  rune('h'),
-		KeyLabel: "h",
-		KeyDesc:  "switch to previous screen",
+		KeyBinding: "previous_screen",
+		Key:        tcell.Key(256), //nolint:gomnd
+		KeyRune:    rune('h'),
+		KeyLabel:   "h",
+		KeyDesc:    "switch to previous screen",
 	}
 	MoveUpKey = uiKeyInfo{
-		Key:      tcell.KeyUp,
-		KeyRune:  rune('k'),
-		KeyLabel: "k",
-		KeyDesc:  "move up",
+		KeyBinding: "move_up",
+		Key:        tcell.KeyUp,
+		KeyRune:    rune('k'),
+		KeyLabel:   "k",
+		KeyDesc:    "move up",
 	}
 	MoveDownKey = uiKeyInfo{
-		Key:      tcell.KeyDown,
-		KeyRune:  rune('j'),
-		KeyLabel: "j",
-		KeyDesc:  "move down",
+		KeyBinding: "move_down",
+		Key:        tcell.KeyDown,
+		KeyRune:    rune('j'),
+		KeyLabel:   "j",
+		KeyDesc:    "move down",
 	}
 	CloseDialogKey = uiKeyInfo{
-		Key:      tcell.KeyEsc,
-		KeyLabel: "Esc",
-		KeyDesc:  "close the active dialog",
+		KeyBinding: "close_dialog",
+		Key:        tcell.KeyEsc,
+		KeyLabel:   "Esc",
+		KeyDesc:    "close the active dialog",
 	}
 	SwitchFocusKey = uiKeyInfo{
-		Key:      tcell.KeyTab,
-		KeyLabel: "Tab",
-		KeyDesc:  "switch between widgets",
+		KeyBinding: "switch_focus",
+		Key:        tcell.KeyTab,
+		KeyLabel:   "Tab",
+		KeyDesc:    "switch between widgets",
 	}
 	DeleteKey = uiKeyInfo{
-		Key:      tcell.KeyDelete,
-		KeyLabel: "Delete",
-		KeyDesc:  "delete the selected item",
+		KeyBinding: "delete",
+		Key:        tcell.KeyDelete,
+		KeyLabel:   "Delete",
+		KeyDesc:    "delete the selected item",
+	}
+	MarkItemKey = uiKeyInfo{
+		KeyBinding: "mark_item",
+		Key:        tcell.Key(256), //nolint:gomnd
+		KeyRune:    rune(' '),
+		KeyLabel:   "Space",
+		KeyDesc:    "mark/unmark the selected item",
+	}
+	MarkAllKey = uiKeyInfo{
+		KeyBinding: "mark_all",
+		Key:        tcell.KeyCtrlA,
+		KeyLabel:   "Ctrl+a",
+		KeyDesc:    "mark/unmark all items",
+	}
+	MarkPatternKey = uiKeyInfo{
+		KeyBinding: "mark_pattern",
+		Key:        tcell.Key(256), //nolint:gomnd
+		KeyRune:    rune('+'),
+		KeyLabel:   "+",
+		KeyDesc:    "mark items matching a pattern",
+	}
+	FilterKey = uiKeyInfo{
+		KeyBinding: "filter",
+		Key:        tcell.Key(256), //nolint:gomnd
+		KeyRune:    rune('/'),
+		KeyLabel:   "/",
+		KeyDesc:    "filter the table items",
+	}
+	SortColumnKey = uiKeyInfo{
+		KeyBinding: "sort_column",
+		Key:        tcell.Key(256), //nolint:gomnd
+		KeyRune:    rune('s'),
+		KeyLabel:   "s",
+		KeyDesc:    "switch the table sort column",
+	}
+	SortOrderKey = uiKeyInfo{
+		KeyBinding: "sort_order",
+		Key:        tcell.Key(256), //nolint:gomnd
+		KeyRune:    rune('S'),
+		KeyLabel:   "S",
+		KeyDesc:    "reverse the table sort order",
+	}
+	RestartKey = uiKeyInfo{
+		KeyBinding: "restart",
+		Key:        tcell.Key(256), //nolint:gomnd
+		KeyRune:    rune('r'),
+		KeyLabel:   "r",
+		KeyDesc:    "restart the selected container",
 	}
 	ArrowUpKey = uiKeyInfo{
-		Key:      tcell.KeyUp,
-		KeyLabel: "Arrow Up",
-		KeyDesc:  "move up",
+		KeyBinding: "arrow_up",
+		Key:        tcell.KeyUp,
+		KeyLabel:   "Arrow Up",
+		KeyDesc:    "move up",
 	}
 	ArrowDownKey = uiKeyInfo{
-		Key:      tcell.KeyDown,
-		KeyLabel: "Arrow Down",
-		KeyDesc:  "move down",
+		KeyBinding: "arrow_down",
+		Key:        tcell.KeyDown,
+		KeyLabel:   "Arrow Down",
+		KeyDesc:    "move down",
 	}
 	ArrowLeftKey = uiKeyInfo{
-		Key:      tcell.KeyLeft,
-		KeyLabel: "Arrow Left",
-		KeyDesc:  "previous screen",
+		KeyBinding: "arrow_left",
+		Key:        tcell.KeyLeft,
+		KeyLabel:   "Arrow Left",
+		KeyDesc:    "previous screen",
 	}
 	ArrowRightKey = uiKeyInfo{
-		Key:      tcell.KeyRight,
-		KeyLabel: "Arrow Right",
-		KeyDesc:  "next screen",
+		KeyBinding: "arrow_right",
+		Key:        tcell.KeyRight,
+		KeyLabel:   "Arrow Right",
+		KeyDesc:    "next screen",
 	}
 	ScrollUpKey = uiKeyInfo{
-		Key:      tcell.KeyPgUp,
-		KeyLabel: "Page Up",
-		KeyDesc:  "scroll up",
+		KeyBinding: "scroll_up",
+		Key:        tcell.KeyPgUp,
+		KeyLabel:   "Page Up",
+		KeyDesc:    "scroll up",
 	}
 	ScrollDownKey = uiKeyInfo{
-		Key:      tcell.KeyPgDn,
-		KeyLabel: "Page Down",
-		KeyDesc:  "scroll down",
+		KeyBinding: "scroll_down",
+		Key:        tcell.KeyPgDn,
+		KeyLabel:   "Page Down",
+		KeyDesc:    "scroll down",
 	}
 	AppExitKey = uiKeyInfo{
-		Key:      tcell.KeyCtrlC,
-		KeyLabel: "Ctrl+c",
-		KeyDesc:  "exit application",
+		KeyBinding: "app_exit",
+		Key:        tcell.KeyCtrlC,
+		KeyLabel:   "Ctrl+c",
+		KeyDesc:    "exit application",
 	}
 	HelpScreenKey = uiKeyInfo{
-		Key:      tcell.KeyF1,
-		KeyLabel: "F1",
-		KeyDesc:  "display help screen",
+		KeyBinding: "help_screen",
+		Key:        tcell.KeyF1,
+		KeyLabel:   "F1",
+		KeyDesc:    "display help screen",
 	}
 	SystemScreenKey = uiKeyInfo{
-		Key:      tcell.KeyF2,
-		KeyLabel: "F2",
-		KeyDesc:  "display system screen",
+		KeyBinding: "system_screen",
+		Key:        tcell.KeyF2,
+		KeyLabel:   "F2",
+		KeyDesc:    "display system screen",
 	}
 	PodsScreenKey = uiKeyInfo{
-		Key:      tcell.KeyF3,
-		KeyLabel: "F3",
-		KeyDesc:  "display pods screen",
+		KeyBinding: "pods_screen",
+		Key:        tcell.KeyF3,
+		KeyLabel:   "F3",
+		KeyDesc:    "display pods screen",
 	}
 	ContainersScreenKey = uiKeyInfo{
-		Key:      tcell.KeyF4,
-		KeyLabel: "F4",
-		KeyDesc:  "display containers screen",
+		KeyBinding: "containers_screen",
+		Key:        tcell.KeyF4,
+		KeyLabel:   "F4",
+		KeyDesc:    "display containers screen",
 	}
 	VolumesScreenKey = uiKeyInfo{
-		Key:      tcell.KeyF5,
-		KeyLabel: "F5",
-		KeyDesc:  "display volumes screen",
+		KeyBinding: "volumes_screen",
+		Key:        tcell.KeyF5,
+		KeyLabel:   "F5",
+		KeyDesc:    "display volumes screen",
 	}
 	ImagesScreenKey = uiKeyInfo{
-		Key:      tcell.KeyF6,
-		KeyLabel: "F6",
-		KeyDesc:  "display images screen",
+		KeyBinding: "images_screen",
+		Key:        tcell.KeyF6,
+		KeyLabel:   "F6",
+		KeyDesc:    "display images screen",
 	}
 	NetworksScreenKey = uiKeyInfo{
-		Key:      tcell.KeyF7,
-		KeyLabel: "F7",
-		KeyDesc:  "display networks screen",
+		KeyBinding: "networks_screen",
+		Key:        tcell.KeyF7,
+		KeyLabel:   "F7",
+		KeyDesc:    "display networks screen",
 	}
 	SecretsScreenKey = uiKeyInfo{
-		Key:      tcell.KeyF8,
-		KeyLabel: "F8",
-		KeyDesc:  "display secrets screen",
+		KeyBinding: "secrets_screen",
+		Key:        tcell.KeyF8,
+		KeyLabel:   "F8",
+		KeyDesc:    "display secrets screen",
 	}
 )
 
-// UIKeysBindings user interface key bindings.
-var UIKeysBindings = []uiKeyInfo{
-	CommandMenuKey,
-	NextScreenKey,
-	PreviousScreenKey,
-	MoveUpKey,
-	MoveDownKey,
-	CloseDialogKey,
-	SwitchFocusKey,
-	DeleteKey,
-	ArrowUpKey,
-	ArrowDownKey,
-	ArrowLeftKey,
-	ArrowRightKey,
-	ScrollUpKey,
-	ScrollDownKey,
-	AppExitKey,
-	HelpScreenKey,
-	SystemScreenKey,
-	PodsScreenKey,
-	ContainersScreenKey,
-	VolumesScreenKey,
-	ImagesScreenKey,
-	NetworksScreenKey,
-	SecretsScreenKey,
+// UIKeysBindings user interface key bindings in effect.
+var UIKeysBindings = keysBindings()
+
+// keyNameAliases special keys names used in the key bindings labels
+// in addition to tcell key names.
+var keyNameAliases = map[tcell.Key]string{
+	tcell.KeyUp:    "Arrow Up",
+	tcell.KeyDown:  "Arrow Down",
+	tcell.KeyLeft:  "Arrow Left",
+	tcell.KeyRight: "Arrow Right",
+	tcell.KeyPgUp:  "Page Up",
+	tcell.KeyPgDn:  "Page Down",
+}
+
+// uiKeysBindingsRefs user interface configurable key bindings.
+var uiKeysBindingsRefs = []*uiKeyInfo{
+	&CommandMenuKey,
+	&NextScreenKey,
+	&PreviousScreenKey,
+	&MoveUpKey,
+	&MoveDownKey,
+	&CloseDialogKey,
+	&SwitchFocusKey,
+	&DeleteKey,
+	&MarkItemKey,
+	&MarkAllKey,
+	&MarkPatternKey,
+	&FilterKey,
+	&SortColumnKey,
+	&SortOrderKey,
+	&RestartKey,
+	&ArrowUpKey,
+	&ArrowDownKey,
+	&ArrowLeftKey,
+	&ArrowRightKey,
+	&ScrollUpKey,
+	&ScrollDownKey,
+	&AppExitKey,
+	&HelpScreenKey,
+	&SystemScreenKey,
+	&PodsScreenKey,
+	&ContainersScreenKey,
+	&VolumesScreenKey,
+	&ImagesScreenKey,
+	&NetworksScreenKey,
+	&SecretsScreenKey,
 }
 
 type uiKeyInfo struct {
-	Key      tcell.Key
-	KeyRune  rune
-	KeyLabel string
-	KeyDesc  string
+	// KeyBinding is the key binding name in the configuration file.
+	KeyBinding string
+	Key        tcell.Key
+	KeyRune    rune
+	KeyLabel   string
+	KeyDesc    string
 }
 
 func (key *uiKeyInfo) Label() string {
@@ -200,3 +298,155 @@ func ParseKeyEventKey(event *tcell.EventKey) *tcell.EventKey {
 
 	return event
 }
+
+// SetKeyBindings overrides the default key bindings with the configuration
+// keys (key binding name to key name) and checks for conflicting bindings.
+// The key bindings are not changed if there is an error.
+func SetKeyBindings(keys map[string]string) error {
+	bindings := make([]uiKeyInfo, len(uiKeysBindingsRefs))
+	for i, ref := range uiKeysBindingsRefs {
+		bindings[i] = *ref
+	}
+
+	names := make([]string, 0, len(keys))
+	for name := range keys {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		index := -1
+
+		for i := range bindings {
+			if bindings[i].KeyBinding == name {
+				index = i
+
+				break
+			}
+		}
+
+		if index < 0 {
+			return fmt.Errorf("%w %q", ErrUnknownKeyBinding, name)
+		}
+
+		if err := bindings[index].setKey(keys[name]); err != nil {
+			return err
+		}
+	}
+
+	if err := checkKeyBindingsConflicts(bindings); err != nil {
+		return err
+	}
+
+	for i, ref := range uiKeysBindingsRefs {
+		*ref = bindings[i]
+	}
+
+	UIKeysBindings = keysBindings()
+
+	log.Debug().Msgf("utils: %d key bindings overridden by configuration", len(keys))
+
+	return nil
+}
+
+func keysBindings() []uiKeyInfo {
+	bindings := make([]uiKeyInfo, 0, len(uiKeysBindingsRefs))
+	for _, ref := range uiKeysBindingsRefs {
+		bindings = append(bindings, *ref)
+	}
+
+	return bindings
+}
+
+// setKey sets the binding key, character key bindings (i.e. menu key)
+// accept only a character and the others accept only a special key (i.e. F1).
+func (key *uiKeyInfo) setKey(name string) error {
+	eventKey, eventRune, label, err := parseKeyName(name)
+	if err != nil {
+		return fmt.Errorf("%w %q for %q", err, name, key.KeyBinding)
+	}
+
+	if key.KeyRune != 0 {
+		if eventRune == 0 {
+			return fmt.Errorf("%w %q for %q (requires a character key)", ErrInvalidKeyBinding, name, key.KeyBinding)
+		}
+
+		key.KeyRune = eventRune
+		key.KeyLabel = label
+
+		return nil
+	}
+
+	if eventRune != 0 {
+		return fmt.Errorf("%w %q for %q (requires a special key)", ErrInvalidKeyBinding, name, key.KeyBinding)
+	}
+
+	key.Key = eventKey
+	key.KeyLabel = label
+
+	return nil
+}
+
+// parseKeyName returns the event key, rune and label of the key name.
+// A key name is a character, "Space", "Ctrl+<letter>" or a special key
+// name (i.e. "F1", "Esc", "Delete", "PgUp", "Page Up").
+func parseKeyName(name string) (tcell.Key, rune, string, error) {
+	name = strings.TrimSpace(name)
+
+	if strings.EqualFold(name, "space") || name == " " {
+		return tcell.KeyRune, ' ', "Space", nil
+	}
+
+	if utf8.RuneCountInString(name) == 1 {
+		keyRune, _ := utf8.DecodeRuneInString(name)
+
+		return tcell.KeyRune, keyRune, name, nil
+	}
+
+	normalizedName := strings.ToLower(strings.NewReplacer(" ", "", "+", "-").Replace(name))
+
+	if letter, found := strings.CutPrefix(normalizedName, "ctrl-"); found && len(letter) == 1 {
+		if letter[0] >= 'a' && letter[0] <= 'z' {
+			return tcell.KeyCtrlA + tcell.Key(letter[0]-'a'), 0, "Ctrl+" + letter, nil
+		}
+	}
+
+	for key, keyName := range keyNameAliases {
+		if strings.ToLower(strings.ReplaceAll(keyName, " ", "")) == normalizedName {
+			return key, 0, name, nil
+		}
+	}
+
+	for key, keyName := range tcell.KeyNames {
+		if key == tcell.KeyRune || strings.HasPrefix(keyName, "Ctrl-") {
+			continue
+		}
+
+		if strings.ToLower(keyName) == normalizedName {
+			return key, 0, name, nil
+		}
+	}
+
+	return 0, 0, "", ErrInvalidKeyBinding
+}
+
+func checkKeyBindingsConflicts(bindings []uiKeyInfo) error {
+	used := make(map[string]string)
+
+	for _, binding := range bindings {
+		keyID := fmt.Sprintf("key:%d", binding.Key)
+		if binding.KeyRune != 0 {
+			keyID = fmt.Sprintf("rune:%c", binding.KeyRune)
+		}
+
+		if usedBy, ok := used[keyID]; ok {
+			return fmt.Errorf("%w: %q is used by %q and %q",
+				ErrKeyBindingConflict, binding.KeyLabel, usedBy, binding.KeyBinding)
+		}
+
+		used[keyID] = binding.KeyBinding
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"errors"
+
 	"github.com/gdamore/tcell/v2"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -56,4 +58,44 @@ var _ = Describe("keys", func() {
 		parsedKey = ParseKeyEventKey(enterEvent)
 		Expect(parsedKey.Key()).To(Equal(tcell.KeyEnter))
 	})
+
+	It("set key bindings", func() {
+		defer func() {
+			Expect(SetKeyBindings(map[string]string{
+				"next_screen": "l", "previous_screen": "h", "mark_all": "Ctrl+a", "help_screen": "F1",
+			})).To(Succeed())
+		}()
+
+		Expect(SetKeyBindings(nil)).To(Succeed())
+
+		err := SetKeyBindings(map[string]string{
+			"next_screen":     "n",
+			"previous_screen": "p",
+			"mark_all":        "ctrl+e",
+			"help_screen":     "F10",
+		})
+		Expect(err).To(BeNil())
+		Expect(NextScreenKey.Rune()).To(Equal('n'))
+		Expect(PreviousScreenKey.Label()).To(Equal("p"))
+		Expect(MarkAllKey.EventKey()).To(Equal(tcell.KeyCtrlE))
+		Expect(MarkAllKey.Label()).To(Equal("Ctrl+e"))
+		Expect(HelpScreenKey.EventKey()).To(Equal(tcell.KeyF10))
+		Expect(UIKeysBindings[1].KeyLabel).To(Equal("n"))
+
+		err = SetKeyBindings(map[string]string{"next_screen": "m"})
+		Expect(errors.Is(err, ErrKeyBindingConflict)).To(Equal(true))
+		Expect(NextScreenKey.Rune()).To(Equal('n'))
+
+		err = SetKeyBindings(map[string]string{"next_page": "n"})
+		Expect(errors.Is(err, ErrUnknownKeyBinding)).To(Equal(true))
+
+		err = SetKeyBindings(map[string]string{"next_screen": "F3"})
+		Expect(errors.Is(err, ErrInvalidKeyBinding)).To(Equal(true))
+
+		err = SetKeyBindings(map[string]string{"help_screen": "x"})
+		Expect(errors.Is(err, ErrInvalidKeyBinding)).To(Equal(true))
+
+		err = SetKeyBindings(map[string]string{"scroll_up": "Page Down"})
+		Expect(errors.Is(err, ErrKeyBindingConflict)).To(Equal(true))
+	})
 })
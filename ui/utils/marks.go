@@ -0,0 +1,338 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/rivo/tview"
+)
+
+const (
+	// markedItemsListMax max number of items listed in bulk command dialogs.
+	markedItemsListMax = 10
+)
+
+// MarkedItem is a table item marked for bulk commands.
+type MarkedItem struct {
+	ID   string
+	Name string
+	// Host is the item service connection name (views which list all connected services)
+	Host string
+}
+
+// MarkedItemKey returns the marked items key of the host item (the ID if the host is not set).
+func MarkedItemKey(host string, id string) string {
+	if host == "" {
+		return id
+	}
+
+	return host + "/" + id
+}
+
+func (item MarkedItem) key() string {
+	return MarkedItemKey(item.Host, item.ID)
+}
+
+// MarkedItems keeps the table items marked for bulk commands.
+type MarkedItems struct {
+	mu    sync.Mutex
+	items map[string]MarkedItem
+}
+
+// BulkCommandResult is the result of a bulk command for a single item.
+type BulkCommandResult struct {
+	Item MarkedItem
+	Err  error
+}
+
+// NewMarkedItems returns new marked items list.
+func NewMarkedItems() *MarkedItems {
+	return &MarkedItems{
+		items: make(map[string]MarkedItem),
+	}
+}
+
+// Toggle marks the item if it is not marked otherwise unmarks it.
+func (marks *MarkedItems) Toggle(id string, name string) {
+	marks.ToggleItem(MarkedItem{ID: id, Name: name})
+}
+
+// ToggleItem marks the (host) item if it is not marked otherwise unmarks it.
+func (marks *MarkedItems) ToggleItem(item MarkedItem) {
+	if item.ID == "" {
+		return
+	}
+
+	marks.mu.Lock()
+	defer marks.mu.Unlock()
+
+	key := item.key()
+	if _, ok := marks.items[key]; ok {
+		delete(marks.items, key)
+
+		return
+	}
+
+	marks.items[key] = item
+}
+
+// ToggleAll marks all the items if one of them is not marked otherwise unmarks all.
+func (marks *MarkedItems) ToggleAll(items []MarkedItem) {
+	marks.mu.Lock()
+	defer marks.mu.Unlock()
+
+	allMarked := true
+
+	for _, item := range items {
+		if _, ok := marks.items[item.key()]; !ok {
+			allMarked = false
+
+			break
+		}
+	}
+
+	if allMarked {
+		marks.items = make(map[string]MarkedItem)
+
+		return
+	}
+
+	for _, item := range items {
+		marks.items[item.key()] = item
+	}
+}
+
+// Mark marks the items which their ID or name matches the pattern and
+// returns number of matched items.
+func (marks *MarkedItems) Mark(items []MarkedItem, pattern string) (int, error) {
+	if strings.TrimSpace(pattern) == "" {
+		return 0, ErrEmptyMarkPattern
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return 0, err
+	}
+
+	marks.mu.Lock()
+	defer marks.mu.Unlock()
+
+	matched := 0
+
+	for _, item := range items {
+		if re.MatchString(item.ID) || re.MatchString(item.Name) {
+			marks.items[item.key()] = item
+			matched++
+		}
+	}
+
+	return matched, nil
+}
+
+// Unmark removes the item (marked items key) from the marked items.
+func (marks *MarkedItems) Unmark(key string) {
+	marks.mu.Lock()
+	delete(marks.items, key)
+	marks.mu.Unlock()
+}
+
+// IsMarked returns true if the item (marked items key) is marked.
+func (marks *MarkedItems) IsMarked(key string) bool {
+	marks.mu.Lock()
+	defer marks.mu.Unlock()
+
+	_, ok := marks.items[key]
+
+	return ok
+}
+
+// Count returns number of marked items.
+func (marks *MarkedItems) Count() int {
+	marks.mu.Lock()
+	defer marks.mu.Unlock()
+
+	return len(marks.items)
+}
+
+// Clear unmarks all items.
+func (marks *MarkedItems) Clear() {
+	marks.mu.Lock()
+	marks.items = make(map[string]MarkedItem)
+	marks.mu.Unlock()
+}
+
+// Items returns marked items sorted by their names.
+func (marks *MarkedItems) Items() []MarkedItem {
+	marks.mu.Lock()
+
+	items := make([]MarkedItem, 0, len(marks.items))
+	for _, item := range marks.items {
+		items = append(items, item)
+	}
+
+	marks.mu.Unlock()
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Name != items[j].Name {
+			return items[i].Name < items[j].Name
+		}
+
+		if items[i].ID != items[j].ID {
+			return items[i].ID < items[j].ID
+		}
+
+		return items[i].Host < items[j].Host
+	})
+
+	return items
+}
+
+// Retain unmarks the items which are not in the keys (see MarkedItemKey) list, the list
+// shall be the full data list of the view (not the filtered table rows).
+func (marks *MarkedItems) Retain(keys []string) {
+	existing := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		existing[key] = true
+	}
+
+	marks.mu.Lock()
+	defer marks.mu.Unlock()
+
+	for key := range marks.items {
+		if !existing[key] {
+			delete(marks.items, key)
+		}
+	}
+}
+
+// HighlightMarkedRows sets the background color of the table marked rows, the marked
+// items hidden by the table filter are kept.
+func (marks *MarkedItems) HighlightMarkedRows(table *tview.Table, idColIndex int) {
+	marks.HighlightHostMarkedRows(table, idColIndex, -1)
+}
+
+// HighlightHostMarkedRows sets the background color of the table marked rows of the
+// views which list all connected services items (hostColIndex is the host column).
+func (marks *MarkedItems) HighlightHostMarkedRows(table *tview.Table, idColIndex int, hostColIndex int) {
+	for row := 1; row < table.GetRowCount(); row++ {
+		host := ""
+		if hostColIndex >= 0 {
+			host = table.GetCell(row, hostColIndex).Text
+		}
+
+		marked := marks.IsMarked(MarkedItemKey(host, table.GetCell(row, idColIndex).Text))
+
+		for col := 0; col < table.GetColumnCount(); col++ {
+			cell := table.GetCell(row, col)
+			if marked {
+				cell.SetBackgroundColor(style.TableMarkedRowBgColor)
+
+				continue
+			}
+
+			cell.SetTransparency(true)
+		}
+	}
+}
+
+// BulkCommandConfirmMessage returns the confirm dialog message of the bulk command.
+func BulkCommandConfirmMessage(itemType string, cmd string, items []MarkedItem) string {
+	list := []string{}
+
+	for i, item := range items {
+		if i == markedItemsListMax {
+			list = append(list, fmt.Sprintf("... and %d more", len(items)-markedItemsListMax))
+
+			break
+		}
+
+		list = append(list, markedItemLabel(item))
+	}
+
+	if cmd == "rm" {
+		cmd = "remove"
+	}
+
+	bgColor := style.GetColorHex(style.DialogBorderColor)
+	fgColor := style.GetColorHex(style.DialogFgColor)
+	header := fmt.Sprintf("[%s:%s:b]MARKED %sS:[:-:-] %d", fgColor, bgColor, strings.ToUpper(itemType), len(items))
+
+	return fmt.Sprintf("%s\n%s\n\nAre you sure you want to %s the marked %ss ?",
+		header, tview.Escape(strings.Join(list, "\n")), cmd, itemType)
+}
+
+// RunBulkCommand runs the command for each marked item one after another,
+// unmarks the succeeded items and returns the results.
+func (marks *MarkedItems) RunBulkCommand(cmd func(id string) error) []BulkCommandResult {
+	return marks.RunHostBulkCommand(func(_ string, id string) error {
+		return cmd(id)
+	})
+}
+
+// RunHostBulkCommand runs the command for each marked item one after another on
+// the item service connection (host), unmarks the succeeded items and returns the results.
+func (marks *MarkedItems) RunHostBulkCommand(cmd func(host string, id string) error) []BulkCommandResult {
+	items := marks.Items()
+	results := make([]BulkCommandResult, 0, len(items))
+
+	for _, item := range items {
+		err := cmd(item.Host, item.ID)
+		if err == nil {
+			marks.Unmark(item.key())
+		}
+
+		results = append(results, BulkCommandResult{
+			Item: item,
+			Err:  err,
+		})
+	}
+
+	return results
+}
+
+// BulkCommandSummary returns the bulk command succeeded and failed items summary
+// and report text.
+func BulkCommandSummary(results []BulkCommandResult) (string, string) {
+	succeeded := []string{}
+	failed := []string{}
+
+	for _, result := range results {
+		if result.Err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", markedItemLabel(result.Item), result.Err))
+
+			continue
+		}
+
+		succeeded = append(succeeded, markedItemLabel(result.Item))
+	}
+
+	summary := fmt.Sprintf("%d succeeded, %d failed", len(succeeded), len(failed))
+	report := ""
+
+	if len(failed) > 0 {
+		report = fmt.Sprintf("[::b]FAILED:[::-]\n%s\n\n", tview.Escape(strings.Join(failed, "\n")))
+	}
+
+	if len(succeeded) > 0 {
+		report += fmt.Sprintf("[::b]SUCCEEDED:[::-]\n%s", tview.Escape(strings.Join(succeeded, "\n")))
+	}
+
+	return summary, report
+}
+
+func markedItemLabel(item MarkedItem) string {
+	label := item.ID
+	if item.Name != "" && item.Name != item.ID {
+		label = fmt.Sprintf("%s (%s)", item.ID, item.Name)
+	}
+
+	if item.Host != "" {
+		label = fmt.Sprintf("%s on %s", label, item.Host)
+	}
+
+	return label
+}
@@ -0,0 +1,159 @@
+package utils
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/containers/podman-tui/ui/style"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rivo/tview"
+)
+
+var _ = Describe("marked items", func() {
+	items := []MarkedItem{
+		{ID: "id01", Name: "web01"},
+		{ID: "id02", Name: "web02"},
+		{ID: "id03", Name: "db01"},
+	}
+
+	It("toggle", func() {
+		marks := NewMarkedItems()
+		marks.Toggle("id01", "web01")
+		Expect(marks.IsMarked("id01")).To(Equal(true))
+		Expect(marks.Count()).To(Equal(1))
+		marks.Toggle("id01", "web01")
+		Expect(marks.IsMarked("id01")).To(Equal(false))
+		marks.Toggle("", "")
+		Expect(marks.Count()).To(Equal(0))
+	})
+
+	It("toggle all", func() {
+		marks := NewMarkedItems()
+		marks.Toggle("id01", "web01")
+		marks.ToggleAll(items)
+		Expect(marks.Count()).To(Equal(3))
+		marks.ToggleAll(items)
+		Expect(marks.Count()).To(Equal(0))
+	})
+
+	It("mark by pattern", func() {
+		marks := NewMarkedItems()
+		matched, err := marks.Mark(items, "^web")
+		Expect(err).To(BeNil())
+		Expect(matched).To(Equal(2))
+		Expect(marks.IsMarked("id03")).To(Equal(false))
+
+		_, err = marks.Mark(items, "[")
+		Expect(err).NotTo(BeNil())
+
+		_, err = marks.Mark(items, " ")
+		Expect(err).To(Equal(ErrEmptyMarkPattern))
+	})
+
+	It("sorted items, retain and clear", func() {
+		marks := NewMarkedItems()
+		marks.ToggleAll(items)
+		Expect(marks.Items()).To(Equal([]MarkedItem{items[2], items[0], items[1]}))
+		marks.Retain([]string{"id01", "id03"})
+		Expect(marks.Items()).To(Equal([]MarkedItem{items[2], items[0]}))
+		marks.Clear()
+		Expect(marks.Count()).To(Equal(0))
+	})
+
+	It("highlight marked rows", func() {
+		table := tview.NewTable()
+		table.SetCell(0, 0, tview.NewTableCell("ID"))
+		table.SetCell(0, 1, tview.NewTableCell("NAME"))
+
+		for i, item := range items {
+			table.SetCell(i+1, 0, tview.NewTableCell(item.ID))
+			table.SetCell(i+1, 1, tview.NewTableCell(item.Name))
+		}
+
+		marks := NewMarkedItems()
+		marks.Toggle("id02", "web02")
+		marks.Toggle("id04", "web04")
+		marks.HighlightMarkedRows(table, 0)
+
+		// the marked items which are not in the table (e.g. filtered) are kept
+		Expect(marks.Count()).To(Equal(2))
+		Expect(table.GetCell(2, 1).BackgroundColor).To(Equal(style.TableMarkedRowBgColor))
+		Expect(table.GetCell(1, 1).Transparent).To(Equal(true))
+	})
+
+	It("run bulk command", func() {
+		errFailed := errors.New("failed")
+		marks := NewMarkedItems()
+		marks.ToggleAll(items)
+
+		results := marks.RunBulkCommand(func(id string) error {
+			if id == "id02" {
+				return errFailed
+			}
+
+			return nil
+		})
+
+		Expect(len(results)).To(Equal(3))
+		Expect(marks.Items()).To(Equal([]MarkedItem{items[1]}))
+
+		summary, report := BulkCommandSummary(results)
+		Expect(summary).To(Equal("2 succeeded, 1 failed"))
+		Expect(report).To(ContainSubstring("id02 (web02): failed"))
+		Expect(report).To(ContainSubstring("id03 (db01)\nid01 (web01)"))
+	})
+
+	It("bulk command confirm message", func() {
+		message := BulkCommandConfirmMessage("container", "rm", items)
+		Expect(message).To(ContainSubstring("MARKED CONTAINERS:[:-:-] 3"))
+		Expect(message).To(HaveSuffix("Are you sure you want to remove the marked containers ?"))
+
+		manyItems := []MarkedItem{}
+		for i := 0; i < markedItemsListMax+5; i++ {
+			manyItems = append(manyItems, MarkedItem{ID: strings.Repeat("a", i+1)})
+		}
+
+		message = BulkCommandConfirmMessage("pod", "stop", manyItems)
+		Expect(message).To(ContainSubstring("... and 5 more"))
+	})
+
+	It("host items", func() {
+		hostItems := []MarkedItem{
+			{ID: "id01", Name: "web01", Host: "host01"},
+			{ID: "id01", Name: "web01", Host: "host02"},
+		}
+
+		marks := NewMarkedItems()
+		marks.ToggleItem(hostItems[0])
+		Expect(marks.IsMarked(MarkedItemKey("host01", "id01"))).To(Equal(true))
+		Expect(marks.IsMarked(MarkedItemKey("host02", "id01"))).To(Equal(false))
+
+		table := tview.NewTable()
+		for i, item := range hostItems {
+			table.SetCell(i+1, 0, tview.NewTableCell(item.ID))
+			table.SetCell(i+1, 1, tview.NewTableCell(item.Host))
+		}
+
+		marks.HighlightHostMarkedRows(table, 0, 1)
+		Expect(table.GetCell(1, 0).BackgroundColor).To(Equal(style.TableMarkedRowBgColor))
+		Expect(table.GetCell(2, 0).Transparent).To(Equal(true))
+
+		marks.ToggleAll(hostItems)
+		Expect(marks.Count()).To(Equal(2))
+
+		hosts := []string{}
+		results := marks.RunHostBulkCommand(func(host string, id string) error {
+			hosts = append(hosts, host+"/"+id)
+
+			return nil
+		})
+
+		Expect(hosts).To(Equal([]string{"host01/id01", "host02/id01"}))
+		Expect(marks.Count()).To(Equal(0))
+
+		summary, report := BulkCommandSummary(results)
+		Expect(summary).To(Equal("2 succeeded, 0 failed"))
+		Expect(report).To(ContainSubstring("id01 (web01) on host02"))
+	})
+})
@@ -0,0 +1,132 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// NotificationTimeout default notification toast display timeout.
+	NotificationTimeout = 5 * time.Second
+	// NotificationTerminalOSC9 is the OSC 9 (iTerm2, kitty, Windows Terminal) terminal notification.
+	NotificationTerminalOSC9 = "osc9"
+	// NotificationTerminalOSC777 is the OSC 777 (urxvt, foot, VTE) terminal notification.
+	NotificationTerminalOSC777 = "osc777"
+	// NotificationOOMKilledAttribute is the event attribute set to "true" for the died events
+	// of the containers killed by the OOM killer (read from the container inspect data).
+	NotificationOOMKilledAttribute = "oomKilled"
+)
+
+var (
+	ErrInvalidNotificationRule     = errors.New("invalid notification rule")
+	ErrInvalidNotificationTerminal = errors.New("invalid notification terminal (should be osc9 or osc777)")
+)
+
+// NotificationRule is a podman event notification rule, an event matches the rule if
+// its type and action are the rule ones and it meets the rule exit code and health conditions.
+type NotificationRule struct {
+	// Name is the rule name displayed in the notification title
+	Name string `toml:"name"`
+	// Type is the event type (e.g. container, pod, image)
+	Type string `toml:"type"`
+	// Action is the event action (e.g. died, health_status)
+	Action string `toml:"action"`
+	// NonZeroExit matches only the events with a non-zero container exit code
+	NonZeroExit bool `toml:"non_zero_exit,omitempty"`
+	// OOMKilled matches only the died events of the containers killed by the OOM killer
+	// (podman has no oom event)
+	OOMKilled bool `toml:"oom_killed,omitempty"`
+	// HealthStatus matches only the events with the container health status (e.g. unhealthy)
+	HealthStatus string `toml:"health_status,omitempty"`
+}
+
+// DefaultNotificationRules are the notification rules used if no rule is configured
+// (an event is notified by its first matching rule).
+var DefaultNotificationRules = []NotificationRule{
+	{Name: "container OOM killed", Type: "container", Action: "died", OOMKilled: true},
+	{Name: "container died", Type: "container", Action: "died", NonZeroExit: true},
+	{Name: "container unhealthy", Type: "container", Action: "health_status", HealthStatus: "unhealthy"},
+}
+
+// Validate returns error if the rule has an empty name, type or action.
+func (rule NotificationRule) Validate() error {
+	if rule.Name == "" || rule.Type == "" || rule.Action == "" {
+		return fmt.Errorf("%w %q (name, type and action are required)", ErrInvalidNotificationRule, rule.Name)
+	}
+
+	return nil
+}
+
+// Match returns true if the event type, action, health status and attributes match the rule.
+func (rule NotificationRule) Match(evtType, action, healthStatus string, attributes map[string]string) bool {
+	if evtType != rule.Type || action != rule.Action {
+		return false
+	}
+
+	if rule.NonZeroExit {
+		exitCode := attributes["containerExitCode"]
+		if exitCode == "" || exitCode == "0" {
+			return false
+		}
+	}
+
+	if rule.OOMKilled && attributes[NotificationOOMKilledAttribute] != "true" {
+		return false
+	}
+
+	if rule.HealthStatus != "" && healthStatus != rule.HealthStatus {
+		return false
+	}
+
+	return true
+}
+
+// ValidateNotificationTerminal returns error if the terminal notification is not empty, osc9 or osc777.
+func ValidateNotificationTerminal(terminal string) error {
+	switch terminal {
+	case "", NotificationTerminalOSC9, NotificationTerminalOSC777:
+		return nil
+	}
+
+	return fmt.Errorf("%w %q", ErrInvalidNotificationTerminal, terminal)
+}
+
+// TerminalNotify emits the OSC 9 or OSC 777 desktop notification of the terminal (if terminal
+// is not empty). The writer shall be the screen terminal (see TerminalWriter) and it shall be
+// called from the user interface goroutine to not interleave with the screen updates.
+func TerminalNotify(writer io.Writer, title string, body string, terminal string) error {
+	return writeNotification(writer, title, body, terminal, os.Getenv("TMUX") != "")
+}
+
+// writeNotification writes the terminal notification sequence, the sequence is wrapped
+// in a tmux passthrough sequence if tmux is set.
+func writeNotification(writer io.Writer, title string, body string, terminal string, tmux bool) error {
+	// the control characters and the OSC 777 field separator are removed from the texts
+	sanitize := strings.NewReplacer("\x1b", "", "\a", "", "\n", " ", ";", ",")
+	title = sanitize.Replace(title)
+	body = sanitize.Replace(body)
+
+	var sequence string
+
+	switch terminal {
+	case NotificationTerminalOSC9:
+		sequence = fmt.Sprintf("\x1b]9;%s: %s\a", title, body)
+	case NotificationTerminalOSC777:
+		sequence = fmt.Sprintf("\x1b]777;notify;%s;%s\a", title, body)
+	default:
+		return nil
+	}
+
+	if tmux {
+		sequence = "\x1bPtmux;" + strings.ReplaceAll(sequence, "\x1b", "\x1b\x1b") + "\x1b\\"
+	}
+
+	_, err := io.WriteString(writer, sequence)
+
+	return err
+}
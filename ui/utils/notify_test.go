@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("notify", func() {
+	It("match notification rules", func() {
+		oom := DefaultNotificationRules[0]
+		died := DefaultNotificationRules[1]
+		unhealthy := DefaultNotificationRules[2]
+
+		Expect(died.Match("container", "died", "", map[string]string{"containerExitCode": "137"})).To(BeTrue())
+		Expect(died.Match("container", "died", "", map[string]string{"containerExitCode": "0"})).To(BeFalse())
+		Expect(died.Match("container", "died", "", nil)).To(BeFalse())
+		Expect(died.Match("pod", "died", "", map[string]string{"containerExitCode": "1"})).To(BeFalse())
+		Expect(unhealthy.Match("container", "health_status", "unhealthy", nil)).To(BeTrue())
+		Expect(unhealthy.Match("container", "health_status", "healthy", nil)).To(BeFalse())
+		Expect(oom.Match("container", "died", "", map[string]string{
+			"containerExitCode": "137", NotificationOOMKilledAttribute: "true",
+		})).To(BeTrue())
+		Expect(oom.Match("container", "died", "", map[string]string{"containerExitCode": "137"})).To(BeFalse())
+		Expect(oom.Match("container", "oom", "", nil)).To(BeFalse())
+	})
+
+	It("validate notification rule", func() {
+		Expect(DefaultNotificationRules[0].Validate()).To(BeNil())
+		Expect(NotificationRule{Name: "no action", Type: "container"}.Validate()).
+			To(MatchError(ErrInvalidNotificationRule))
+	})
+
+	It("validate notification terminal", func() {
+		Expect(ValidateNotificationTerminal("")).To(BeNil())
+		Expect(ValidateNotificationTerminal(NotificationTerminalOSC9)).To(BeNil())
+		Expect(ValidateNotificationTerminal(NotificationTerminalOSC777)).To(BeNil())
+		Expect(ValidateNotificationTerminal("osc99")).To(MatchError(ErrInvalidNotificationTerminal))
+	})
+
+	It("write notification sequences", func() {
+		var writer strings.Builder
+
+		Expect(writeNotification(&writer, "died", "web", "", false)).To(BeNil())
+		Expect(writer.String()).To(Equal(""))
+
+		writer.Reset()
+		Expect(writeNotification(&writer, "died", "web", NotificationTerminalOSC9, false)).To(BeNil())
+		Expect(writer.String()).To(Equal("\x1b]9;died: web\a"))
+
+		writer.Reset()
+		Expect(writeNotification(&writer, "died", "web;exit\x1b", NotificationTerminalOSC777, false)).To(BeNil())
+		Expect(writer.String()).To(Equal("\x1b]777;notify;died;web,exit\a"))
+	})
+
+	It("write notification tmux passthrough sequence", func() {
+		var writer strings.Builder
+
+		Expect(writeNotification(&writer, "died", "web", NotificationTerminalOSC9, true)).To(BeNil())
+		Expect(writer.String()).To(Equal("\x1bPtmux;\x1b\x1b]9;died: web\a\x1b\\"))
+	})
+})
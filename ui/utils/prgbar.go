@@ -34,15 +34,15 @@ func getBarColor(value int) string {
 
 	switch {
 	case value < prgWarn:
-		barColor = style.GetColorName(style.PrgBarOKColor)
+		barColor = style.GetColorHex(style.PrgBarOKColor)
 	case value < prgCrit:
-		barColor = style.GetColorName(style.PrgBarWarnColor)
+		barColor = style.GetColorHex(style.PrgBarWarnColor)
 	default:
-		barColor = style.GetColorName(style.PrgBarCritColor)
+		barColor = style.GetColorHex(style.PrgBarCritColor)
 	}
 
 	barCell = fmt.Sprintf("[%s::]%s[%s::]",
-		barColor, style.ProgressBarCell, style.GetColorName(style.PrgBarEmptyColor))
+		barColor, style.ProgressBarCell, style.GetColorHex(style.PrgBarEmptyColor))
 
 	return barCell
 }
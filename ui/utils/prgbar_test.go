@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"github.com/containers/podman-tui/ui/style"
+	"github.com/gdamore/tcell/v2"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -12,9 +14,9 @@ var _ = Describe("prgbar", func() {
 			value       int
 			expectedBar string
 		}{
-			{value: 0, expectedBar: "[green::]▉[white::]"},
-			{value: 16, expectedBar: "[orange::]▉[white::]"},
-			{value: 18, expectedBar: "[red::]▉[white::]"},
+			{value: 0, expectedBar: "[#008000::]▉[#ffffff::]"},
+			{value: 16, expectedBar: "[#ffa500::]▉[#ffffff::]"},
+			{value: 18, expectedBar: "[#ff0000::]▉[#ffffff::]"},
 		}
 
 		for _, tt := range tests {
@@ -22,13 +24,24 @@ var _ = Describe("prgbar", func() {
 		}
 	})
 
+	It("bar theme hex color", func() {
+		okColor := style.PrgBarOKColor
+		defer func() {
+			style.PrgBarOKColor = okColor
+		}()
+
+		style.PrgBarOKColor = tcell.GetColor("#00a0b4")
+
+		Expect(getBarColor(0)).To(Equal("[#00a0b4::]▉[#ffffff::]"))
+	})
+
 	It("progress usage string", func() {
 		tests := []struct {
 			percntage          float64
 			epectedUsageString string
 		}{
 			{percntage: 0.0, epectedUsageString: "▉▉▉▉▉▉▉▉▉▉▉▉▉▉▉▉▉▉▉▉  0.00%"},
-			{percntage: 10.0, epectedUsageString: "[green::]▉[white::][green::]▉[white::]▉▉▉▉▉▉▉▉▉▉▉▉▉▉▉▉▉▉ 10.00%"},
+			{percntage: 10.0, epectedUsageString: "[#008000::]▉[#ffffff::][#008000::]▉[#ffffff::]▉▉▉▉▉▉▉▉▉▉▉▉▉▉▉▉▉▉ 10.00%"},
 		}
 
 		for _, tt := range tests {
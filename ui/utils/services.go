@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	"github.com/rs/zerolog/log"
+)
+
+// ListConnectedServices calls the list function for the active connection and
+// the other connected services concurrently and returns the lists by service
+// connection name. The active connection list error is returned and the other
+// services list errors are logged.
+func ListConnectedServices[T any](list func(name string) ([]T, error)) (map[string][]T, error) {
+	var (
+		mu        sync.Mutex
+		wg        sync.WaitGroup
+		activeErr error
+	)
+
+	activeName := registry.ConnectionName()
+	lists := make(map[string][]T)
+
+	for _, conn := range registry.Connections() {
+		if conn.Name != activeName && conn.Status != registry.ConnectionStatusConnected {
+			continue
+		}
+
+		name := conn.Name
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			items, err := list(name)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				if name == activeName {
+					activeErr = err
+				}
+
+				log.Error().Msgf("view: %s list %v", name, err)
+
+				return
+			}
+
+			lists[name] = items
+		}()
+	}
+
+	wg.Wait()
+
+	return lists, activeErr
+}
+
+// SortedKeys returns the service connection names of the lists in sorted order.
+func SortedKeys[T any](lists map[string][]T) []string {
+	keys := make([]string, 0, len(lists))
+	for key := range lists {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"errors"
+
+	"github.com/containers/podman-tui/pdcs/registry"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("connected services", func() {
+	It("list connected services", func() {
+		registry.SetConnection(registry.Connection{Name: "node01", URI: "unix://node01.sock"})
+		registry.AddConnection(registry.Connection{Name: "node02", URI: "unix://node02.sock"})
+		registry.AddConnection(registry.Connection{Name: "node03", URI: "unix://node03.sock"})
+		registry.SetConnectionStatusByName("node02", registry.ConnectionStatusConnected)
+
+		defer func() {
+			registry.RemoveConnection("node01")
+			registry.RemoveConnection("node02")
+			registry.RemoveConnection("node03")
+		}()
+
+		lists, err := ListConnectedServices(func(name string) ([]string, error) {
+			return []string{name + "-item"}, nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lists).To(Equal(map[string][]string{
+			"node01": {"node01-item"},
+			"node02": {"node02-item"},
+		}))
+
+		listErr := errors.New("list error")
+
+		lists, err = ListConnectedServices(func(name string) ([]string, error) {
+			if name == "node01" {
+				return nil, listErr
+			}
+
+			return []string{name + "-item"}, nil
+		})
+		Expect(err).To(Equal(listErr))
+		Expect(lists).To(Equal(map[string][]string{"node02": {"node02-item"}}))
+		Expect(SortedKeys(map[string][]string{"node02": nil, "node01": nil})).To(Equal([]string{"node01", "node02"}))
+	})
+})
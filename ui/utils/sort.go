@@ -0,0 +1,90 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+const (
+	sortAscendingIndicator  = "\u25B2"
+	sortDescendingIndicator = "\u25BC"
+)
+
+// TableSort keeps the resource table sort column and order.
+type TableSort struct {
+	mu         sync.Mutex
+	columns    int
+	column     int
+	descending bool
+}
+
+// NewTableSort returns new table sort for number of columns which sorts the
+// table by the default column index in ascending order.
+func NewTableSort(columns int, defaultColumn int) *TableSort {
+	return &TableSort{
+		columns: columns,
+		column:  defaultColumn,
+	}
+}
+
+// Next switches the sort column to the next column in ascending order.
+func (ts *TableSort) Next() {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if ts.columns == 0 {
+		return
+	}
+
+	ts.column = (ts.column + 1) % ts.columns
+	ts.descending = false
+}
+
+// Reverse reverses the sort order.
+func (ts *TableSort) Reverse() {
+	ts.mu.Lock()
+	ts.descending = !ts.descending
+	ts.mu.Unlock()
+}
+
+// Column returns the sort column index.
+func (ts *TableSort) Column() int {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	return ts.column
+}
+
+// Descending returns true if the sort order is descending.
+func (ts *TableSort) Descending() bool {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	return ts.descending
+}
+
+// Less returns true if the item with the compare result (-1, 0 or +1)
+// shall be sorted before the other item.
+func (ts *TableSort) Less(result int) bool {
+	if ts.Descending() {
+		return result > 0
+	}
+
+	return result < 0
+}
+
+// HeaderLabel returns the table column header label with the sort indicator.
+func (ts *TableSort) HeaderLabel(column int, header string) string {
+	label := "[::b]" + strings.ToUpper(header)
+	if column != ts.Column() {
+		return label
+	}
+
+	indicator := sortAscendingIndicator
+	if ts.Descending() {
+		indicator = sortDescendingIndicator
+	}
+
+	return fmt.Sprintf("%s %s", label, indicator)
+}
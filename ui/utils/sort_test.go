@@ -0,0 +1,37 @@
+package utils
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("table sort", func() {
+	It("switch sort column", func() {
+		tableSort := NewTableSort(3, 1)
+		Expect(tableSort.Column()).To(Equal(1))
+		tableSort.Reverse()
+		Expect(tableSort.Descending()).To(Equal(true))
+		tableSort.Next()
+		Expect(tableSort.Column()).To(Equal(2))
+		Expect(tableSort.Descending()).To(Equal(false))
+		tableSort.Next()
+		Expect(tableSort.Column()).To(Equal(0))
+	})
+
+	It("sort order", func() {
+		tableSort := NewTableSort(3, 0)
+		Expect(tableSort.Less(-1)).To(Equal(true))
+		Expect(tableSort.Less(0)).To(Equal(false))
+		tableSort.Reverse()
+		Expect(tableSort.Less(1)).To(Equal(true))
+		Expect(tableSort.Less(-1)).To(Equal(false))
+	})
+
+	It("header label", func() {
+		tableSort := NewTableSort(3, 1)
+		Expect(tableSort.HeaderLabel(0, "id")).To(Equal("[::b]ID"))
+		Expect(tableSort.HeaderLabel(1, "name")).To(Equal("[::b]NAME " + sortAscendingIndicator))
+		tableSort.Reverse()
+		Expect(tableSort.HeaderLabel(1, "name")).To(Equal("[::b]NAME " + sortDescendingIndicator))
+	})
+})
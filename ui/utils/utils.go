@@ -3,6 +3,7 @@ package utils
 import (
 	"fmt"
 	"net/url"
+	"slices"
 	"strings"
 	"time"
 
@@ -14,16 +15,33 @@ import (
 const (
 	// IDLength max ID length to display.
 	IDLength = 12
-	// RefreshInterval application refresh interval.
+	// RefreshInterval default application refresh interval.
 	RefreshInterval = 1000 * time.Millisecond
-	idLimit         = 12
+	// SecretRevealTimeout default revealed secret value display timeout.
+	SecretRevealTimeout = 10 * time.Second
+	idLimit             = 12
+	// EventActionRemove is the podman event action of a removed resource.
+	EventActionRemove = "remove"
+	// EventActionHealthStatus is the podman event action of a container healthcheck run.
+	EventActionHealthStatus = "health_status"
 )
 
 var (
-	ErrURLMissingScheme = errors.New("url missing scheme")
-	ErrInvalidFilename  = errors.New("invalid filename (should not contain ':')")
+	ErrURLMissingScheme   = errors.New("url missing scheme")
+	ErrInvalidFilename    = errors.New("invalid filename (should not contain ':')")
+	ErrEmptyMarkPattern   = errors.New("empty mark pattern")
+	ErrUnknownKeyBinding  = errors.New("unknown key binding")
+	ErrInvalidKeyBinding  = errors.New("invalid key")
+	ErrKeyBindingConflict = errors.New("key binding conflict")
+	ErrInvalidChange      = errors.New("invalid change instruction")
 )
 
+// ChangeInstructions is the list of the Dockerfile instructions supported by
+// the image import and container commit change option.
+var ChangeInstructions = []string{
+	"CMD", "ENTRYPOINT", "ENV", "EXPOSE", "LABEL", "ONBUILD", "STOPSIGNAL", "USER", "VOLUME", "WORKDIR",
+}
+
 // GetIDWithLimit return ID string with limited string characters.
 func GetIDWithLimit(id string) string {
 	if len(id) > 0 {
@@ -62,6 +80,58 @@ func AlignStringListWidth(list []string) ([]string, int) {
 	return alignedList, max
 }
 
+// ParseChangeInstructions returns the list of Dockerfile change instructions separated by ";"
+// (e.g. CMD ["/bin/sh"]; ENV KEY=VALUE; LABEL key=value), the instruction names are upper cased.
+// A ";" which isn't followed by an instruction name is part of the instruction value
+// (e.g. CMD /bin/sh -c 'a; b').
+func ParseChangeInstructions(changes string) ([]string, error) {
+	var (
+		instructions []string
+		current      string
+	)
+
+	addInstruction := func() error {
+		change := strings.TrimRight(strings.TrimSpace(current), "; ")
+		if change == "" {
+			return nil
+		}
+
+		instruction, value, _ := strings.Cut(change, " ")
+		value = strings.TrimSpace(value)
+
+		if value == "" {
+			return fmt.Errorf("%w %q", ErrInvalidChange, change)
+		}
+
+		instructions = append(instructions, strings.ToUpper(instruction)+" "+value)
+
+		return nil
+	}
+
+	for _, change := range strings.Split(changes, ";") {
+		instruction, _, _ := strings.Cut(strings.TrimSpace(change), " ")
+
+		switch {
+		case slices.Contains(ChangeInstructions, strings.ToUpper(instruction)):
+			if err := addInstruction(); err != nil {
+				return nil, err
+			}
+
+			current = change
+		case current != "":
+			current += ";" + change
+		case strings.TrimSpace(change) != "":
+			return nil, fmt.Errorf("%w %q", ErrInvalidChange, strings.TrimSpace(change))
+		}
+	}
+
+	if err := addInstruction(); err != nil {
+		return nil, err
+	}
+
+	return instructions, nil
+}
+
 // EmptyBoxSpace returns simple Box without border with bgColor as background.
 func EmptyBoxSpace(bgColor tcell.Color) *tview.Box {
 	box := tview.NewBox()
@@ -13,7 +13,7 @@ var _ = Describe("utils", func() {
 		validColor01 := tcell.ColorRed
 		validC0lor01Wants := "#ff0000"
 		validColor02 := tcell.ColorBlue
-		validColor02Wants := "#ff"
+		validColor02Wants := "#0000ff"
 		invalidColor03 := tcell.Color100
 		invalidcolor03Wants := "#878700"
 		Expect(style.GetColorHex(validColor01)).To(Equal(validC0lor01Wants))
@@ -89,4 +89,35 @@ var _ = Describe("utils", func() {
 			}
 		}
 	})
+	It("parse change instructions", func() {
+		changes, err := ParseChangeInstructions(`cmd ["/bin/sh", "-c", "sleep 60"]; ENV KEY=VALUE;; LABEL debug=true `)
+		Expect(err).To(BeNil())
+		Expect(changes).To(Equal([]string{
+			`CMD ["/bin/sh", "-c", "sleep 60"]`,
+			"ENV KEY=VALUE",
+			"LABEL debug=true",
+		}))
+
+		changes, err = ParseChangeInstructions(" ")
+		Expect(err).To(BeNil())
+		Expect(changes).To(BeNil())
+
+		_, err = ParseChangeInstructions("RUN apt update")
+		Expect(err).To(MatchError(ErrInvalidChange))
+
+		_, err = ParseChangeInstructions("CMD")
+		Expect(err).To(MatchError(ErrInvalidChange))
+
+		_, err = ParseChangeInstructions("ENV X=a; CMD ")
+		Expect(err).To(MatchError(ErrInvalidChange))
+	})
+	It("parse change instructions with separator in value", func() {
+		changes, err := ParseChangeInstructions(`CMD /bin/sh -c 'a; b'; ENV X=a;b;user app`)
+		Expect(err).To(BeNil())
+		Expect(changes).To(Equal([]string{
+			`CMD /bin/sh -c 'a; b'`,
+			"ENV X=a;b",
+			"USER app",
+		}))
+	})
 })
@@ -3,11 +3,14 @@ package volumes
 import (
 	"errors"
 	"fmt"
+	"os"
 	"strings"
 
+	"github.com/containers/podman-tui/pdcs/kube"
 	"github.com/containers/podman-tui/pdcs/volumes"
 	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
 	"github.com/rs/zerolog/log"
 )
 
@@ -17,6 +20,8 @@ func (vols *Volumes) runCommand(cmd string) {
 	switch cmd {
 	case "create":
 		vols.createDialog.Display()
+	case "generate kube":
+		vols.generateKube()
 	case "inspect":
 		vols.inspect()
 	case "prune": //nolint:goconst
@@ -69,6 +74,43 @@ func (vols *Volumes) inspect() {
 	vols.messageDialog.Display()
 }
 
+func (vols *Volumes) generateKube() {
+	volID := vols.getSelectedItem()
+	if volID == "" {
+		vols.displayError("", errNoVolume)
+
+		return
+	}
+
+	data, err := kube.Generate([]string{volID})
+	if err != nil {
+		title := fmt.Sprintf("volume (%s) generate kube error", volID)
+		vols.displayError(title, err)
+
+		return
+	}
+
+	vols.kubeDialog.SetKubeYAML(dialogs.MessageVolumeInfo, volID, data)
+	vols.kubeDialog.Display()
+}
+
+func (vols *Volumes) saveKube() {
+	path, err := vols.kubeDialog.GetOutputPath()
+	if err != nil {
+		vols.displayError("generate kube save error", err)
+
+		return
+	}
+
+	if err := os.WriteFile(path, []byte(vols.kubeDialog.GetKubeYAML()), 0o644); err != nil { //nolint:gosec,gomnd
+		vols.displayError("generate kube save error", err)
+
+		return
+	}
+
+	vols.kubeDialog.Hide()
+}
+
 func (vols *Volumes) prunePrep() {
 	vols.confirmDialog.SetTitle("podman volume prune")
 	vols.confirmData = "prune"
@@ -102,6 +144,12 @@ func (vols *Volumes) prune() {
 }
 
 func (vols *Volumes) removePrep() {
+	if vols.markedItems.Count() > 0 {
+		vols.bulkCommandPrep("rm")
+
+		return
+	}
+
 	volID := vols.getSelectedItem()
 	if volID == "" {
 		vols.displayError("", errNoVolume)
@@ -148,3 +196,74 @@ func (vols *Volumes) remove() {
 
 	go remove(volID)
 }
+
+func (vols *Volumes) markItem() {
+	volID := vols.getSelectedItem()
+	if volID == "" {
+		return
+	}
+
+	vols.markedItems.Toggle(volID, volID)
+
+	row, _ := vols.table.GetSelection()
+	if row+1 < vols.table.GetRowCount() {
+		vols.table.Select(row+1, 0)
+	}
+}
+
+func (vols *Volumes) markPatternPrep() {
+	vols.cmdInputDialog.SetTitle("mark volumes")
+	vols.cmdInputDialog.SetDescription("mark the volumes which their name matches the regular expression")
+	vols.cmdInputDialog.SetSelectButtonLabel("mark")
+	vols.cmdInputDialog.SetLabel("pattern ")
+
+	vols.cmdInputDialog.SetSelectedFunc(func() {
+		pattern := vols.cmdInputDialog.GetInputText()
+		vols.cmdInputDialog.Hide()
+
+		if _, err := vols.markedItems.Mark(vols.getItems(), pattern); err != nil {
+			vols.displayError("VOLUME MARK ERROR", err)
+		}
+	})
+
+	vols.cmdInputDialog.Display()
+}
+
+func (vols *Volumes) bulkCommandPrep(cmd string) {
+	vols.confirmDialog.SetTitle("podman volume " + cmd)
+	vols.confirmData = "bulk"
+	vols.bulkCmd = cmd
+
+	vols.confirmDialog.SetText(utils.BulkCommandConfirmMessage("volume", cmd, vols.markedItems.Items()))
+	vols.confirmDialog.Display()
+}
+
+func (vols *Volumes) bulkCommand() {
+	var cmdFunc func(id string) error
+
+	cmd := vols.bulkCmd
+
+	switch cmd {
+	case "rm":
+		cmdFunc = volumes.Remove
+	default:
+		return
+	}
+
+	vols.progressDialog.SetTitle(fmt.Sprintf("volume %s in progress", cmd))
+	vols.progressDialog.Display()
+
+	bulk := func() {
+		results := vols.markedItems.RunBulkCommand(cmdFunc)
+
+		vols.progressDialog.Hide()
+
+		summary, report := utils.BulkCommandSummary(results)
+
+		vols.messageDialog.SetTitle("podman volume " + cmd)
+		vols.messageDialog.SetText(dialogs.MessageBulkCommandInfo, summary, report)
+		vols.messageDialog.Display()
+	}
+
+	go bulk()
+}
@@ -2,6 +2,7 @@ package volumes
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/containers/podman-tui/pdcs/volumes"
@@ -27,14 +28,35 @@ func (vols *Volumes) UpdateData() {
 	vols.volumeList.mu.Unlock()
 }
 
+// getData returns a copy of the list data sorted by the table sort column and order.
 func (vols *Volumes) getData() []*entities.VolumeListReport {
 	vols.volumeList.mu.Lock()
-	data := vols.volumeList.report
+	data := make([]*entities.VolumeListReport, len(vols.volumeList.report))
+	copy(data, vols.volumeList.report)
 	vols.volumeList.mu.Unlock()
 
+	column := vols.tableSort.Column()
+
+	sort.SliceStable(data, func(i, j int) bool {
+		return vols.tableSort.Less(compareVolumes(column, data[i], data[j]))
+	})
+
 	return data
 }
 
+func compareVolumes(column int, a, b *entities.VolumeListReport) int {
+	switch column {
+	case volsTableDriverColIndex:
+		return strings.Compare(a.Driver, b.Driver)
+	case volsTableCreatedAtColIndex:
+		return a.CreatedAt.Compare(b.CreatedAt)
+	case volsTableMountPointColIndex:
+		return strings.Compare(a.Mountpoint, b.Mountpoint)
+	default:
+		return strings.Compare(a.Name, b.Name)
+	}
+}
+
 // ClearData clears table data.
 func (vols *Volumes) ClearData() {
 	vols.volumeList.mu.Lock()
@@ -49,12 +71,12 @@ func (vols *Volumes) ClearData() {
 
 	for i := 0; i < len(vols.headers); i++ {
 		vols.table.SetCell(0, i,
-			tview.NewTableCell(fmt.Sprintf("[::b]%s", strings.ToUpper(vols.headers[i]))). //nolint:perfsprint
-													SetExpansion(expand).
-													SetBackgroundColor(bgColor).
-													SetTextColor(fgColor).
-													SetAlign(tview.AlignLeft).
-													SetSelectable(false))
+			tview.NewTableCell(vols.tableSort.HeaderLabel(i, vols.headers[i])).
+				SetExpansion(expand).
+				SetBackgroundColor(bgColor).
+				SetTextColor(fgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
 	}
 
 	vols.table.SetTitle(fmt.Sprintf("[::b]%s[0]", strings.ToUpper(vols.title)))
@@ -1,6 +1,7 @@
 package volumes
 
 import (
+	"github.com/containers/podman-tui/ui/dialogs"
 	"github.com/gdamore/tcell/v2"
 )
 
@@ -12,6 +13,12 @@ func (vols *Volumes) Draw(screen tcell.Screen) {
 
 	x, y, width, height := vols.GetInnerRect()
 
+	if vols.filterBar.IsVisible() {
+		height -= dialogs.FilterBarHeight
+		vols.filterBar.SetRect(x, y+height, width, dialogs.FilterBarHeight)
+		vols.filterBar.Draw(screen)
+	}
+
 	vols.table.SetRect(x, y, width, height)
 	vols.table.SetBorder(true)
 
@@ -24,6 +24,12 @@ func (vols *Volumes) InputHandler() func(event *tcell.EventKey, setFocus func(p
 			}
 		}
 
+		if vols.filterBar.HasFocus() {
+			if handler := vols.filterBar.InputHandler(); handler != nil {
+				handler(event, setFocus)
+			}
+		}
+
 		// table handlers
 		if vols.table.HasFocus() {
 			vols.processTableInputHandler(event, setFocus)
@@ -50,6 +56,42 @@ func (vols *Volumes) processTableInputHandler(event *tcell.EventKey, setFocus fu
 		return
 	}
 
+	if event.Rune() == utils.MarkItemKey.Rune() {
+		vols.markItem()
+
+		return
+	}
+
+	if event.Key() == utils.MarkAllKey.EventKey() {
+		vols.markedItems.ToggleAll(vols.getItems())
+
+		return
+	}
+
+	if event.Rune() == utils.MarkPatternKey.Rune() {
+		vols.markPatternPrep()
+
+		return
+	}
+
+	if event.Rune() == utils.FilterKey.Rune() {
+		vols.filterBar.Display()
+
+		return
+	}
+
+	if event.Rune() == utils.SortColumnKey.Rune() {
+		vols.tableSort.Next()
+
+		return
+	}
+
+	if event.Rune() == utils.SortOrderKey.Rune() {
+		vols.tableSort.Reverse()
+
+		return
+	}
+
 	if tableHandler := vols.table.InputHandler(); tableHandler != nil {
 		tableHandler(event, setFocus)
 	}
@@ -1,11 +1,10 @@
 package volumes
 
 import (
-	"fmt"
-	"strings"
 	"time"
 
 	"github.com/containers/podman-tui/ui/style"
+	"github.com/containers/podman-tui/ui/utils"
 	"github.com/docker/go-units"
 	"github.com/rivo/tview"
 )
@@ -25,18 +24,19 @@ func (vols *Volumes) refresh() {
 
 	for i := 0; i < len(vols.headers); i++ {
 		vols.table.SetCell(0, i,
-			tview.NewTableCell(fmt.Sprintf("[::b]%s", strings.ToUpper(vols.headers[i]))). //nolint:perfsprint
-													SetExpansion(expand).
-													SetBackgroundColor(style.PageHeaderBgColor).
-													SetTextColor(style.PageHeaderFgColor).
-													SetAlign(tview.AlignLeft).
-													SetSelectable(false))
+			tview.NewTableCell(vols.tableSort.HeaderLabel(i, vols.headers[i])).
+				SetExpansion(expand).
+				SetBackgroundColor(style.PageHeaderBgColor).
+				SetTextColor(style.PageHeaderFgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
 	}
 
 	rowIndex := 1
 	volList := vols.getData()
+	filter := vols.filterBar.GetFilter()
 
-	vols.table.SetTitle(fmt.Sprintf("[::b]%s[%d]", strings.ToUpper(vols.title), len(volList)))
+	markIDs := make([]string, 0, len(volList))
 
 	for i := 0; i < len(volList); i++ {
 		volDriver := volList[i].Driver
@@ -44,6 +44,12 @@ func (vols *Volumes) refresh() {
 		volCreatedAt := units.HumanDuration(time.Since(volList[i].CreatedAt)) + " ago"
 		volMountPoint := volList[i].Mountpoint
 
+		markIDs = append(markIDs, volName)
+
+		if !utils.MatchFilter(filter, volList[i].Labels, volName, volDriver, volMountPoint) {
+			continue
+		}
+
 		// driver name column
 		vols.table.SetCell(rowIndex, volsTableDriverColIndex,
 			tview.NewTableCell(volDriver).
@@ -70,4 +76,8 @@ func (vols *Volumes) refresh() {
 
 		rowIndex++
 	}
+
+	vols.markedItems.Retain(markIDs)
+	vols.markedItems.HighlightMarkedRows(vols.table, volsTableNameColIndex)
+	vols.table.SetTitle(utils.TableTitle(vols.title, len(volList), rowIndex-1, filter, vols.markedItems.Count()))
 }
@@ -23,10 +23,16 @@ type Volumes struct {
 	progressDialog *dialogs.ProgressDialog
 	confirmDialog  *dialogs.ConfirmDialog
 	cmdDialog      *dialogs.CommandDialog
+	cmdInputDialog *dialogs.SimpleInputDialog
 	messageDialog  *dialogs.MessageDialog
 	createDialog   *voldialogs.VolumeCreateDialog
+	kubeDialog     *dialogs.KubeGenerateDialog
+	filterBar      *dialogs.FilterBar
 	volumeList     volListReport
+	markedItems    *utils.MarkedItems
+	tableSort      *utils.TableSort
 	confirmData    string
+	bulkCmd        string
 }
 
 type volListReport struct {
@@ -43,10 +49,16 @@ func NewVolumes() *Volumes {
 		errorDialog:    dialogs.NewErrorDialog(),
 		progressDialog: dialogs.NewProgressDialog(),
 		confirmDialog:  dialogs.NewConfirmDialog(),
+		cmdInputDialog: dialogs.NewSimpleInputDialog(""),
 		messageDialog:  dialogs.NewMessageDialog(""),
 		createDialog:   voldialogs.NewVolumeCreateDialog(),
+		kubeDialog:     dialogs.NewKubeGenerateDialog(),
+		filterBar:      dialogs.NewFilterBar(),
+		markedItems:    utils.NewMarkedItems(),
 	}
 
+	vols.tableSort = utils.NewTableSort(len(vols.headers), volsTableNameColIndex)
+
 	vols.initUI()
 
 	return vols
@@ -55,6 +67,7 @@ func NewVolumes() *Volumes {
 func (vols *Volumes) initUI() {
 	vols.cmdDialog = dialogs.NewCommandDialog([][]string{
 		{"create", "create a new volume"},
+		{"generate kube", "generate kubernetes YAML of the selected volume"},
 		{"inspect", "display detailed volume's information"},
 		{"prune", "remove all unused volumes"},
 		{"rm", "remove the selected volume"},
@@ -70,12 +83,12 @@ func (vols *Volumes) initUI() {
 
 	for i := 0; i < len(vols.headers); i++ {
 		vols.table.SetCell(0, i,
-			tview.NewTableCell(fmt.Sprintf("[black::b]%s", strings.ToUpper(vols.headers[i]))). //nolint:perfsprint
-														SetExpansion(1).
-														SetBackgroundColor(style.PageHeaderBgColor).
-														SetTextColor(style.PageHeaderFgColor).
-														SetAlign(tview.AlignLeft).
-														SetSelectable(false))
+			tview.NewTableCell("[::b]"+strings.ToUpper(vols.headers[i])).
+				SetExpansion(1).
+				SetBackgroundColor(style.PageHeaderBgColor).
+				SetTextColor(style.PageHeaderFgColor).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
 	}
 
 	vols.table.SetFixed(1, 1)
@@ -91,6 +104,10 @@ func (vols *Volumes) initUI() {
 		vols.cmdDialog.Hide()
 	})
 
+	// set input cmd dialog functions
+	vols.cmdInputDialog.SetCancelFunc(vols.cmdInputDialog.Hide)
+	vols.cmdInputDialog.SetSelectedFunc(vols.cmdInputDialog.Hide)
+
 	// set message dialog functions
 	vols.messageDialog.SetCancelFunc(func() {
 		vols.messageDialog.Hide()
@@ -105,6 +122,8 @@ func (vols *Volumes) initUI() {
 			vols.prune()
 		case "rm":
 			vols.remove()
+		case "bulk":
+			vols.bulkCommand()
 		}
 	})
 
@@ -121,6 +140,10 @@ func (vols *Volumes) initUI() {
 		vols.createDialog.Hide()
 		vols.create()
 	})
+
+	// set kube generate dialog functions
+	vols.kubeDialog.SetCancelFunc(vols.kubeDialog.Hide)
+	vols.kubeDialog.SetSaveFunc(vols.saveKube)
 }
 
 // GetTitle returns primitive title.
@@ -145,7 +168,7 @@ func (vols *Volumes) SubDialogHasFocus() bool {
 		}
 	}
 
-	return false
+	return vols.filterBar.HasFocus()
 }
 
 // Focus is called when this primitive receives focus.
@@ -158,6 +181,12 @@ func (vols *Volumes) Focus(delegate func(p tview.Primitive)) {
 		}
 	}
 
+	if vols.filterBar.IsDisplay() {
+		delegate(vols.filterBar)
+
+		return
+	}
+
 	delegate(vols.table)
 }
 
@@ -168,6 +197,8 @@ func (vols *Volumes) HideAllDialogs() {
 			dialog.Hide()
 		}
 	}
+
+	vols.filterBar.Hide()
 }
 
 func (vols *Volumes) getInnerDialogs() []utils.UIDialog {
@@ -176,8 +207,10 @@ func (vols *Volumes) getInnerDialogs() []utils.UIDialog {
 		vols.progressDialog,
 		vols.confirmDialog,
 		vols.cmdDialog,
+		vols.cmdInputDialog,
 		vols.messageDialog,
 		vols.createDialog,
+		vols.kubeDialog,
 	}
 
 	return dialogs
@@ -193,3 +226,18 @@ func (vols *Volumes) getSelectedItem() string {
 
 	return volID
 }
+
+func (vols *Volumes) getItems() []utils.MarkedItem {
+	items := []utils.MarkedItem{}
+
+	for row := 1; row < vols.table.GetRowCount(); row++ {
+		volName := vols.table.GetCell(row, volsTableNameColIndex).Text
+
+		items = append(items, utils.MarkedItem{
+			ID:   volName,
+			Name: volName,
+		})
+	}
+
+	return items
+}